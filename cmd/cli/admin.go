@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/config"
+)
+
+// adminAction is a maintenance action the server runs on all items.
+type adminAction struct {
+	name string
+	// description completes "This will ...".
+	description string
+	// done reports the number of changed items.
+	done string
+	run  func(*homeboxclient.ActionsService) (int, error)
+}
+
+var adminActions = []adminAction{
+	{
+		name:        "ensure-asset-ids",
+		description: "assign an asset ID to every item without one",
+		done:        "Assigned asset IDs to %d items",
+		run:         (*homeboxclient.ActionsService).EnsureAssetIDs,
+	},
+	{
+		name:        "ensure-import-refs",
+		description: "assign an import ref to every item without one",
+		done:        "Assigned import refs to %d items",
+		run:         (*homeboxclient.ActionsService).EnsureImportRefs,
+	},
+	{
+		name:        "set-primary-photos",
+		description: "make the first photo of every item without a primary photo its primary photo",
+		done:        "Set the primary photo of %d items",
+		run:         (*homeboxclient.ActionsService).SetPrimaryPhotos,
+	},
+	{
+		name:        "zero-item-time-fields",
+		description: "reset the time of day of every item's dates to midnight",
+		done:        "Reset the dates of %d items",
+		run:         (*homeboxclient.ActionsService).ZeroItemTimeFields,
+	},
+}
+
+type adminOptions struct {
+	Action adminAction
+	Backup bool
+	Yes    bool
+}
+
+func (a *App) parseAdminConfig(args []string) (config.Config, adminOptions, error) {
+	cmd := flag.NewFlagSet("admin", flag.ExitOnError)
+
+	var config config.Config
+	var opts adminOptions
+	finish := registerExportFlags(cmd, &config)
+
+	cmd.BoolVar(&opts.Backup, "backup", false, "Export into -output before running the action")
+	cmd.BoolVar(&opts.Yes, "yes", false, "Don't ask for confirmation")
+
+	if err := cmd.Parse(args); err != nil {
+		return config, opts, err
+	}
+	finish()
+
+	var err error
+	if opts.Backup {
+		err = validateRequired(config)
+	} else {
+		err = validateServer(config)
+	}
+	if err != nil {
+		return config, opts, err
+	}
+
+	if cmd.NArg() != 1 {
+		return config, opts, fmt.Errorf("expected a single action (%s)", adminActionNames())
+	}
+	for _, action := range adminActions {
+		if action.name == cmd.Arg(0) {
+			opts.Action = action
+			return config, opts, nil
+		}
+	}
+	return config, opts, fmt.Errorf("unknown action %q (valid actions: %s)", cmd.Arg(0), adminActionNames())
+}
+
+func adminActionNames() string {
+	names := make([]string, len(adminActions))
+	for i, action := range adminActions {
+		names[i] = action.name
+	}
+	return strings.Join(names, ", ")
+}
+
+// handleAdmin runs a maintenance action on the server once the user confirmed
+// it, optionally after exporting everything so the changes can be undone.
+func (a *App) handleAdmin(args []string) error {
+	config, opts, err := a.parseAdminConfig(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	if !opts.Yes {
+		fmt.Fprintf(a.out, "This will %s on %s.\n", opts.Action.description, config.ServerURL)
+		if opts.Backup {
+			fmt.Fprintf(a.out, "Everything is exported to %s first.\n", config.DownloadPath)
+		}
+		if !a.confirm("Continue?") {
+			fmt.Fprintln(a.out, "Aborted")
+			return nil
+		}
+	}
+
+	if opts.Backup {
+		stats, err := runExport(context.Background(), config)
+		if err != nil {
+			return fmt.Errorf("backup failed, %s was not run: %w", opts.Action.name, err)
+		}
+		fmt.Fprintf(a.out, "Exported %d items to %s\n", stats.ItemsDone, config.DownloadPath)
+	}
+
+	client, err := login(config)
+	if err != nil {
+		return err
+	}
+	completed, err := opts.Action.run(homeboxclient.NewActionsService(client))
+	if err != nil {
+		return fmt.Errorf("failed to run %s: %w", opts.Action.name, err)
+	}
+	fmt.Fprintf(a.out, opts.Action.done+"\n", completed)
+	return nil
+}
+
+// confirm asks a yes/no question on the input, defaulting to no.
+func (a *App) confirm(question string) bool {
+	fmt.Fprintf(a.out, "%s [y/N] ", question)
+	if a.in == nil {
+		fmt.Fprintln(a.out)
+		return false
+	}
+	answer, _ := bufio.NewReader(a.in).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+)
+
+func TestParseAdminConfig(t *testing.T) {
+	login := []string{"-server", "http://localhost:8080", "-user", "testuser", "-pass", "testpass"}
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantAction string
+		wantErr    bool
+		errMsg     string
+	}{
+		{
+			name:       "action",
+			args:       append(login, "ensure-asset-ids"),
+			wantAction: "ensure-asset-ids",
+		},
+		{
+			name:       "backup",
+			args:       append([]string{"-backup", "-yes", "-output", "backup"}, append(login, "zero-item-time-fields")...),
+			wantAction: "zero-item-time-fields",
+		},
+		{
+			name:    "no action",
+			args:    login,
+			wantErr: true,
+			errMsg:  "expected a single action (ensure-asset-ids, ensure-import-refs, set-primary-photos, zero-item-time-fields)",
+		},
+		{
+			name:    "unknown action",
+			args:    append(login, "delete-everything"),
+			wantErr: true,
+			errMsg:  `unknown action "delete-everything" (valid actions: ensure-asset-ids, ensure-import-refs, set-primary-photos, zero-item-time-fields)`,
+		},
+		{
+			name:    "backup validates the export options",
+			args:    append([]string{"-backup", "-snapshots", "-output", "s3://bucket"}, append(login, "ensure-asset-ids")...),
+			wantErr: true,
+			errMsg:  "snapshots require a local output directory",
+		},
+		{
+			name:    "missing server",
+			args:    []string{"ensure-asset-ids"},
+			wantErr: true,
+			errMsg:  "server URL is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := New()
+			_, opts, err := app.parseAdminConfig(tt.args)
+			checkError(t, err, tt.wantErr, tt.errMsg)
+			if err != nil {
+				return
+			}
+			if opts.Action.name != tt.wantAction {
+				t.Errorf("action = %q, want %q", opts.Action.name, tt.wantAction)
+			}
+		})
+	}
+}
+
+// adminFixtures are three items without asset IDs and with a time in their
+// dates, for the actions to fix.
+func adminFixtures() fakehomebox.Fixtures {
+	var f fakehomebox.Fixtures
+	for _, name := range []string{"Drill", "Saw", "Hammer"} {
+		f.Items = append(f.Items, fakehomebox.Item{Item: homeboxclient.Item{Name: name, PurchaseTime: "2024-03-01T00:00:00Z"}})
+	}
+	return f
+}
+
+func TestHandleAdmin(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		input      string
+		wantOutput []string
+		wantAction bool
+	}{
+		{
+			name:       "confirmed",
+			args:       []string{"ensure-asset-ids"},
+			input:      "y\n",
+			wantOutput: []string{"This will assign an asset ID to every item without one on ", "Continue? [y/N] ", "Assigned asset IDs to 3 items\n"},
+			wantAction: true,
+		},
+		{
+			name:       "declined",
+			args:       []string{"ensure-asset-ids"},
+			input:      "\n",
+			wantOutput: []string{"Continue? [y/N] ", "Aborted\n"},
+		},
+		{
+			name:       "no input",
+			args:       []string{"ensure-asset-ids"},
+			wantOutput: []string{"Aborted\n"},
+		},
+		{
+			name:       "yes",
+			args:       []string{"-yes", "zero-item-time-fields"},
+			wantOutput: []string{"Reset the dates of 3 items\n"},
+			wantAction: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := startFake(t, adminFixtures())
+
+			var out bytes.Buffer
+			app := &App{out: &out, in: strings.NewReader(tt.input)}
+			if err := app.handleAdmin(append(srv.login, tt.args...)); err != nil {
+				t.Fatalf("handleAdmin() error = %v", err)
+			}
+			for _, want := range tt.wantOutput {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("output missing %q:\n%s", want, out.String())
+				}
+			}
+			requests := srv.Requests()
+			ran := false
+			for _, req := range requests {
+				if strings.HasPrefix(req, "POST /api/v1/actions/") {
+					ran = true
+				}
+			}
+			if ran != tt.wantAction {
+				t.Errorf("action ran = %v, want %v (requests: %v)", ran, tt.wantAction, requests)
+			}
+		})
+	}
+}
+
+func TestHandleAdmin_Backup(t *testing.T) {
+	srv := startFake(t, fakehomebox.Fixtures{})
+	output := t.TempDir()
+	args := append(srv.login, "-output", output, "-progress", "none", "-backup", "set-primary-photos")
+
+	var out bytes.Buffer
+	app := &App{out: &out, in: strings.NewReader("yes\n")}
+	if err := app.handleAdmin(args); err != nil {
+		t.Fatalf("handleAdmin() error = %v", err)
+	}
+	for _, want := range []string{"Everything is exported to " + output + " first.", "Exported 0 items to " + output, "Set the primary photo of 0 items"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	// The export has to finish before the action changes anything.
+	requests := srv.Requests()
+	last := requests[len(requests)-1]
+	if last != "POST /api/v1/actions/set-primary-photos" {
+		t.Errorf("last request = %q, want the action (requests: %v)", last, requests)
+	}
+	for _, req := range requests[:len(requests)-1] {
+		if strings.HasPrefix(req, "POST /api/v1/actions/") {
+			t.Errorf("action ran before the backup finished (requests: %v)", requests)
+		}
+	}
+}
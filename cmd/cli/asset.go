@@ -0,0 +1,217 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/config"
+	"github.com/kusold/homebox-export/internal/csvexport"
+	"github.com/kusold/homebox-export/internal/downloader"
+	"github.com/kusold/homebox-export/internal/qrlabels"
+	"github.com/kusold/homebox-export/internal/report"
+)
+
+type assetOptions struct {
+	AssetID   string
+	JSON      bool
+	Sheet     string // file to write QR labels to instead of looking up an item
+	Filter    report.Filter
+	PublicURL string // where the QR codes link to
+}
+
+func (a *App) parseAssetConfig(args []string) (config.Config, assetOptions, error) {
+	cmd := flag.NewFlagSet("asset", flag.ExitOnError)
+
+	var config config.Config
+	var opts assetOptions
+	registerServerFlags(cmd, &config)
+
+	cmd.BoolVar(&opts.JSON, "json", false, "Print the item as JSON")
+	cmd.StringVar(&opts.Sheet, "sheet", "", "Write a sheet of QR labels for all items to this .pdf or .png file")
+	labels := cmd.String("label", "", "Comma separated labels; only items with any of them get a QR label")
+	cmd.StringVar(&opts.Filter.Location, "location", "", "Only items in this location or below it get a QR label, e.g. \"Home / Garage\"")
+	cmd.StringVar(&opts.PublicURL, "public-url", os.Getenv("HOMEBOX_PUBLIC_URL"), "Homebox URL the QR codes link to (default: -server)")
+
+	if err := cmd.Parse(args); err != nil {
+		return config, opts, err
+	}
+	opts.Filter.Labels = splitList(*labels)
+	opts.AssetID = cmd.Arg(0)
+	if opts.PublicURL == "" {
+		opts.PublicURL = config.ServerURL
+	}
+
+	if err := validateServer(config); err != nil {
+		return config, opts, err
+	}
+	switch {
+	case cmd.NArg() > 1:
+		return config, opts, fmt.Errorf("expected a single asset ID, got %d arguments", cmd.NArg())
+	case opts.Sheet == "" && opts.AssetID == "":
+		return config, opts, fmt.Errorf("an asset ID or -sheet is required")
+	case opts.Sheet != "" && opts.AssetID != "":
+		return config, opts, fmt.Errorf("give either an asset ID or -sheet, not both")
+	case opts.Sheet == "" && (len(opts.Filter.Labels) > 0 || opts.Filter.Location != ""):
+		return config, opts, fmt.Errorf("-label and -location only apply to -sheet")
+	}
+	switch strings.ToLower(filepath.Ext(opts.Sheet)) {
+	case "", ".pdf", ".png":
+	default:
+		return config, opts, fmt.Errorf("invalid sheet %q, want a .pdf or .png file", opts.Sheet)
+	}
+	return config, opts, nil
+}
+
+// handleAsset prints the item with an asset ID, or writes a sheet of QR
+// labels linking to the items.
+func (a *App) handleAsset(args []string) error {
+	config, opts, err := a.parseAssetConfig(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	client, err := login(config)
+	if err != nil {
+		return err
+	}
+	tree, err := homeboxclient.NewLocationsService(client).GetTree(false)
+	if err != nil {
+		return fmt.Errorf("failed to get locations: %w", err)
+	}
+	locations := csvexport.NewLocationPaths(tree)
+
+	if opts.Sheet != "" {
+		return a.writeSheet(client, locations, opts)
+	}
+	return a.printAsset(client, locations, opts)
+}
+
+func (a *App) printAsset(client *homeboxclient.Client, locations csvexport.LocationPaths, opts assetOptions) error {
+	items := homeboxclient.NewItemsService(client)
+	found, err := items.GetByAssetID(opts.AssetID)
+	if err != nil {
+		return fmt.Errorf("failed to look up asset %s: %w", opts.AssetID, err)
+	}
+	if len(found.Items) == 0 {
+		return fmt.Errorf("no item with asset ID %s", opts.AssetID)
+	}
+	item, err := items.Get(found.Items[0].ID)
+	if err != nil {
+		return fmt.Errorf("failed to get item %s: %w", found.Items[0].ID, err)
+	}
+
+	if opts.JSON {
+		enc := json.NewEncoder(a.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(item)
+	}
+
+	var labels []string
+	for _, l := range item.Labels {
+		labels = append(labels, l.Name)
+	}
+	w := tabwriter.NewWriter(a.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\n\n", item.Name)
+	for _, d := range [][2]string{
+		{"Asset ID", csvexport.FormatAssetID(item.AssetID)},
+		{"ID", item.ID},
+		{"Location", locations.Path(item.Location)},
+		{"Labels", strings.Join(labels, ", ")},
+		{"Quantity", strconv.Itoa(item.Quantity)},
+		{"Purchase price", formatMoney(item.PurchasePrice)},
+		{"Manufacturer", strings.TrimSpace(item.Manufacturer + " " + item.ModelNumber)},
+		{"Serial number", item.SerialNumber},
+		{"Attachments", strconv.Itoa(len(item.Attachments))},
+		{"URL", itemURL(opts.PublicURL, *item)},
+	} {
+		if d[1] != "" {
+			fmt.Fprintf(w, "%s\t%s\n", d[0], d[1])
+		}
+	}
+	return w.Flush()
+}
+
+// writeSheet renders a QR label for every item matching the filter. PNG
+// sheets with more than one page are written as name.png, name-2.png, ...
+func (a *App) writeSheet(client *homeboxclient.Client, locations csvexport.LocationPaths, opts assetOptions) error {
+	items, err := downloader.ListItems(homeboxclient.NewItemsService(client), listPageSize)
+	if err != nil {
+		return err
+	}
+	r := report.New(items, locations, nil)
+	r.Filter(opts.Filter)
+	if len(r.Rows) == 0 {
+		return fmt.Errorf("no items to label")
+	}
+
+	var labels []qrlabels.Label
+	for _, row := range r.Rows {
+		qr, err := client.QRCode(itemURL(opts.PublicURL, row.Item))
+		if err != nil {
+			return fmt.Errorf("failed to get the QR code of %s: %w", row.Item.Name, err)
+		}
+		label := qrlabels.Label{
+			Title:   row.Item.Name,
+			AssetID: csvexport.FormatAssetID(row.Item.AssetID),
+			QR:      qr,
+		}
+		// The innermost location is what helps find the item.
+		if row.Item.Location != nil {
+			label.Location = row.Item.Location.Name
+		}
+		labels = append(labels, label)
+	}
+
+	files := []string{opts.Sheet}
+	if strings.EqualFold(filepath.Ext(opts.Sheet), ".png") {
+		pages, err := qrlabels.RenderPNG(labels)
+		if err != nil {
+			return fmt.Errorf("failed to render QR labels: %w", err)
+		}
+		base := strings.TrimSuffix(opts.Sheet, filepath.Ext(opts.Sheet))
+		for i, page := range pages {
+			if i > 0 {
+				files = append(files, fmt.Sprintf("%s-%d%s", base, i+1, filepath.Ext(opts.Sheet)))
+			}
+			if err := os.WriteFile(files[i], page, 0o644); err != nil {
+				return fmt.Errorf("failed to write QR labels: %w", err)
+			}
+		}
+	} else {
+		f, err := os.Create(opts.Sheet)
+		if err != nil {
+			return fmt.Errorf("failed to write QR labels: %w", err)
+		}
+		defer f.Close()
+		if err := qrlabels.WritePDF(f, labels); err != nil {
+			return fmt.Errorf("failed to render QR labels: %w", err)
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("failed to write QR labels: %w", err)
+		}
+	}
+
+	fmt.Fprintf(a.out, "Wrote %d QR labels to %s\n", len(labels), strings.Join(files, ", "))
+	return nil
+}
+
+// listPageSize is the number of items listed per request by the commands
+// without a -pagesize flag.
+const listPageSize = 100
+
+// itemURL is the page of an item in the Homebox web UI. Items with an asset
+// ID link to /a/<asset ID>, which Homebox redirects to the item.
+func itemURL(base string, item homeboxclient.Item) string {
+	base = strings.TrimRight(base, "/")
+	if id := csvexport.FormatAssetID(item.AssetID); id != "" {
+		return base + "/a/" + id
+	}
+	return base + "/item/" + item.ID
+}
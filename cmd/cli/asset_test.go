@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+)
+
+func TestParseAssetConfig(t *testing.T) {
+	login := []string{"-server", "http://localhost:8080", "-user", "testuser", "-pass", "testpass"}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    assetOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "lookup",
+			args: append(login, "000-042"),
+			want: assetOptions{AssetID: "000-042", PublicURL: "http://localhost:8080"},
+		},
+		{
+			name: "sheet",
+			args: append([]string{"-sheet", "labels.pdf", "-label", "Tools", "-public-url", "https://homebox.example.com"}, login...),
+			want: assetOptions{Sheet: "labels.pdf", PublicURL: "https://homebox.example.com"},
+		},
+		{
+			name:    "nothing to do",
+			args:    login,
+			wantErr: true,
+			errMsg:  "an asset ID or -sheet is required",
+		},
+		{
+			name:    "both",
+			args:    append([]string{"-sheet", "labels.pdf"}, append(login, "000-042")...),
+			wantErr: true,
+			errMsg:  "give either an asset ID or -sheet, not both",
+		},
+		{
+			name:    "filter without sheet",
+			args:    append([]string{"-label", "Tools"}, append(login, "000-042")...),
+			wantErr: true,
+			errMsg:  "-label and -location only apply to -sheet",
+		},
+		{
+			name:    "invalid sheet",
+			args:    append([]string{"-sheet", "labels.svg"}, login...),
+			wantErr: true,
+			errMsg:  `invalid sheet "labels.svg", want a .pdf or .png file`,
+		},
+		{
+			name:    "missing server",
+			args:    []string{"000-042"},
+			wantErr: true,
+			errMsg:  "server URL is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := New()
+			_, opts, err := app.parseAssetConfig(tt.args)
+			checkError(t, err, tt.wantErr, tt.errMsg)
+			if err != nil {
+				return
+			}
+			if opts.AssetID != tt.want.AssetID || opts.Sheet != tt.want.Sheet || opts.PublicURL != tt.want.PublicURL {
+				t.Errorf("options = %+v, want %+v", opts, tt.want)
+			}
+		})
+	}
+}
+
+// assetFixtures are two items, of which the drill has asset ID 000-042, in
+// two rooms of the home.
+func assetFixtures() fakehomebox.Fixtures {
+	return fakehomebox.Fixtures{
+		Labels: []homeboxclient.Label{{ID: "tools", Name: "Tools"}},
+		Locations: []fakehomebox.Location{
+			{ID: "home", Name: "Home"},
+			{ID: "garage", Name: "Garage", ParentID: "home"},
+			{ID: "living", Name: "Living Room", ParentID: "home"},
+		},
+		Items: []fakehomebox.Item{
+			{Item: homeboxclient.Item{ID: "drill-1", Name: "Drill", AssetID: "000-042", Quantity: 1, PurchasePrice: 129.5}, LocationID: "garage", LabelIDs: []string{"tools"}},
+			{Item: homeboxclient.Item{ID: "tv-1", Name: "TV", AssetID: "000-000"}, LocationID: "living"},
+		},
+	}
+}
+
+func TestHandleAsset(t *testing.T) {
+	srv := startFake(t, assetFixtures())
+	login := srv.login
+
+	var out bytes.Buffer
+	app := &App{out: &out}
+	if err := app.handleAsset(append(login, "000-042")); err != nil {
+		t.Fatalf("handleAsset() error = %v", err)
+	}
+	got := out.String()
+	for _, want := range []string{
+		"Drill\n",
+		"Asset ID        000-042",
+		"Location        Home / Garage",
+		"Labels          Tools",
+		"Purchase price  129.50",
+		"URL             " + srv.URL + "/a/000-042",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+
+	out.Reset()
+	if err := app.handleAsset(append([]string{"-json"}, append(login, "000-042")...)); err != nil {
+		t.Fatalf("handleAsset() error = %v", err)
+	}
+	if !strings.Contains(out.String(), `"assetId": "000-042"`) {
+		t.Errorf("JSON output missing the asset ID:\n%s", out.String())
+	}
+
+	err := app.handleAsset(append(login, "000-404"))
+	checkError(t, err, true, "no item with asset ID 000-404")
+}
+
+func TestHandleAsset_Sheet(t *testing.T) {
+	srv := startFake(t, assetFixtures())
+	login := append([]string{"-public-url", "https://homebox.example.com/"}, srv.login...)
+
+	tests := []struct {
+		name   string
+		args   []string
+		sheet  string
+		prefix string
+		want   []string
+	}{
+		{
+			name:   "pdf of all items",
+			sheet:  "labels.pdf",
+			prefix: "%PDF-",
+			want:   []string{"https://homebox.example.com/a/000-042", "https://homebox.example.com/item/tv-1"},
+		},
+		{
+			name:   "png of a label",
+			args:   []string{"-label", "tools"},
+			sheet:  "labels.png",
+			prefix: "\x89PNG",
+			want:   []string{"https://homebox.example.com/a/000-042"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := len(srv.Queries("/api/v1/qrcode"))
+			sheet := filepath.Join(t.TempDir(), tt.sheet)
+
+			var out bytes.Buffer
+			app := &App{out: &out}
+			args := append(append([]string{"-sheet", sheet}, tt.args...), login...)
+			if err := app.handleAsset(args); err != nil {
+				t.Fatalf("handleAsset() error = %v", err)
+			}
+
+			data, err := os.ReadFile(sheet)
+			if err != nil {
+				t.Fatalf("sheet not written: %v", err)
+			}
+			if !strings.HasPrefix(string(data), tt.prefix) {
+				t.Errorf("sheet starts with %q, want %q", data[:8], tt.prefix)
+			}
+			var encoded []string
+			for _, query := range srv.Queries("/api/v1/qrcode")[seen:] {
+				encoded = append(encoded, query.Get("data"))
+			}
+			if strings.Join(encoded, " ") != strings.Join(tt.want, " ") {
+				t.Errorf("QR codes encode %q, want %q", encoded, tt.want)
+			}
+		})
+	}
+}
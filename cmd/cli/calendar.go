@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"path"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/config"
+	"github.com/kusold/homebox-export/internal/downloader"
+	"github.com/kusold/homebox-export/internal/ical"
+)
+
+// calendarFilename is the file export-calendar writes into the output.
+const calendarFilename = "calendar.ics"
+
+type calendarOptions struct {
+	Status     homeboxclient.MaintenanceFilterStatus
+	Warranties bool
+}
+
+func (a *App) parseCalendarConfig(args []string) (config.Config, calendarOptions, error) {
+	cmd := flag.NewFlagSet("export-calendar", flag.ExitOnError)
+
+	var config config.Config
+	var opts calendarOptions
+	finish := registerExportFlags(cmd, &config)
+
+	status := cmd.String("status", getEnvOrDefault("HOMEBOX_CALENDAR_STATUS", "both"), "Maintenance to include (scheduled, completed, both)")
+	cmd.BoolVar(&opts.Warranties, "warranties", getEnvBoolOrDefault("HOMEBOX_CALENDAR_WARRANTIES", false), "Also include the day each item's warranty expires")
+
+	if err := cmd.Parse(args); err != nil {
+		return config, opts, err
+	}
+	finish()
+
+	if err := validateRequired(config); err != nil {
+		return config, opts, err
+	}
+	if config.Snapshots {
+		return config, opts, fmt.Errorf("export-calendar does not take -snapshots")
+	}
+	opts.Status = homeboxclient.MaintenanceFilterStatus(*status)
+	switch opts.Status {
+	case homeboxclient.MaintenanceFilterStatusScheduled, homeboxclient.MaintenanceFilterStatusCompleted, homeboxclient.MaintenanceFilterStatusBoth:
+	default:
+		return config, opts, fmt.Errorf("invalid status %q (valid statuses: scheduled, completed, both)", *status)
+	}
+	return config, opts, nil
+}
+
+// handleCalendar writes the maintenance of all items, and optionally the
+// warranty expiry of every item, as an iCalendar file into the output.
+func (a *App) handleCalendar(args []string) error {
+	config, opts, err := a.parseCalendarConfig(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	ctx := context.Background()
+	d, err := downloader.New(config)
+	if err != nil {
+		return fmt.Errorf("failed to initialize downloader: %w", err)
+	}
+	defer d.Close()
+
+	cal := ical.Calendar{Name: "Homebox"}
+	entries, err := d.Maintenance(opts.Status)
+	if err != nil {
+		return err
+	}
+	cal.AddMaintenance(entries)
+	if opts.Warranties {
+		// Only the full items carry their warranty.
+		items, err := d.FetchItems(ctx)
+		if err != nil {
+			return err
+		}
+		cal.AddWarranties(items)
+	}
+
+	var buf bytes.Buffer
+	if err := cal.Write(&buf, time.Now()); err != nil {
+		return err
+	}
+	if err := d.WriteFile(ctx, calendarFilename, buf.Bytes()); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.out, "Wrote calendar of %d events to %s\n", len(cal.Events), path.Join(config.DownloadPath, calendarFilename))
+	return nil
+}
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+)
+
+func TestParseCalendarConfig(t *testing.T) {
+	login := []string{"-server", "http://localhost:8080", "-user", "testuser", "-pass", "testpass"}
+
+	tests := []struct {
+		name           string
+		args           []string
+		wantStatus     string
+		wantWarranties bool
+		wantErr        bool
+		errMsg         string
+	}{
+		{
+			name:       "defaults",
+			args:       login,
+			wantStatus: "both",
+		},
+		{
+			name:           "scheduled with warranties",
+			args:           append([]string{"-status", "scheduled", "-warranties"}, login...),
+			wantStatus:     "scheduled",
+			wantWarranties: true,
+		},
+		{
+			name:    "invalid status",
+			args:    append([]string{"-status", "overdue"}, login...),
+			wantErr: true,
+			errMsg:  `invalid status "overdue" (valid statuses: scheduled, completed, both)`,
+		},
+		{
+			name:    "snapshots",
+			args:    append([]string{"-snapshots"}, login...),
+			wantErr: true,
+			errMsg:  "export-calendar does not take -snapshots",
+		},
+		{
+			name:    "missing server",
+			args:    []string{},
+			wantErr: true,
+			errMsg:  "server URL is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := New()
+			_, opts, err := app.parseCalendarConfig(tt.args)
+			checkError(t, err, tt.wantErr, tt.errMsg)
+			if err != nil {
+				return
+			}
+			if string(opts.Status) != tt.wantStatus || opts.Warranties != tt.wantWarranties {
+				t.Errorf("options = %+v, want status %q, warranties %v", opts, tt.wantStatus, tt.wantWarranties)
+			}
+		})
+	}
+}
+
+func TestHandleCalendar(t *testing.T) {
+	srv := startFake(t, fakehomebox.Fixtures{
+		Items: []fakehomebox.Item{
+			{
+				Item:        homeboxclient.Item{Name: "Furnace"},
+				Maintenance: []homeboxclient.MaintenanceEntry{{Name: "Replace filter", Cost: "24.5", ScheduledDate: "2025-03-01"}},
+			},
+			{Item: homeboxclient.Item{Name: "TV", WarrantyExpires: "2026-01-15T00:00:00Z"}},
+		},
+	})
+
+	output := t.TempDir()
+	var out bytes.Buffer
+	app := &App{out: &out}
+	args := append(srv.login, "-output", output, "-progress", "none", "-warranties")
+	if err := app.handleCalendar(args); err != nil {
+		t.Fatalf("handleCalendar() error = %v", err)
+	}
+
+	if status := srv.Queries("/api/v1/maintenance")[0].Get("status"); status != "both" {
+		t.Errorf("maintenance status = %q, want both", status)
+	}
+	if want := "Wrote calendar of 2 events to " + filepath.Join(output, "calendar.ics"); !strings.Contains(out.String(), want) {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+	data, err := os.ReadFile(filepath.Join(output, "calendar.ics"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"SUMMARY:Furnace: Replace filter\r\n", "SUMMARY:Warranty expires: TV\r\n"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("calendar missing %q:\n%s", want, data)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/kusold/homebox-export/internal/encryption"
+)
+
+type decryptOptions struct {
+	Input      string
+	Output     string
+	Identities []string
+	Passphrase string
+}
+
+func (a *App) parseDecryptConfig(args []string) (decryptOptions, error) {
+	cmd := flag.NewFlagSet("decrypt", flag.ExitOnError)
+
+	var opts decryptOptions
+	cmd.StringVar(&opts.Input, "input", getEnvOrDefault("HOMEBOX_OUTPUT", "export"), "Directory holding the encrypted export")
+	cmd.StringVar(&opts.Output, "output", "", "Directory to write the decrypted files to (required)")
+	identities := cmd.String("identity", os.Getenv("HOMEBOX_DECRYPT_IDENTITY"), "Comma separated age identity files")
+	cmd.StringVar(&opts.Passphrase, "passphrase", os.Getenv("HOMEBOX_ENCRYPT_PASSPHRASE"), "Passphrase the export was encrypted with")
+
+	if err := cmd.Parse(args); err != nil {
+		return opts, err
+	}
+	opts.Identities = splitList(*identities)
+
+	if opts.Output == "" {
+		return opts, fmt.Errorf("output directory is required")
+	}
+	if len(opts.Identities) == 0 && opts.Passphrase == "" {
+		return opts, fmt.Errorf("an identity file or passphrase is required")
+	}
+	return opts, nil
+}
+
+func (a *App) handleDecrypt(args []string) error {
+	opts, err := a.parseDecryptConfig(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	identities, err := encryption.ParseIdentities(opts.Identities, opts.Passphrase)
+	if err != nil {
+		return err
+	}
+
+	n, err := encryption.DecryptDir(opts.Input, opts.Output, identities...)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.out, "Decrypted %d files to %s\n", n, opts.Output)
+	return nil
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+
+	"github.com/kusold/homebox-export/internal/encryption"
+	"github.com/kusold/homebox-export/internal/storage"
+)
+
+func TestParseDecryptConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		env     map[string]string
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "identity file",
+			args: []string{"-input", "export", "-output", "plain", "-identity", "key.txt"},
+		},
+		{
+			name: "passphrase from env",
+			args: []string{"-output", "plain"},
+			env:  map[string]string{"HOMEBOX_ENCRYPT_PASSPHRASE": "secret"},
+		},
+		{
+			name:    "missing output",
+			args:    []string{"-identity", "key.txt"},
+			wantErr: true,
+			errMsg:  "output directory is required",
+		},
+		{
+			name:    "missing identity",
+			args:    []string{"-output", "plain"},
+			wantErr: true,
+			errMsg:  "an identity file or passphrase is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer setupTestEnvironment(tt.env)()
+
+			app := New()
+			_, err := app.parseDecryptConfig(tt.args)
+			checkError(t, err, tt.wantErr, tt.errMsg)
+		})
+	}
+}
+
+func TestHandleDecrypt(t *testing.T) {
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key.txt")
+	if err := os.WriteFile(keyFile, []byte(id.String()+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	input := filepath.Join(dir, "export")
+	local, err := storage.NewLocal(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink, _ := encryption.NewSink(local, id.Recipient())
+	if _, err := sink.Put(context.Background(), "Item_abc/receipt.pdf", strings.NewReader("receipt"), -1, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	app := &App{out: &out}
+	output := filepath.Join(dir, "plain")
+	if err := app.Execute([]string{"decrypt", "-input", input, "-output", output, "-identity", keyFile}); err != nil {
+		t.Fatalf("decrypt error = %v", err)
+	}
+	if !strings.Contains(out.String(), "Decrypted 1 files") {
+		t.Errorf("output = %q", out.String())
+	}
+	got, err := os.ReadFile(filepath.Join(output, "Item_abc", "receipt.pdf"))
+	if err != nil || string(got) != "receipt" {
+		t.Errorf("decrypted file = %q, %v", got, err)
+	}
+}
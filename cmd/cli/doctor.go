@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/config"
+)
+
+// certificateWarning is how long before its certificate expires doctor warns
+// about a server.
+const certificateWarning = 14 * 24 * time.Hour
+
+// doctorSampleSize is how many items doctor looks at to find an attachment to
+// test downloads with.
+const doctorSampleSize = 20
+
+// Outcomes of a doctor check.
+const (
+	checkOK   = "ok"
+	checkWarn = "warn"
+	checkFail = "fail"
+	checkSkip = "skip"
+)
+
+type checkResult struct {
+	Name    string
+	Outcome string
+	Detail  string
+}
+
+func (a *App) parseDoctorConfig(args []string) (config.Config, error) {
+	cmd := flag.NewFlagSet("doctor", flag.ExitOnError)
+
+	var config config.Config
+	registerServerFlags(cmd, &config)
+
+	if err := cmd.Parse(args); err != nil {
+		return config, err
+	}
+	return config, validateServer(config)
+}
+
+// handleDoctor checks that an export can run against the server: that it is
+// reachable, its certificate and version, the credentials and the API calls
+// an export makes. It fails if any check fails.
+func (a *App) handleDoctor(args []string) error {
+	config, err := a.parseDoctorConfig(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	results := runDoctor(config, http.DefaultClient)
+
+	failed := 0
+	for _, r := range results {
+		fmt.Fprintf(a.out, "%-6s %-22s %s\n", "["+r.Outcome+"]", r.Name, r.Detail)
+		if r.Outcome == checkFail {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(results))
+	}
+	return nil
+}
+
+// runDoctor runs the checks in order. Checks that depend on an earlier check
+// that failed are skipped.
+func runDoctor(config config.Config, httpClient *http.Client) []checkResult {
+	var results []checkResult
+	add := func(name, outcome, format string, a ...any) {
+		results = append(results, checkResult{Name: name, Outcome: outcome, Detail: fmt.Sprintf(format, a...)})
+	}
+	skipRest := func(names ...string) []checkResult {
+		for _, n := range names {
+			add(n, checkSkip, "skipped after an earlier failure")
+		}
+		return results
+	}
+
+	client, err := homeboxclient.NewClient(config.ServerURL, homeboxclient.WithHTTPClient(httpClient))
+	if err != nil {
+		add("Server reachable", checkFail, "%v", err)
+		return skipRest("TLS", "Server version", "Login", "List items", "Read items", "Download attachments", "List locations")
+	}
+
+	status, err := client.Status()
+	if err != nil {
+		add("Server reachable", checkFail, "%v", err)
+		return skipRest("TLS", "Server version", "Login", "List items", "Read items", "Download attachments", "List locations")
+	}
+	if status.Health {
+		add("Server reachable", checkOK, "%s is healthy", status.Title)
+	} else {
+		add("Server reachable", checkWarn, "%s reports it is unhealthy", status.Title)
+	}
+
+	outcome, detail := checkTLS(config.ServerURL, httpClient)
+	add("TLS", outcome, "%s", detail)
+
+	outcome, detail = checkVersion(status.Build.Version)
+	add("Server version", outcome, "%s", detail)
+
+	if _, err := client.Login(config.Username, config.Password); err != nil {
+		add("Login", checkFail, "%v", err)
+		return skipRest("List items", "Read items", "Download attachments", "List locations")
+	}
+	add("Login", checkOK, "logged in as %s", config.Username)
+
+	items := homeboxclient.NewItemsService(client)
+	page, err := items.List(1, doctorSampleSize)
+	if err != nil {
+		add("List items", checkFail, "%v", err)
+		return skipRest("Read items", "Download attachments", "List locations")
+	}
+	add("List items", checkOK, "%d items", page.Total)
+
+	checkItems(items, page.Items, add)
+
+	tree, err := homeboxclient.NewLocationsService(client).GetTree(false)
+	if err != nil {
+		add("List locations", checkFail, "%v", err)
+	} else {
+		add("List locations", checkOK, "%d top level locations", len(tree))
+	}
+	return results
+}
+
+// checkItems reads the details of the listed items until it finds an
+// attachment, and downloads the start of it.
+func checkItems(items *homeboxclient.ItemsService, listed []homeboxclient.Item, add func(name, outcome, format string, a ...any)) {
+	if len(listed) == 0 {
+		add("Read items", checkSkip, "no items to read")
+		add("Download attachments", checkSkip, "no items to read")
+		return
+	}
+
+	for i, summary := range listed {
+		item, err := items.Get(summary.ID)
+		if err != nil {
+			add("Read items", checkFail, "%v", err)
+			add("Download attachments", checkSkip, "skipped after an earlier failure")
+			return
+		}
+		if i == 0 {
+			add("Read items", checkOK, "read %s", item.Name)
+		}
+		if len(item.Attachments) == 0 {
+			continue
+		}
+
+		content, err := items.OpenAttachment(item.ID, item.Attachments[0].ID)
+		if err != nil {
+			add("Download attachments", checkFail, "%v", err)
+			return
+		}
+		_, err = io.ReadFull(content, make([]byte, 1))
+		content.Close()
+		if err != nil && err != io.EOF {
+			add("Download attachments", checkFail, "%v", err)
+			return
+		}
+		add("Download attachments", checkOK, "downloaded an attachment of %s", item.Name)
+		return
+	}
+	add("Download attachments", checkSkip, "none of the first %d items has attachments", len(listed))
+}
+
+// checkTLS reports the TLS version and certificate expiry of the server.
+func checkTLS(serverURL string, httpClient *http.Client) (string, string) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return checkFail, err.Error()
+	}
+	if u.Scheme != "https" {
+		return checkWarn, "not using HTTPS, the password is sent unencrypted"
+	}
+
+	resp, err := httpClient.Get(serverURL)
+	if err != nil {
+		return checkFail, err.Error()
+	}
+	resp.Body.Close()
+	if resp.TLS == nil || len(resp.TLS.PeerCertificates) == 0 {
+		return checkWarn, "no TLS connection information"
+	}
+
+	version := tls.VersionName(resp.TLS.Version)
+	expires := resp.TLS.PeerCertificates[0].NotAfter
+	if time.Until(expires) < certificateWarning {
+		return checkWarn, fmt.Sprintf("%s, certificate expires %s", version, expires.Format(time.DateOnly))
+	}
+	return checkOK, fmt.Sprintf("%s, certificate valid until %s", version, expires.Format(time.DateOnly))
+}
+
+// checkVersion compares the server version with the versions the export
+// supports.
+func checkVersion(serverVersion string) (string, string) {
+	min, _ := homeboxclient.ParseVersion(homeboxclient.MinVersion)
+	target, _ := homeboxclient.ParseVersion(homeboxclient.TargetVersion)
+
+	v, ok := homeboxclient.ParseVersion(serverVersion)
+	switch {
+	case !ok:
+		return checkWarn, fmt.Sprintf("%q is not a release, supported are %s to %s", serverVersion, min, target)
+	case v.Compare(min) < 0:
+		return checkFail, fmt.Sprintf("%s is older than %s, the oldest supported version", v, min)
+	case v.Compare(target) > 0 && !v.SameMinor(target):
+		return checkWarn, fmt.Sprintf("%s is newer than %s, the export may not know its API", v, target)
+	default:
+		return checkOK, fmt.Sprintf("%s is supported", v)
+	}
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+)
+
+// doctorFixtures are a drill with a photo in the garage, on a server of
+// version.
+func doctorFixtures(version string) fakehomebox.Fixtures {
+	return fakehomebox.Fixtures{
+		Version:   version,
+		Users:     []fakehomebox.User{{Email: "u", Password: "p"}},
+		Locations: []fakehomebox.Location{{ID: "garage", Name: "Garage"}},
+		Items: []fakehomebox.Item{{
+			Item:        homeboxclient.Item{Name: "Drill"},
+			LocationID:  "garage",
+			Attachments: []fakehomebox.Attachment{{Title: "drill.jpg", Type: homeboxclient.AttachmentTypePhoto, Content: "jpeg"}},
+		}},
+	}
+}
+
+func TestHandleDoctor(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		loginOK bool
+		want    []string
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "healthy",
+			version: "v0.16.0",
+			loginOK: true,
+			want: []string{
+				"[ok]   Server reachable       Homebox is healthy",
+				"[warn] TLS",
+				"[ok]   Server version         v0.16.0 is supported",
+				"[ok]   Login                  logged in as u",
+				"[ok]   List items             1 items",
+				"[ok]   Read items             read Drill",
+				"[ok]   Download attachments   downloaded an attachment of Drill",
+				"[ok]   List locations         1 top level locations",
+			},
+		},
+		{
+			name:    "failed login",
+			version: "v0.16.0",
+			want: []string{
+				"[fail] Login",
+				"[skip] List items             skipped after an earlier failure",
+				"[skip] List locations         skipped after an earlier failure",
+			},
+			wantErr: true,
+			errMsg:  "1 of 8 checks failed",
+		},
+		{
+			name:    "old server",
+			version: "v0.9.2",
+			loginOK: true,
+			want:    []string{"[fail] Server version         v0.9.2 is older than v0.10.0, the oldest supported version"},
+			wantErr: true,
+			errMsg:  "1 of 8 checks failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := startFake(t, doctorFixtures(tt.version))
+			pass := "p"
+			if !tt.loginOK {
+				pass = "wrong"
+			}
+
+			var out bytes.Buffer
+			app := &App{out: &out}
+			err := app.handleDoctor([]string{"-server", srv.URL, "-user", "u", "-pass", pass})
+			checkError(t, err, tt.wantErr, tt.errMsg)
+
+			got := out.String()
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("output missing %q:\n%s", want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{"v0.16.0", checkOK},
+		{"0.16.3", checkOK},
+		{"v0.12.1", checkOK},
+		{"v0.10.0", checkOK},
+		{"v0.9.9", checkFail},
+		{"v0.17.0", checkWarn},
+		{"v1.0.0", checkWarn},
+		{"nightly", checkWarn},
+		{"", checkWarn},
+	}
+	for _, tt := range tests {
+		if got, detail := checkVersion(tt.version); got != tt.want {
+			t.Errorf("checkVersion(%q) = %s (%s), want %s", tt.version, got, detail, tt.want)
+		}
+	}
+}
+
+func TestCheckTLS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	outcome, detail := checkTLS(srv.URL, srv.Client())
+	if outcome != checkOK || !strings.HasPrefix(detail, "TLS 1.3, certificate valid until ") {
+		t.Errorf("checkTLS() = %s, %q, want ok with the TLS version and expiry", outcome, detail)
+	}
+
+	if outcome, _ := checkTLS("http://localhost:7745", srv.Client()); outcome != checkWarn {
+		t.Errorf("checkTLS() over HTTP = %s, want warn", outcome)
+	}
+}
@@ -0,0 +1,198 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/containrrr/shoutrrr"
+	"github.com/containrrr/shoutrrr/pkg/types"
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/config"
+	"github.com/kusold/homebox-export/internal/csvexport"
+	"github.com/kusold/homebox-export/internal/downloader"
+)
+
+type dueOptions struct {
+	Days      int
+	Notifier  string
+	NotifyURL string
+}
+
+func (a *App) parseDueConfig(args []string) (config.Config, dueOptions, error) {
+	cmd := flag.NewFlagSet("due", flag.ExitOnError)
+
+	var config config.Config
+	var opts dueOptions
+	registerServerFlags(cmd, &config)
+
+	cmd.IntVar(&opts.Days, "days", getEnvIntOrDefault("HOMEBOX_DUE_DAYS", 30), "Report what is due within this many days")
+	cmd.StringVar(&opts.Notifier, "notifier", getEnvOrDefault("HOMEBOX_DUE_NOTIFIER", ""), "Name or ID of a Homebox notifier to send the report through")
+	cmd.StringVar(&opts.NotifyURL, "notify-url", getEnvOrDefault("HOMEBOX_DUE_NOTIFY_URL", ""), "Shoutrrr URL to send the report to, e.g. ntfy://ntfy.sh/homebox")
+
+	if err := cmd.Parse(args); err != nil {
+		return config, opts, err
+	}
+
+	if err := validateServer(config); err != nil {
+		return config, opts, err
+	}
+	if opts.Days < 0 {
+		return config, opts, fmt.Errorf("-days must not be negative")
+	}
+	if opts.Notifier != "" && opts.NotifyURL != "" {
+		return config, opts, fmt.Errorf("give either -notifier or -notify-url, not both")
+	}
+	return config, opts, nil
+}
+
+// handleDue prints the maintenance and warranties due within the next days,
+// and sends the same report as a notification if anything is due.
+func (a *App) handleDue(args []string) error {
+	config, opts, err := a.parseDueConfig(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	client, err := login(config)
+	if err != nil {
+		return err
+	}
+
+	// Resolve the notifier first, so that a typo fails before fetching every
+	// item.
+	notifyURL := opts.NotifyURL
+	if opts.Notifier != "" {
+		if notifyURL, err = notifierURL(homeboxclient.NewNotifiersService(client), opts.Notifier); err != nil {
+			return err
+		}
+	}
+
+	entries, err := homeboxclient.NewMaintenanceService(client).List(homeboxclient.MaintenanceFilterStatusScheduled)
+	if err != nil {
+		return fmt.Errorf("failed to list maintenance: %w", err)
+	}
+	// Only the full items carry their warranty.
+	items, err := downloader.FetchItems(context.Background(), homeboxclient.NewItemsService(client), listPageSize)
+	if err != nil {
+		return err
+	}
+
+	due := findDue(items, entries, time.Now(), opts.Days)
+	report := due.String()
+	fmt.Fprint(a.out, report)
+
+	if notifyURL == "" || due.empty() {
+		return nil
+	}
+	sender, err := shoutrrr.CreateSender(notifyURL)
+	if err != nil {
+		return fmt.Errorf("invalid notification URL: %w", err)
+	}
+	title := fmt.Sprintf("Homebox: %d due in the next %d days", len(due.maintenance)+len(due.warranties), opts.Days)
+	for _, err := range sender.Send(report, &types.Params{"title": title}) {
+		if err != nil {
+			return fmt.Errorf("failed to send notification: %w", err)
+		}
+	}
+	fmt.Fprintln(a.out, "\nSent notification")
+	return nil
+}
+
+// notifierURL returns the URL of the notifier with a name or ID.
+func notifierURL(notifiers *homeboxclient.NotifiersService, nameOrID string) (string, error) {
+	list, err := notifiers.List()
+	if err != nil {
+		return "", fmt.Errorf("failed to list notifiers: %w", err)
+	}
+	for _, n := range list {
+		if n.ID != nameOrID && !strings.EqualFold(n.Name, nameOrID) {
+			continue
+		}
+		if !n.IsActive {
+			return "", fmt.Errorf("notifier %q is disabled", n.Name)
+		}
+		if n.URL == "" {
+			return "", fmt.Errorf("the server did not return the URL of notifier %q, use -notify-url instead", n.Name)
+		}
+		return n.URL, nil
+	}
+	return "", fmt.Errorf("no notifier named %q", nameOrID)
+}
+
+// dueEntry is a dated line of the due report.
+type dueEntry struct {
+	date string // YYYY-MM-DD
+	text string
+}
+
+type dueReport struct {
+	days        int
+	maintenance []dueEntry
+	warranties  []dueEntry
+}
+
+// findDue returns the maintenance scheduled up to days after now, including
+// overdue maintenance, and the warranties expiring in that time.
+func findDue(items []homeboxclient.Item, entries []homeboxclient.MaintenanceEntryWithDetails, now time.Time, days int) dueReport {
+	today := now.Format(time.DateOnly)
+	until := now.AddDate(0, 0, days).Format(time.DateOnly)
+	r := dueReport{days: days}
+
+	for _, entry := range entries {
+		scheduled := csvexport.FormatDate(entry.ScheduledDate)
+		if scheduled == "" || scheduled > until || csvexport.FormatDate(entry.CompletedDate) != "" {
+			continue
+		}
+		text := entry.ItemName + ": " + entry.Name
+		if scheduled < today {
+			text += " (overdue)"
+		}
+		r.maintenance = append(r.maintenance, dueEntry{date: scheduled, text: text})
+	}
+
+	for _, item := range items {
+		expires := csvexport.FormatDate(item.WarrantyExpires)
+		if item.LifetimeWarranty || expires == "" || expires < today || expires > until {
+			continue
+		}
+		text := item.Name
+		if id := csvexport.FormatAssetID(item.AssetID); id != "" {
+			text += " (" + id + ")"
+		}
+		r.warranties = append(r.warranties, dueEntry{date: expires, text: text})
+	}
+
+	for _, list := range [][]dueEntry{r.maintenance, r.warranties} {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].date < list[j].date })
+	}
+	return r
+}
+
+func (r dueReport) empty() bool {
+	return len(r.maintenance) == 0 && len(r.warranties) == 0
+}
+
+func (r dueReport) String() string {
+	if r.empty() {
+		return fmt.Sprintf("Nothing is due in the next %d days\n", r.days)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Due in the next %d days:\n", r.days)
+	section := func(title string, entries []dueEntry) {
+		if len(entries) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n%s\n", title)
+		for _, e := range entries {
+			fmt.Fprintf(&b, "  %s  %s\n", e.date, e.text)
+		}
+	}
+	section("Maintenance", r.maintenance)
+	section("Warranty expires", r.warranties)
+	return b.String()
+}
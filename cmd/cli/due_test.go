@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+)
+
+func TestParseDueConfig(t *testing.T) {
+	login := []string{"-server", "http://localhost:8080", "-user", "testuser", "-pass", "testpass"}
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    dueOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "defaults",
+			args: login,
+			want: dueOptions{Days: 30},
+		},
+		{
+			name: "notifier",
+			args: append([]string{"-days", "7", "-notifier", "Family"}, login...),
+			want: dueOptions{Days: 7, Notifier: "Family"},
+		},
+		{
+			name:    "negative days",
+			args:    append([]string{"-days", "-1"}, login...),
+			wantErr: true,
+			errMsg:  "-days must not be negative",
+		},
+		{
+			name:    "both notifiers",
+			args:    append([]string{"-notifier", "Family", "-notify-url", "ntfy://ntfy.sh/homebox"}, login...),
+			wantErr: true,
+			errMsg:  "give either -notifier or -notify-url, not both",
+		},
+		{
+			name:    "missing server",
+			args:    []string{},
+			wantErr: true,
+			errMsg:  "server URL is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := New()
+			_, opts, err := app.parseDueConfig(tt.args)
+			checkError(t, err, tt.wantErr, tt.errMsg)
+			if err != nil {
+				return
+			}
+			if opts != tt.want {
+				t.Errorf("options = %+v, want %+v", opts, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindDue(t *testing.T) {
+	now := time.Date(2025, 3, 1, 18, 0, 0, 0, time.UTC)
+	entry := func(item, name, scheduled, completed string) homeboxclient.MaintenanceEntryWithDetails {
+		return homeboxclient.MaintenanceEntryWithDetails{
+			MaintenanceEntry: homeboxclient.MaintenanceEntry{Name: name, ScheduledDate: scheduled, CompletedDate: completed},
+			ItemName:         item,
+		}
+	}
+	entries := []homeboxclient.MaintenanceEntryWithDetails{
+		entry("Car", "Oil change", "2025-03-20", ""),
+		entry("Furnace", "Replace filter", "2025-02-15", ""),
+		entry("Bike", "Tune up", "2025-04-15", ""),
+		entry("Shed", "Paint", "2025-03-05", "2025-03-01"),
+		entry("Attic", "Someday", "", ""),
+	}
+	items := []homeboxclient.Item{
+		{Name: "TV", AssetID: "000-007", WarrantyExpires: "2025-03-31T00:00:00Z"},
+		{Name: "Phone", AssetID: "000-000", WarrantyExpires: "2025-03-01"},
+		{Name: "Laptop", WarrantyExpires: "2025-02-28"},
+		{Name: "Pan", LifetimeWarranty: true, WarrantyExpires: "2025-03-10"},
+		{Name: "Chair", WarrantyExpires: "0001-01-01T00:00:00Z"},
+	}
+
+	got := findDue(items, entries, now, 30).String()
+	want := `Due in the next 30 days:
+
+Maintenance
+  2025-02-15  Furnace: Replace filter (overdue)
+  2025-03-20  Car: Oil change
+
+Warranty expires
+  2025-03-01  Phone
+  2025-03-31  TV (000-007)
+`
+	if got != want {
+		t.Errorf("report =\n%s\nwant\n%s", got, want)
+	}
+
+	if got, want := findDue(nil, nil, now, 7).String(), "Nothing is due in the next 7 days\n"; got != want {
+		t.Errorf("empty report = %q, want %q", got, want)
+	}
+}
+
+func TestHandleDue(t *testing.T) {
+	soon := time.Now().AddDate(0, 0, 3).Format(time.DateOnly)
+	var notifications []string
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		notifications = append(notifications, string(body))
+	}))
+	defer hook.Close()
+	srv := startFake(t, fakehomebox.Fixtures{
+		Items: []fakehomebox.Item{
+			{Item: homeboxclient.Item{Name: "Drill", WarrantyExpires: soon}},
+			{Item: homeboxclient.Item{Name: "Saw"}, Maintenance: []homeboxclient.MaintenanceEntry{{Name: "Sharpen", ScheduledDate: soon}}},
+		},
+		Notifiers: []homeboxclient.Notifier{
+			{Name: "Family", URL: "generic+" + hook.URL + "/hook", IsActive: true},
+			{Name: "Work", URL: "generic+" + hook.URL + "/hook"},
+		},
+	})
+	login := srv.login
+
+	var out bytes.Buffer
+	app := &App{out: &out}
+	if err := app.handleDue(append([]string{"-days", "7", "-notifier", "family"}, login...)); err != nil {
+		t.Fatalf("handleDue() error = %v", err)
+	}
+	for _, want := range []string{soon + "  Saw: Sharpen", soon + "  Drill", "Sent notification"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+	if len(notifications) != 1 || !strings.Contains(notifications[0], soon+"  Drill") {
+		t.Errorf("notifications = %q, want the report", notifications)
+	}
+
+	// Nothing is due within a day, so no notification is sent.
+	notifications = nil
+	out.Reset()
+	if err := app.handleDue(append([]string{"-days", "1", "-notifier", "Family"}, login...)); err != nil {
+		t.Fatalf("handleDue() error = %v", err)
+	}
+	if out.String() != "Nothing is due in the next 1 days\n" || len(notifications) != 0 {
+		t.Errorf("output = %q, notifications = %q, want nothing due and no notification", out.String(), notifications)
+	}
+
+	err := app.handleDue(append([]string{"-notifier", "Work"}, login...))
+	checkError(t, err, true, `notifier "Work" is disabled`)
+
+	err = app.handleDue(append([]string{"-notifier", "Home"}, login...))
+	checkError(t, err, true, `no notifier named "Home"`)
+}
@@ -1,42 +1,148 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/kusold/homebox-export/internal/config"
 	"github.com/kusold/homebox-export/internal/downloader"
+	"github.com/kusold/homebox-export/internal/logger"
+	"github.com/kusold/homebox-export/internal/metrics"
+	"github.com/kusold/homebox-export/internal/progress"
+	"github.com/kusold/homebox-export/internal/snapshot"
+	"github.com/kusold/homebox-export/internal/storage"
 )
 
 func (a *App) parseConfig(args []string) (config.Config, error) {
 	cmd := flag.NewFlagSet("export", flag.ExitOnError)
 
 	var config config.Config
+	finish := registerExportFlags(cmd, &config)
+	registerOutputFlags(cmd, &config)
+	loadProfiles := registerProfilesFlag(cmd, &config)
 
+	if err := cmd.Parse(args); err != nil {
+		return config, err
+	}
+	finish()
+	if err := loadProfiles(); err != nil {
+		return config, err
+	}
+
+	return config, validateRequired(config)
+}
+
+// registerExportFlags registers the flags shared by every command that runs an
+// export. The returned function must be called after parsing to copy list
+// flags into config.
+func registerExportFlags(cmd *flag.FlagSet, config *config.Config) func() {
+	registerServerFlags(cmd, config)
+	cmd.StringVar(&config.DownloadPath, "output", getEnvOrDefault("HOMEBOX_OUTPUT", "export"), "Output directory or s3://, webdav(s):// or sftp:// URL")
+	cmd.IntVar(&config.PageSize, "pagesize", getEnvIntOrDefault("HOMEBOX_PAGESIZE", 100), "Number of items per page")
+	attachmentTypes := cmd.String("attachment-types", os.Getenv("HOMEBOX_ATTACHMENT_TYPES"), "Comma separated attachment types to download (photo, receipt, manual, warranty, attachment)")
+	cmd.BoolVar(&config.TypeFolders, "type-folders", getEnvBoolOrDefault("HOMEBOX_TYPE_FOLDERS", false), "Place attachments in a subfolder per attachment type")
+	cmd.BoolVar(&config.PrimaryOnly, "primary-only", getEnvBoolOrDefault("HOMEBOX_PRIMARY_ONLY", false), "Only export each item's primary photo, named by asset ID, into one flat folder")
+	cmd.BoolVar(&config.Incremental, "incremental", getEnvBoolOrDefault("HOMEBOX_INCREMENTAL", false), "Skip attachments that are unchanged since the last export")
+	cmd.StringVar(&config.LogLevel, "log-level", getEnvOrDefault("HOMEBOX_LOG_LEVEL", "info"), "Log level (debug, info, warn, error)")
+	cmd.StringVar(&config.LogFormat, "log-format", getEnvOrDefault("HOMEBOX_LOG_FORMAT", "text"), "Log format (text, json)")
+	cmd.StringVar(&config.Progress, "progress", getEnvOrDefault("HOMEBOX_PROGRESS", "auto"), "Progress reporting on stderr (auto, tty, json, none)")
+	cmd.StringVar(&config.MetricsFile, "metrics-file", os.Getenv("HOMEBOX_METRICS_FILE"), "Write node_exporter textfile metrics to this path")
+	cmd.StringVar(&config.Storage.S3Endpoint, "s3-endpoint", os.Getenv("HOMEBOX_S3_ENDPOINT"), "Endpoint of an S3-compatible service, e.g. http://minio:9000")
+	cmd.StringVar(&config.Storage.S3Region, "s3-region", os.Getenv("HOMEBOX_S3_REGION"), "S3 region (defaults to AWS_REGION)")
+	cmd.BoolVar(&config.Storage.S3PathStyle, "s3-path-style", getEnvBoolOrDefault("HOMEBOX_S3_PATH_STYLE", false), "Address S3 buckets by path, as needed by most S3-compatible services")
+	cmd.StringVar(&config.Storage.WebDAVUser, "webdav-user", os.Getenv("HOMEBOX_WEBDAV_USER"), "WebDAV username")
+	cmd.StringVar(&config.Storage.WebDAVPassword, "webdav-pass", os.Getenv("HOMEBOX_WEBDAV_PASS"), "WebDAV password")
+	cmd.StringVar(&config.Storage.SFTPPassword, "sftp-pass", os.Getenv("HOMEBOX_SFTP_PASS"), "SFTP password")
+	cmd.StringVar(&config.Storage.SFTPKeyFile, "sftp-key", os.Getenv("HOMEBOX_SFTP_KEY"), "Private key file for SFTP")
+	cmd.StringVar(&config.Storage.SFTPKnownHosts, "sftp-known-hosts", os.Getenv("HOMEBOX_SFTP_KNOWN_HOSTS"), "known_hosts file to verify the SFTP server with (default ~/.ssh/known_hosts)")
+	cmd.BoolVar(&config.Storage.SFTPInsecureIgnoreHostKey, "sftp-insecure", getEnvBoolOrDefault("HOMEBOX_SFTP_INSECURE", false), "Don't verify the SFTP server's host key")
+	recipients := cmd.String("encrypt-recipients", os.Getenv("HOMEBOX_ENCRYPT_RECIPIENTS"), "Comma separated age public keys or recipients files to encrypt attachments to")
+	cmd.StringVar(&config.Passphrase, "encrypt-passphrase", os.Getenv("HOMEBOX_ENCRYPT_PASSPHRASE"), "Encrypt attachments with a passphrase instead of recipients")
+	cmd.BoolVar(&config.Snapshots, "snapshots", getEnvBoolOrDefault("HOMEBOX_SNAPSHOTS", false), "Write every run to a new timestamped snapshot below the output directory")
+	cmd.IntVar(&config.Retention.Last, "keep-last", getEnvIntOrDefault("HOMEBOX_KEEP_LAST", 0), "Number of most recent snapshots to keep")
+	cmd.IntVar(&config.Retention.Daily, "keep-daily", getEnvIntOrDefault("HOMEBOX_KEEP_DAILY", 0), "Number of daily snapshots to keep")
+	cmd.IntVar(&config.Retention.Weekly, "keep-weekly", getEnvIntOrDefault("HOMEBOX_KEEP_WEEKLY", 0), "Number of weekly snapshots to keep")
+	cmd.IntVar(&config.Retention.Monthly, "keep-monthly", getEnvIntOrDefault("HOMEBOX_KEEP_MONTHLY", 0), "Number of monthly snapshots to keep")
+	cmd.IntVar(&config.Retention.Yearly, "keep-yearly", getEnvIntOrDefault("HOMEBOX_KEEP_YEARLY", 0), "Number of yearly snapshots to keep")
+
+	return func() {
+		config.AttachmentTypes = splitList(*attachmentTypes)
+		config.Recipients = splitList(*recipients)
+	}
+}
+
+// registerOutputFlags registers the flags choosing what an export writes
+// besides attachments. The report command doesn't share them as it writes a
+// report instead.
+func registerOutputFlags(cmd *flag.FlagSet, config *config.Config) {
+	cmd.StringVar(&config.Format, "format", getEnvOrDefault("HOMEBOX_FORMAT", "files"), "Export format (files, csv, markdown)")
+	cmd.BoolVar(&config.HTML, "html", getEnvBoolOrDefault("HOMEBOX_HTML", false), "Write a browsable HTML catalog of the items into the output")
+}
+
+// registerServerFlags registers the flags to connect to the Homebox server,
+// which every command talking to the server shares.
+func registerServerFlags(cmd *flag.FlagSet, config *config.Config) {
 	// Default to environment variables if available
 	cmd.StringVar(&config.ServerURL, "server", os.Getenv("HOMEBOX_SERVER"), "Homebox server URL (required)")
 	cmd.StringVar(&config.Username, "user", os.Getenv("HOMEBOX_USER"), "Username for authentication (required)")
 	cmd.StringVar(&config.Password, "pass", os.Getenv("HOMEBOX_PASS"), "Password for authentication (required)")
-	cmd.StringVar(&config.DownloadPath, "output", getEnvOrDefault("HOMEBOX_OUTPUT", "export"), "Output directory")
-	cmd.IntVar(&config.PageSize, "pagesize", getEnvIntOrDefault("HOMEBOX_PAGESIZE", 100), "Number of items per page")
+}
 
-	if err := cmd.Parse(args); err != nil {
-		return config, err
+// registerProfilesFlag registers the flag to export several profiles in one
+// run. The returned function must be called after parsing to load them.
+func registerProfilesFlag(cmd *flag.FlagSet, cfg *config.Config) func() error {
+	path := cmd.String("profiles", os.Getenv("HOMEBOX_PROFILES"), "YAML file of profiles to export, each into its own subdirectory")
+
+	return func() error {
+		if *path == "" {
+			return nil
+		}
+		profiles, err := config.LoadProfiles(*path, cfg.ServerURL)
+		if err != nil {
+			return err
+		}
+		cfg.Profiles = profiles
+		return nil
 	}
+}
 
-	// Validate required flags
+// validateServer checks the credentials to log in with, which come from the
+// profiles if there are any.
+func validateServer(config config.Config) error {
+	if len(config.Profiles) > 0 {
+		return config.ValidateProfiles()
+	}
 	if config.ServerURL == "" {
-		return config, fmt.Errorf("server URL is required")
+		return fmt.Errorf("server URL is required")
 	}
 	if config.Username == "" {
-		return config, fmt.Errorf("username is required")
+		return fmt.Errorf("username is required")
 	}
 	if config.Password == "" {
-		return config, fmt.Errorf("password is required")
+		return fmt.Errorf("password is required")
 	}
-	return config, nil
+	return nil
+}
+
+func validateRequired(config config.Config) error {
+	if err := validateServer(config); err != nil {
+		return err
+	}
+	if _, ok := storage.LocalPath(config.DownloadPath); config.Snapshots && !ok {
+		return fmt.Errorf("snapshots require a local output directory")
+	}
+	// Profiles share every option but the credentials, so checking one of
+	// them checks all.
+	if len(config.Profiles) > 0 {
+		config = config.ForProfile(config.Profiles[0])
+	}
+	return config.Validate()
 }
 
 func (a *App) handleExport(args []string) error {
@@ -46,12 +152,99 @@ func (a *App) handleExport(args []string) error {
 		return fmt.Errorf("failed to parse config: %w", err)
 	}
 
+	start := time.Now()
+	stats, err := runExport(context.Background(), config)
+
+	if config.MetricsFile != "" {
+		run := metrics.Run{Start: start, End: time.Now(), Stats: stats, Err: err}
+		if merr := writeMetricsFile(config.MetricsFile, run); merr != nil {
+			return errors.Join(err, merr)
+		}
+	}
+
+	return err
+}
+
+func runExport(ctx context.Context, config config.Config) (progress.Stats, error) {
+	if len(config.Profiles) > 0 {
+		return runProfileExports(ctx, config)
+	}
+	if config.Snapshots {
+		return runSnapshotExport(ctx, config)
+	}
+	return runExportTo(ctx, config)
+}
+
+// runExportTo runs a single export into config.DownloadPath.
+func runExportTo(ctx context.Context, config config.Config) (progress.Stats, error) {
 	d, err := downloader.New(config)
 	if err != nil {
-		return fmt.Errorf("failed to initialize downloader: %w", err)
+		return progress.Stats{Errors: 1}, fmt.Errorf("failed to initialize downloader: %w", err)
+	}
+
+	defer d.Close()
+
+	err = d.DownloadAllContext(ctx)
+	return d.Stats(), err
+}
+
+// runSnapshotExport exports into a new snapshot below the output directory,
+// hardlinking unchanged attachments from the latest snapshot, and prunes old
+// snapshots once the export succeeded. A failed export leaves no snapshot
+// behind.
+func runSnapshotExport(ctx context.Context, config config.Config) (progress.Stats, error) {
+	log, err := logger.NewSlog(os.Stderr, config.LogLevel, config.LogFormat)
+	if err != nil {
+		return progress.Stats{Errors: 1}, err
+	}
+	root, _ := storage.LocalPath(config.DownloadPath)
+	latest, ok, err := snapshot.Latest(root)
+	if err != nil {
+		return progress.Stats{Errors: 1}, err
+	}
+	pending, err := snapshot.Begin(root, time.Now())
+	if err != nil {
+		return progress.Stats{Errors: 1}, err
+	}
+
+	config.DownloadPath = pending.Path
+	if ok {
+		config.LinkFrom = latest.Path
+	}
+
+	stats, err := runExportTo(ctx, config)
+	if err != nil {
+		if aerr := pending.Abort(); aerr != nil {
+			log.Warn("failed to remove incomplete snapshot", "path", pending.Path, "error", aerr)
+		}
+		return stats, err
+	}
+
+	s, err := pending.Commit()
+	if err != nil {
+		return stats, err
 	}
+	log.Info("snapshot complete", "snapshot", s.Name, "path", s.Path)
 
-	return d.DownloadAll()
+	removed, err := snapshot.Prune(root, config.Retention)
+	for _, r := range removed {
+		log.Info("pruned snapshot", "snapshot", r.Name)
+	}
+	if err != nil {
+		return stats, fmt.Errorf("failed to prune snapshots: %w", err)
+	}
+	return stats, nil
+}
+
+// writeMetricsFile records run in the textfile at path, keeping the last
+// success time and run counters of earlier runs.
+func writeMetricsFile(path string, run metrics.Run) error {
+	recorder := metrics.NewRecorder()
+	if err := recorder.Load(path); err != nil {
+		return err
+	}
+	recorder.Record(run)
+	return recorder.WriteTextfile(path)
 }
 
 func getEnvOrDefault(key, defaultValue string) string {
@@ -69,3 +262,41 @@ func getEnvIntOrDefault(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+func getEnvFloatOrDefault(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	}
+	return defaultValue
+}
+
+func getEnvBoolOrDefault(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return defaultValue
+}
+
+func getEnvDurationOrDefault(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return defaultValue
+}
+
+// splitList splits a comma separated list, dropping empty entries.
+func splitList(value string) []string {
+	var list []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
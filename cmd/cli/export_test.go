@@ -1,8 +1,18 @@
 package cli
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+	"github.com/kusold/homebox-export/internal/snapshot"
 )
 
 func TestHandleExport(t *testing.T) {
@@ -78,6 +88,112 @@ func TestHandleExport(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "primary only",
+			args: []string{
+				"-server", "http://localhost:8080",
+				"-user", "testuser",
+				"-pass", "testpass",
+				"-output", tempDir,
+				"-primary-only",
+			},
+			wantErr: false,
+		},
+		{
+			name: "csv format",
+			args: []string{
+				"-server", "http://localhost:8080",
+				"-user", "testuser",
+				"-pass", "testpass",
+				"-output", tempDir,
+				"-format", "csv",
+			},
+			wantErr: false,
+		},
+		{
+			name: "markdown format",
+			args: []string{
+				"-server", "http://localhost:8080",
+				"-user", "testuser",
+				"-pass", "testpass",
+				"-output", tempDir,
+				"-format", "markdown",
+			},
+			wantErr: false,
+		},
+		{
+			name: "html catalog",
+			args: []string{
+				"-server", "http://localhost:8080",
+				"-user", "testuser",
+				"-pass", "testpass",
+				"-output", tempDir,
+				"-html",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid format",
+			args: []string{
+				"-server", "http://localhost:8080",
+				"-user", "testuser",
+				"-pass", "testpass",
+				"-output", tempDir,
+				"-format", "xml",
+			},
+			wantErr: true,
+			errMsg:  `invalid format "xml" (valid formats: files, csv, markdown)`,
+		},
+		{
+			name: "html with csv",
+			args: []string{
+				"-server", "http://localhost:8080",
+				"-user", "testuser",
+				"-pass", "testpass",
+				"-output", tempDir,
+				"-format", "csv",
+				"-html",
+			},
+			wantErr: true,
+			errMsg:  "the HTML catalog can't be combined with the csv format",
+		},
+		{
+			name: "negative retention",
+			args: []string{
+				"-server", "http://localhost:8080",
+				"-user", "testuser",
+				"-pass", "testpass",
+				"-output", tempDir,
+				"-snapshots",
+				"-keep-daily", "-1",
+			},
+			wantErr: true,
+			errMsg:  "retention counts must not be negative",
+		},
+		{
+			name: "retention without snapshots",
+			args: []string{
+				"-server", "http://localhost:8080",
+				"-user", "testuser",
+				"-pass", "testpass",
+				"-output", tempDir,
+				"-keep-weekly", "4",
+			},
+			wantErr: true,
+			errMsg:  "a retention policy requires snapshots",
+		},
+		{
+			name: "snapshots to s3",
+			args: []string{
+				"-server", "http://localhost:8080",
+				"-user", "testuser",
+				"-pass", "testpass",
+				"-output", "s3://bucket/homebox",
+				"-snapshots",
+			},
+			wantErr: true,
+			errMsg:  "snapshots require a local output directory",
+		},
 		{
 			name: "page size from env",
 			args: []string{
@@ -193,6 +309,140 @@ func TestGetEnvIntOrDefault(t *testing.T) {
 	}
 }
 
+func TestParseConfig_AttachmentTypes(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            []string
+		env             map[string]string
+		wantTypes       []string
+		wantTypeFolders bool
+	}{
+		{
+			name:      "no filter",
+			args:      []string{},
+			wantTypes: nil,
+		},
+		{
+			name:            "flags",
+			args:            []string{"-attachment-types", "photo, receipt", "-type-folders"},
+			wantTypes:       []string{"photo", "receipt"},
+			wantTypeFolders: true,
+		},
+		{
+			name: "environment variables",
+			args: []string{},
+			env: map[string]string{
+				"HOMEBOX_ATTACHMENT_TYPES": "manual,warranty",
+				"HOMEBOX_TYPE_FOLDERS":     "true",
+			},
+			wantTypes:       []string{"manual", "warranty"},
+			wantTypeFolders: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer setupTestEnvironment(tt.env)()
+
+			args := append([]string{
+				"-server", "http://localhost:8080",
+				"-user", "testuser",
+				"-pass", "testpass",
+				"-output", t.TempDir(),
+			}, tt.args...)
+
+			app := New()
+			cfg, err := app.parseConfig(args)
+			if err != nil {
+				t.Fatalf("parseConfig() unexpected error: %v", err)
+			}
+			if strings.Join(cfg.AttachmentTypes, ",") != strings.Join(tt.wantTypes, ",") {
+				t.Errorf("AttachmentTypes = %v, want %v", cfg.AttachmentTypes, tt.wantTypes)
+			}
+			if cfg.TypeFolders != tt.wantTypeFolders {
+				t.Errorf("TypeFolders = %v, want %v", cfg.TypeFolders, tt.wantTypeFolders)
+			}
+		})
+	}
+}
+
+func TestHandleExport_MetricsFileOnFailure(t *testing.T) {
+	metricsFile := filepath.Join(t.TempDir(), "homebox_export.prom")
+
+	app := New()
+	err := app.handleExport([]string{
+		"-server", "http://127.0.0.1:1",
+		"-user", "testuser",
+		"-pass", "testpass",
+		"-output", t.TempDir(),
+		"-progress", "none",
+		"-metrics-file", metricsFile,
+	})
+	if err == nil {
+		t.Fatal("handleExport() expected error for unreachable server")
+	}
+
+	content, err := os.ReadFile(metricsFile)
+	if err != nil {
+		t.Fatalf("metrics file not written: %v", err)
+	}
+	for _, want := range []string{
+		"homebox_export_last_run_success 0",
+		"homebox_export_failures_total 1",
+		"homebox_export_last_run_errors 1",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("metrics file missing %q\nGot:\n%s", want, content)
+		}
+	}
+}
+
+func TestGetEnvBoolOrDefault(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        string
+		defaultVal bool
+		envValue   string
+		want       bool
+	}{
+		{
+			name:       "valid boolean",
+			key:        "TEST_BOOL",
+			defaultVal: false,
+			envValue:   "true",
+			want:       true,
+		},
+		{
+			name:       "invalid boolean",
+			key:        "TEST_BOOL",
+			defaultVal: true,
+			envValue:   "maybe",
+			want:       true,
+		},
+		{
+			name:       "missing environment variable",
+			key:        "MISSING_BOOL",
+			defaultVal: false,
+			envValue:   "",
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.envValue != "" {
+				os.Setenv(tt.key, tt.envValue)
+				defer os.Unsetenv(tt.key)
+			}
+
+			got := getEnvBoolOrDefault(tt.key, tt.defaultVal)
+			if got != tt.want {
+				t.Errorf("getEnvBoolOrDefault() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 // Helper function to setup test environment
 func setupTestEnvironment(env map[string]string) func() {
 	originalEnv := make(map[string]string)
@@ -228,6 +478,58 @@ func checkError(t *testing.T, err error, wantErr bool, errMsg string) {
 	}
 }
 
+// fakeServer is a fake Homebox for the tests of a command.
+type fakeServer struct {
+	*fakehomebox.Server
+	URL   string
+	login []string // the flags to log in with
+
+	mu       sync.Mutex
+	requests []string // "METHOD /path?query" of every request
+}
+
+// startFake serves fixtures until the test ends. The first user of the
+// fixtures logs in, or any user if they have none.
+func startFake(t *testing.T, fixtures fakehomebox.Fixtures) *fakeServer {
+	t.Helper()
+	f := &fakeServer{Server: fakehomebox.New(fixtures)}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		f.requests = append(f.requests, r.Method+" "+r.URL.RequestURI())
+		f.mu.Unlock()
+		f.Server.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	f.URL = srv.URL
+	user, pass := "user", "pass"
+	if len(fixtures.Users) > 0 {
+		user, pass = fixtures.Users[0].Email, fixtures.Users[0].Password
+	}
+	f.login = []string{"-server", srv.URL, "-user", user, "-pass", pass}
+	return f
+}
+
+// Requests returns the requests the server got so far.
+func (f *fakeServer) Requests() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.requests)
+}
+
+// Queries returns the queries of the GET requests for path so far.
+func (f *fakeServer) Queries(path string) []url.Values {
+	var queries []url.Values
+	for _, req := range f.Requests() {
+		p, query, _ := strings.Cut(strings.TrimPrefix(req, "GET "), "?")
+		if p == path {
+			values, _ := url.ParseQuery(query)
+			queries = append(queries, values)
+		}
+	}
+	return queries
+}
+
 // Helper function to check output directory
 func checkOutputDirectory(t *testing.T, env map[string]string, args []string) {
 	t.Helper()
@@ -255,3 +557,32 @@ func getOutputDirectory(env map[string]string, args []string) string {
 	// Return default value
 	return "export"
 }
+
+func TestHandleExport_SnapshotsToFileURL(t *testing.T) {
+	srv := fakehomebox.New(fakehomebox.Demo()).Start()
+	defer srv.Close()
+	dir := t.TempDir()
+	t.Chdir(t.TempDir())
+
+	app := New()
+	err := app.handleExport([]string{
+		"-server", srv.URL,
+		"-user", "demo@example.com",
+		"-pass", "demo",
+		"-output", "file://" + dir,
+		"-snapshots",
+		"-progress", "none",
+		"-log-level", "error",
+	})
+	if err != nil {
+		t.Fatalf("handleExport() error = %v", err)
+	}
+
+	snapshots, err := snapshot.List(dir)
+	if err != nil || len(snapshots) != 1 {
+		t.Errorf("snapshots in %s = %+v, %v, want 1", dir, snapshots, err)
+	}
+	if entries, _ := os.ReadDir("."); len(entries) != 0 {
+		t.Errorf("export wrote %v to the working directory", entries)
+	}
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+	"github.com/kusold/homebox-export/internal/logger"
+)
+
+type mockServerOptions struct {
+	Listen    string
+	Fixtures  string
+	Latency   time.Duration
+	ErrorRate float64
+	LogLevel  string
+	LogFormat string
+}
+
+func (a *App) parseMockServerConfig(args []string) (mockServerOptions, error) {
+	cmd := flag.NewFlagSet("mock-server", flag.ExitOnError)
+
+	var opts mockServerOptions
+	cmd.StringVar(&opts.Listen, "listen", getEnvOrDefault("HOMEBOX_MOCK_LISTEN", ":7745"), "Address to serve the fake Homebox on")
+	cmd.StringVar(&opts.Fixtures, "fixtures", os.Getenv("HOMEBOX_MOCK_FIXTURES"), "YAML or JSON file with the inventory to serve (default: a demo household)")
+	cmd.DurationVar(&opts.Latency, "latency", getEnvDurationOrDefault("HOMEBOX_MOCK_LATENCY", 0), "Delay added to every response")
+	cmd.Float64Var(&opts.ErrorRate, "error-rate", getEnvFloatOrDefault("HOMEBOX_MOCK_ERROR_RATE", 0), "Fraction of requests to fail with 500, e.g. 0.1")
+	cmd.StringVar(&opts.LogLevel, "log-level", getEnvOrDefault("HOMEBOX_LOG_LEVEL", "info"), "Log level (debug, info, warn, error)")
+	cmd.StringVar(&opts.LogFormat, "log-format", getEnvOrDefault("HOMEBOX_LOG_FORMAT", "text"), "Log format (text, json)")
+
+	if err := cmd.Parse(args); err != nil {
+		return opts, err
+	}
+
+	if opts.Listen == "" {
+		return opts, fmt.Errorf("listen address is required")
+	}
+	if opts.Latency < 0 {
+		return opts, fmt.Errorf("-latency must not be negative")
+	}
+	if opts.ErrorRate < 0 || opts.ErrorRate > 1 {
+		return opts, fmt.Errorf("-error-rate must be between 0 and 1")
+	}
+	return opts, nil
+}
+
+// handleMockServer serves a fake Homebox until interrupted, to try the other
+// commands against without a real server.
+func (a *App) handleMockServer(args []string) error {
+	opts, err := a.parseMockServerConfig(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	log, err := logger.NewSlog(os.Stderr, opts.LogLevel, opts.LogFormat)
+	if err != nil {
+		return err
+	}
+	fixtures := fakehomebox.Demo()
+	if opts.Fixtures != "" {
+		if fixtures, err = fakehomebox.LoadFixtures(opts.Fixtures); err != nil {
+			return err
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	shutdown, err := startServer(opts.Listen, newMockServer(fixtures, opts, log), log)
+	if err != nil {
+		return err
+	}
+	defer shutdown()
+
+	if len(fixtures.Users) == 0 {
+		fmt.Fprintln(a.out, "Log in with any username and password")
+	}
+	for _, u := range fixtures.Users {
+		fmt.Fprintf(a.out, "Log in as %s with password %q\n", u.Email, u.Password)
+	}
+	<-ctx.Done()
+	return nil
+}
+
+// newMockServer returns the fake Homebox for fixtures with the faults of opts,
+// logging every request.
+func newMockServer(fixtures fakehomebox.Fixtures, opts mockServerOptions, log *slog.Logger) http.Handler {
+	fake := fakehomebox.New(fixtures)
+	if opts.Latency > 0 {
+		fake.AddFault(fakehomebox.Fault{Latency: opts.Latency})
+	}
+	if opts.ErrorRate > 0 {
+		fake.AddFault(fakehomebox.Fault{Status: http.StatusInternalServerError, Rate: opts.ErrorRate})
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		fake.ServeHTTP(rec, r)
+		log.Info("request", "method", r.Method, "path", r.URL.Path, "status", rec.status, "duration", time.Since(start).Round(time.Millisecond))
+	})
+}
+
+// statusRecorder remembers the status of a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
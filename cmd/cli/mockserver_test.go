@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+)
+
+func TestParseMockServerConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantListen  string
+		wantLatency time.Duration
+		wantErr     bool
+		errMsg      string
+	}{
+		{
+			name:       "defaults",
+			wantListen: ":7745",
+		},
+		{
+			name:        "faults",
+			args:        []string{"-listen", "127.0.0.1:9000", "-latency", "200ms", "-error-rate", "0.1"},
+			wantListen:  "127.0.0.1:9000",
+			wantLatency: 200 * time.Millisecond,
+		},
+		{
+			name:    "error rate above 1",
+			args:    []string{"-error-rate", "10"},
+			wantErr: true,
+			errMsg:  "-error-rate must be between 0 and 1",
+		},
+		{
+			name:    "negative latency",
+			args:    []string{"-latency", "-1s"},
+			wantErr: true,
+			errMsg:  "-latency must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := New()
+			opts, err := app.parseMockServerConfig(tt.args)
+			checkError(t, err, tt.wantErr, tt.errMsg)
+			if err != nil {
+				return
+			}
+			if opts.Listen != tt.wantListen || opts.Latency != tt.wantLatency {
+				t.Errorf("listen = %q, latency = %v, want %q and %v", opts.Listen, opts.Latency, tt.wantListen, tt.wantLatency)
+			}
+		})
+	}
+}
+
+func TestMockServer_Export(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	srv := httptest.NewServer(newMockServer(fakehomebox.Demo(), mockServerOptions{}, log))
+	defer srv.Close()
+
+	output := t.TempDir()
+	app := &App{out: &bytes.Buffer{}}
+	err := app.Execute([]string{"export", "-server", srv.URL, "-user", "demo@example.com", "-pass", "demo", "-output", output, "-progress", "none", "-log-level", "error"})
+	if err != nil {
+		t.Fatalf("export error = %v", err)
+	}
+
+	photos, _ := filepath.Glob(filepath.Join(output, "*", "*.svg"))
+	manuals, _ := filepath.Glob(filepath.Join(output, "*", "manual.txt"))
+	if len(photos) != 2 || len(manuals) != 1 {
+		t.Fatalf("exported photos %q and manuals %q, want 2 and 1", photos, manuals)
+	}
+	if data, err := os.ReadFile(manuals[0]); err != nil || string(data) != "Charge the battery fully before first use.\n" {
+		t.Errorf("manual = %q, %v", data, err)
+	}
+}
+
+func TestMockServer_ErrorRate(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	srv := httptest.NewServer(newMockServer(fakehomebox.Demo(), mockServerOptions{ErrorRate: 1}, log))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/api/v1/status")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", resp.StatusCode)
+	}
+}
@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/kusold/homebox-export/internal/config"
+	"github.com/kusold/homebox-export/internal/encryption"
+	"github.com/kusold/homebox-export/internal/logger"
+	"github.com/kusold/homebox-export/internal/progress"
+	"github.com/kusold/homebox-export/internal/storage"
+)
+
+// manifestFilename is the file a multi-profile export describes its profiles
+// in, at the root of the output.
+const manifestFilename = "manifest.json"
+
+// manifest describes a multi-profile export.
+type manifest struct {
+	ExportedAt time.Time         `json:"exportedAt"`
+	Profiles   []manifestProfile `json:"profiles"`
+	Total      manifestTotal     `json:"total"`
+}
+
+// manifestCounts are the counts of an export.
+type manifestCounts struct {
+	Items       int   `json:"items"`
+	Attachments int   `json:"attachments"`
+	Skipped     int   `json:"skipped"`
+	Bytes       int64 `json:"bytes"`
+	Errors      int   `json:"errors"`
+}
+
+func newManifestCounts(stats progress.Stats) manifestCounts {
+	return manifestCounts{
+		Items:       stats.ItemsDone,
+		Attachments: stats.Attachments,
+		Skipped:     stats.Skipped,
+		Bytes:       stats.Bytes,
+		Errors:      stats.Errors,
+	}
+}
+
+type manifestProfile struct {
+	Name   string `json:"name"`
+	Server string `json:"server"`
+	User   string `json:"user"`
+	Path   string `json:"path"` // relative to the manifest
+	manifestCounts
+	Error string `json:"error,omitempty"`
+}
+
+// manifestTotal adds up the counts of all profiles.
+type manifestTotal struct {
+	Profiles int `json:"profiles"`
+	Failed   int `json:"failed"`
+	manifestCounts
+}
+
+// runProfileExports exports every profile into its own subdirectory of the
+// output, one after the other, and writes a manifest of all of them next to
+// the subdirectories. A failing profile doesn't stop the others; the returned
+// stats, the manifest's total and the final log line add up all of them.
+func runProfileExports(ctx context.Context, cfg config.Config) (progress.Stats, error) {
+	log, err := logger.NewSlog(os.Stderr, cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		return progress.Stats{Errors: 1}, err
+	}
+
+	m := manifest{ExportedAt: time.Now().UTC()}
+	var total progress.Stats
+	var errs []error
+	for _, p := range cfg.Profiles {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, fmt.Errorf("interrupted: %w", err))
+			break
+		}
+
+		log.Info("exporting profile", "profile", p.Name, "server", p.ServerURL, "user", p.Username)
+		stats, err := runExport(ctx, cfg.ForProfile(p))
+		total = total.Add(stats)
+
+		entry := manifestProfile{
+			Name:           p.Name,
+			Server:         p.ServerURL,
+			User:           p.Username,
+			Path:           p.Name,
+			manifestCounts: newManifestCounts(stats),
+		}
+		if err != nil {
+			log.Error("profile export failed", "profile", p.Name, "error", err)
+			entry.Error = err.Error()
+			errs = append(errs, fmt.Errorf("profile %s: %w", p.Name, err))
+			m.Total.Failed++
+		}
+		m.Profiles = append(m.Profiles, entry)
+	}
+	m.Total.Profiles = len(m.Profiles)
+	m.Total.manifestCounts = newManifestCounts(total)
+
+	if err := writeManifest(ctx, cfg, m); err != nil {
+		total.Errors++
+		errs = append(errs, err)
+	}
+	log.Info("all profiles exported",
+		"profiles", m.Total.Profiles,
+		"failed", m.Total.Failed,
+		"items", total.ItemsDone,
+		"attachments", total.Attachments,
+		"skipped", total.Skipped,
+		"bytes", total.Bytes,
+		"errors", total.Errors,
+	)
+	return total, errors.Join(errs...)
+}
+
+// writeManifest writes m into the root of the output, encrypted like the
+// exports it describes, as it names the servers and users.
+func writeManifest(ctx context.Context, cfg config.Config, m manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	sink, err := storage.Open(ctx, cfg.DownloadPath, cfg.Storage)
+	if err != nil {
+		return fmt.Errorf("failed to open output: %w", err)
+	}
+	if c, ok := sink.(io.Closer); ok {
+		defer c.Close()
+	}
+	if len(cfg.Recipients) > 0 || cfg.Passphrase != "" {
+		recipients, err := encryption.ParseRecipients(cfg.Recipients, cfg.Passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to setup encryption: %w", err)
+		}
+		if sink, err = encryption.NewSink(sink, recipients...); err != nil {
+			return fmt.Errorf("failed to setup encryption: %w", err)
+		}
+	}
+	if _, err := sink.Put(ctx, manifestFilename, bytes.NewReader(data), int64(len(data)), time.Now()); err != nil {
+		return fmt.Errorf("failed to write %s: %w", manifestFilename, err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/config"
+	"github.com/kusold/homebox-export/internal/encryption"
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+)
+
+func TestHandleExport_Profiles(t *testing.T) {
+	// One item for alice; any other login is rejected.
+	srv := startFake(t, fakehomebox.Fixtures{
+		Users: []fakehomebox.User{{Email: "alice", Password: "secret"}},
+		Items: []fakehomebox.Item{{Item: homeboxclient.Item{Name: "TV", Quantity: 1}}},
+	})
+	output := t.TempDir()
+	profiles := filepath.Join(t.TempDir(), "profiles.yaml")
+	content := `profiles:
+  - name: parents
+    user: alice
+    pass: secret
+  - name: kids
+    user: bob
+    pass: wrong
+`
+	if err := os.WriteFile(profiles, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	app := New()
+	err := app.handleExport([]string{"-server", srv.URL, "-profiles", profiles, "-output", output, "-format", "csv", "-progress", "none"})
+	if err == nil || !strings.Contains(err.Error(), "profile kids: ") {
+		t.Errorf("handleExport() error = %v, want the kids profile to fail", err)
+	}
+
+	// The failing profile doesn't keep the other one from being exported.
+	if _, err := os.Stat(filepath.Join(output, "parents", "items.csv")); err != nil {
+		t.Errorf("parents export missing: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(output, "manifest.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Profiles) != 2 {
+		t.Fatalf("manifest profiles = %+v, want 2", m.Profiles)
+	}
+	parents, kids := m.Profiles[0], m.Profiles[1]
+	if parents.Name != "parents" || parents.Path != "parents" || parents.User != "alice" || parents.Server != srv.URL || parents.Items != 1 || parents.Error != "" {
+		t.Errorf("parents = %+v", parents)
+	}
+	if kids.Name != "kids" || kids.Items != 0 || !strings.Contains(kids.Error, "failed to login") {
+		t.Errorf("kids = %+v", kids)
+	}
+	if total := m.Total; total.Profiles != 2 || total.Failed != 1 || total.Items != 1 || total.Errors != kids.Errors+parents.Errors {
+		t.Errorf("total = %+v, want both profiles with the parents' item", total)
+	}
+	if strings.Contains(string(data), "secret") || strings.Contains(string(data), "wrong") {
+		t.Errorf("manifest contains a password:\n%s", data)
+	}
+}
+
+func TestParseConfig_Profiles(t *testing.T) {
+	profiles := filepath.Join(t.TempDir(), "profiles.yaml")
+	if err := os.WriteFile(profiles, []byte("profiles:\n  - name: parents\n    user: alice\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	app := New()
+	_, err := app.parseConfig([]string{"-server", "http://localhost:8080", "-profiles", profiles})
+	checkError(t, err, true, `profile "parents": password is required`)
+}
+
+func TestWriteManifest_Encrypted(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := t.TempDir()
+	cfg := config.Config{DownloadPath: output, Recipients: []string{identity.Recipient().String()}}
+	m := manifest{Profiles: []manifestProfile{{Name: "parents", User: "alice"}}}
+	if err := writeManifest(context.Background(), cfg, m); err != nil {
+		t.Fatalf("writeManifest() error = %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(output, manifestFilename)); !os.IsNotExist(err) {
+		t.Errorf("plain manifest written next to the encrypted one: %v", err)
+	}
+	f, err := os.Open(filepath.Join(output, manifestFilename+encryption.Suffix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var data bytes.Buffer
+	if _, err := encryption.Decrypt(&data, f, identity); err != nil {
+		t.Fatalf("Decrypt() error = %v", err)
+	}
+	if !strings.Contains(data.String(), `"user": "alice"`) {
+		t.Errorf("decrypted manifest = %s", data.String())
+	}
+}
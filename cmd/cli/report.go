@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/kusold/homebox-export/internal/config"
+	"github.com/kusold/homebox-export/internal/downloader"
+	"github.com/kusold/homebox-export/internal/logger"
+	"github.com/kusold/homebox-export/internal/report"
+)
+
+type reportOptions struct {
+	Format string
+	Filter report.Filter
+}
+
+func (a *App) parseReportConfig(args []string) (config.Config, reportOptions, error) {
+	cmd := flag.NewFlagSet("report", flag.ExitOnError)
+
+	var config config.Config
+	var opts reportOptions
+	finish := registerExportFlags(cmd, &config)
+
+	cmd.StringVar(&opts.Format, "format", getEnvOrDefault("HOMEBOX_REPORT_FORMAT", "xlsx"), "Report format (xlsx, pdf)")
+	labels := cmd.String("label", os.Getenv("HOMEBOX_REPORT_LABELS"), "Comma separated labels; only items with any of them are reported")
+	cmd.StringVar(&opts.Filter.Location, "location", os.Getenv("HOMEBOX_REPORT_LOCATION"), "Only report items in this location or below it, e.g. \"Home / Garage\"")
+
+	if err := cmd.Parse(args); err != nil {
+		return config, opts, err
+	}
+	finish()
+	opts.Filter.Labels = splitList(*labels)
+
+	if err := validateRequired(config); err != nil {
+		return config, opts, err
+	}
+	if config.Snapshots {
+		return config, opts, fmt.Errorf("report does not take -snapshots, set -output to the snapshot to report on")
+	}
+	switch opts.Format {
+	case "xlsx", "pdf":
+	default:
+		return config, opts, fmt.Errorf("invalid report format %q (valid formats: xlsx, pdf)", opts.Format)
+	}
+	return config, opts, nil
+}
+
+// handleReport writes a report of all items into the output. Links to
+// attachments point at the files an export with the same options writes, so
+// the report belongs next to such an export.
+func (a *App) handleReport(args []string) error {
+	config, opts, err := a.parseReportConfig(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	log, err := logger.NewSlog(os.Stderr, config.LogLevel, config.LogFormat)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	d, err := downloader.New(config)
+	if err != nil {
+		return fmt.Errorf("failed to initialize downloader: %w", err)
+	}
+	defer d.Close()
+
+	items, err := d.FetchItems(ctx)
+	if err != nil {
+		return err
+	}
+	locations, err := d.Locations()
+	if err != nil {
+		return err
+	}
+	r := report.New(items, locations, d.AttachmentPath)
+	r.Filter(opts.Filter)
+
+	var buf bytes.Buffer
+	switch opts.Format {
+	case "xlsx":
+		err = r.WriteXLSX(&buf)
+	case "pdf":
+		err = r.WritePDF(&buf, d.PrimaryPhoto, log)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to render report: %w", err)
+	}
+
+	name := "report." + opts.Format
+	if err := d.WriteFile(ctx, name, buf.Bytes()); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.out, "Wrote report of %d items to %s\n", len(r.Rows), path.Join(config.DownloadPath, name))
+	return nil
+}
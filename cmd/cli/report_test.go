@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kusold/homebox-export/internal/report"
+)
+
+func TestParseReportConfig(t *testing.T) {
+	login := []string{"-server", "http://localhost:8080", "-user", "testuser", "-pass", "testpass"}
+
+	tests := []struct {
+		name       string
+		args       []string
+		env        map[string]string
+		wantFormat string
+		wantFilter report.Filter
+		wantErr    bool
+		errMsg     string
+	}{
+		{
+			name:       "default format",
+			args:       login,
+			wantFormat: "xlsx",
+		},
+		{
+			name:       "format from env",
+			args:       login,
+			env:        map[string]string{"HOMEBOX_REPORT_FORMAT": "xlsx"},
+			wantFormat: "xlsx",
+		},
+		{
+			name:       "pdf with filter",
+			args:       append([]string{"-format", "pdf", "-label", "Tools,Electronics", "-location", "Home / Garage"}, login...),
+			wantFormat: "pdf",
+			wantFilter: report.Filter{Labels: []string{"Tools", "Electronics"}, Location: "Home / Garage"},
+		},
+		{
+			name:    "invalid format",
+			args:    append([]string{"-format", "ods"}, login...),
+			wantErr: true,
+			errMsg:  `invalid report format "ods" (valid formats: xlsx, pdf)`,
+		},
+		{
+			name:    "snapshots",
+			args:    append([]string{"-snapshots"}, login...),
+			wantErr: true,
+			errMsg:  "report does not take -snapshots, set -output to the snapshot to report on",
+		},
+		{
+			name:    "missing server",
+			args:    []string{"-user", "testuser", "-pass", "testpass"},
+			wantErr: true,
+			errMsg:  "server URL is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer setupTestEnvironment(tt.env)()
+
+			app := New()
+			_, opts, err := app.parseReportConfig(tt.args)
+			checkError(t, err, tt.wantErr, tt.errMsg)
+			if err != nil {
+				return
+			}
+			if opts.Format != tt.wantFormat {
+				t.Errorf("Format = %q, want %q", opts.Format, tt.wantFormat)
+			}
+			if !reflect.DeepEqual(opts.Filter, tt.wantFilter) {
+				t.Errorf("Filter = %+v, want %+v", opts.Filter, tt.wantFilter)
+			}
+		})
+	}
+}
@@ -10,11 +10,13 @@ const Version = "0.0.1"
 
 type App struct {
 	out io.Writer
+	in  io.Reader
 }
 
 func New() *App {
 	return &App{
 		out: os.Stdout,
+		in:  os.Stdin,
 	}
 }
 
@@ -33,6 +35,28 @@ func (a *App) Execute(args []string) error {
 		return nil
 	case "export":
 		return a.handleExport(args[1:])
+	case "serve":
+		return a.handleServe(args[1:])
+	case "report":
+		return a.handleReport(args[1:])
+	case "export-calendar":
+		return a.handleCalendar(args[1:])
+	case "stats":
+		return a.handleStats(args[1:])
+	case "due":
+		return a.handleDue(args[1:])
+	case "asset":
+		return a.handleAsset(args[1:])
+	case "doctor":
+		return a.handleDoctor(args[1:])
+	case "admin":
+		return a.handleAdmin(args[1:])
+	case "sync":
+		return a.handleSync(args[1:])
+	case "mock-server":
+		return a.handleMockServer(args[1:])
+	case "decrypt":
+		return a.handleDecrypt(args[1:])
 	default:
 		return fmt.Errorf("unknown command %q\nRun 'homebox-export help' for usage", args[0])
 	}
@@ -43,6 +67,18 @@ func (a *App) printHelp() {
 
 Commands:
   export        Download all items and their attachments
+  serve         Run incremental exports on a schedule
+  report        Write an inventory report of all items
+  export-calendar
+                Write maintenance and warranty dates as an .ics calendar
+  stats         Print totals and value by location, label and over time
+  due           Report maintenance and warranties due soon, and notify
+  asset         Look up an item by asset ID, or print QR labels for items
+  doctor        Check the server, credentials and API before exporting
+  admin         Run one of the server's maintenance actions on all items
+  sync          Copy the inventory to another Homebox server or account
+  mock-server   Serve a fake Homebox with demo data, to try the commands
+  decrypt       Decrypt an encrypted export
   help          Show this help message
   version       Show version information
 
@@ -50,19 +86,206 @@ Export Options:
   -server       Homebox server URL
   -user         Username for authentication
   -pass         Password for authentication
-  -output       Output directory (default: ./downloads)
+  -profiles     YAML file of users to export, each into its own
+                subdirectory of the output, instead of -user and -pass
+                (export and serve only)
+  -output       Output directory or s3://, webdav(s):// or sftp:// URL
+                (default: ./downloads)
   -pagesize     Number of items per page (default: 100)
+  -s3-endpoint  Endpoint of an S3-compatible service, e.g. http://minio:9000
+  -s3-region    S3 region (default: AWS_REGION)
+  -s3-path-style
+                Address buckets by path, as most S3-compatible services need
+  -webdav-user, -webdav-pass
+                WebDAV credentials, unless given in the URL
+  -sftp-pass    SFTP password, unless given in the URL
+  -sftp-key     Private key file for SFTP
+  -sftp-known-hosts
+                known_hosts file for SFTP (default: ~/.ssh/known_hosts)
+  -sftp-insecure
+                Don't verify the SFTP server's host key
+  -attachment-types
+                Comma separated attachment types to download
+                (photo, receipt, manual, warranty, attachment)
+  -format      Export format: files, csv for a single items.csv in
+                Homebox's import format, or markdown to add a note per item,
+                location and label to the files (default: files)
+  -html         Write a browsable HTML catalog of the items into the output
+  -type-folders Place attachments in a subfolder per attachment type
+  -primary-only Only export each item's primary photo as <assetId>.<ext>
+                into one flat folder
+  -log-level    Log level: debug, info, warn, error (default: info)
+  -log-format   Log format: text, json (default: text)
+  -progress     Progress on stderr: auto, tty, json, none (default: auto)
+  -incremental  Skip attachments that are unchanged since the last export
+  -metrics-file Write node_exporter textfile metrics to this path
+  -encrypt-recipients
+                Comma separated age public keys or recipients files to
+                encrypt attachments to
+  -encrypt-passphrase
+                Encrypt attachments with a passphrase instead
+  -snapshots    Write every run to a new timestamped snapshot below the
+                output directory, hardlinking unchanged attachments
+  -keep-last, -keep-daily, -keep-weekly, -keep-monthly, -keep-yearly
+                Number of snapshots to keep per period (default: keep all)
+
+Serve Options (in addition to the export options):
+  -schedule     Cron schedule for exports, e.g. "0 3 * * *"
+  -jitter       Random delay added to every scheduled run, e.g. 10m
+  -listen       Address for /healthz and /metrics (default: :8080)
+  -metrics      Expose Prometheus metrics on /metrics
+  -run-on-start Run an export immediately on startup
+
+Report Options (in addition to the export options, except -format and -html):
+  -format       Report format: xlsx, pdf (default: xlsx)
+  -label        Comma separated labels; only report items with any of them
+  -location     Only report items in this location or below it
+
+Export Calendar Options (in addition to the export options, except -format
+and -html):
+  -status       Maintenance to include: scheduled, completed, both
+                (default: both)
+  -warranties   Also add the day each item's warranty expires
+
+Stats Options (-server, -user and -pass as for export):
+  -from         Start of the value over time, YYYY-MM-DD (default: a year ago)
+  -to           End of the value over time, YYYY-MM-DD (default: today)
+
+Due Options (-server, -user and -pass as for export):
+  -days         Report what is due within this many days (default: 30)
+  -notifier     Name or ID of a Homebox notifier to send the report through
+  -notify-url   Shoutrrr URL to send the report to, e.g. ntfy://ntfy.sh/topic
+
+Asset Options (-server, -user and -pass as for export):
+  homebox-export asset [options] <asset-id>
+  -json         Print the item as JSON
+  -sheet        Write a sheet of QR labels to this .pdf or .png file
+                instead of looking up an item
+  -label        Comma separated labels; only label items with any of them
+  -location     Only label items in this location or below it
+  -public-url   Homebox URL the QR codes link to (default: -server)
+
+Admin Options (in addition to the export options):
+  homebox-export admin [options] <action>
+  -backup       Export into -output before running the action
+  -yes          Don't ask for confirmation
+  Actions:
+    ensure-asset-ids      Assign an asset ID to every item without one
+    ensure-import-refs    Assign an import ref to every item without one
+    set-primary-photos    Make each item's first photo its primary photo
+    zero-item-time-fields Reset the time of day of the items' dates
+
+Sync Options:
+  -from, -from-user, -from-pass
+                Server and credentials to copy from
+  -to, -to-user, -to-pass
+                Server and credentials to copy to
+  -state        File recording what was copied, to resume an interrupted
+                sync (default: homebox-sync.json)
+  -conflicts    What to do with items the target already has: fail, skip,
+                duplicate (default: fail)
+  -dry-run      Only print what would be copied
+  -log-level, -log-format
+                As for export
+
+Mock Server Options:
+  -listen       Address to serve the fake Homebox on (default: :7745)
+  -fixtures     YAML or JSON file with the inventory to serve
+                (default: a demo household)
+  -latency      Delay added to every response, e.g. 200ms
+  -error-rate   Fraction of requests to fail with 500, e.g. 0.1
+  -log-level, -log-format
+                As for export
+
+Decrypt Options:
+  -input        Directory holding the encrypted export (default: ./export)
+  -output       Directory to write the decrypted files to
+  -identity     Comma separated age identity files
+  -passphrase   Passphrase the export was encrypted with
 
 Environment Variables:
   HOMEBOX_SERVER   Server URL
   HOMEBOX_USER     Username
   HOMEBOX_PASS     Password
+  HOMEBOX_PROFILES Profiles file
   HOMEBOX_OUTPUT   Output directory
   HOMEBOX_PAGESIZE Number of items per page
+  HOMEBOX_S3_ENDPOINT, HOMEBOX_S3_REGION, HOMEBOX_S3_PATH_STYLE
+                   S3 settings; credentials are read from AWS_ACCESS_KEY_ID
+                   and AWS_SECRET_ACCESS_KEY
+  HOMEBOX_WEBDAV_USER, HOMEBOX_WEBDAV_PASS
+                   WebDAV credentials
+  HOMEBOX_SFTP_PASS, HOMEBOX_SFTP_KEY, HOMEBOX_SFTP_KNOWN_HOSTS,
+  HOMEBOX_SFTP_INSECURE
+                   SFTP settings
+  HOMEBOX_ATTACHMENT_TYPES
+                   Comma separated attachment types to download
+  HOMEBOX_FORMAT   Export format (files, csv, markdown)
+  HOMEBOX_HTML     Write an HTML catalog (true/false)
+  HOMEBOX_TYPE_FOLDERS
+                   Place attachments in a subfolder per type (true/false)
+  HOMEBOX_PRIMARY_ONLY
+                   Only export primary photos (true/false)
+  HOMEBOX_LOG_LEVEL  Log level
+  HOMEBOX_LOG_FORMAT Log format
+  HOMEBOX_PROGRESS   Progress reporting mode
+  HOMEBOX_METRICS_FILE
+                   node_exporter textfile metrics path
+  HOMEBOX_INCREMENTAL
+                   Skip unchanged attachments (true/false)
+  HOMEBOX_ENCRYPT_RECIPIENTS, HOMEBOX_ENCRYPT_PASSPHRASE
+                   Encryption settings
+  HOMEBOX_REPORT_FORMAT
+                   Report format
+  HOMEBOX_REPORT_LABELS, HOMEBOX_REPORT_LOCATION
+                   Report filters
+  HOMEBOX_CALENDAR_STATUS, HOMEBOX_CALENDAR_WARRANTIES
+                   Calendar contents
+  HOMEBOX_STATS_FROM, HOMEBOX_STATS_TO
+                   Date range for stats
+  HOMEBOX_DUE_DAYS, HOMEBOX_DUE_NOTIFIER, HOMEBOX_DUE_NOTIFY_URL
+                   Due report settings
+  HOMEBOX_PUBLIC_URL
+                   Homebox URL QR labels link to
+  HOMEBOX_SYNC_FROM, HOMEBOX_SYNC_FROM_USER, HOMEBOX_SYNC_FROM_PASS,
+  HOMEBOX_SYNC_TO, HOMEBOX_SYNC_TO_USER, HOMEBOX_SYNC_TO_PASS
+                   Source and target of sync
+  HOMEBOX_SYNC_STATE, HOMEBOX_SYNC_CONFLICTS, HOMEBOX_SYNC_DRY_RUN
+                   Sync settings
+  HOMEBOX_MOCK_LISTEN, HOMEBOX_MOCK_FIXTURES, HOMEBOX_MOCK_LATENCY,
+  HOMEBOX_MOCK_ERROR_RATE
+                   Mock server settings
+  HOMEBOX_DECRYPT_IDENTITY
+                   Identity files for decrypt
+  HOMEBOX_SNAPSHOTS
+                   Write timestamped snapshots (true/false)
+  HOMEBOX_KEEP_LAST, HOMEBOX_KEEP_DAILY, HOMEBOX_KEEP_WEEKLY,
+  HOMEBOX_KEEP_MONTHLY, HOMEBOX_KEEP_YEARLY
+                   Snapshot retention counts
+  HOMEBOX_SCHEDULE Cron schedule for serve
+  HOMEBOX_JITTER   Random delay added to scheduled runs
+  HOMEBOX_LISTEN   Address for the serve endpoints
+  HOMEBOX_METRICS  Expose /metrics when serving (true/false)
+  HOMEBOX_RUN_ON_START
+                   Run an export when serve starts (true/false)
 
 Examples:
   homebox-export export -server http://homebox.local -user admin -pass secret
   homebox-export export -output ./my-backup
+  homebox-export export -attachment-types photo,receipt -type-folders
+  homebox-export export -output s3://backups/homebox -s3-endpoint http://minio:9000 -s3-path-style
+  homebox-export export -output sftp://backup@nas/volume1/homebox -sftp-key ~/.ssh/id_ed25519
+  homebox-export export -encrypt-recipients age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p
+  homebox-export decrypt -input ./export -output ./plain -identity key.txt
+  homebox-export export -snapshots -keep-daily 7 -keep-weekly 4 -keep-monthly 12
+  homebox-export export -server http://homebox.local -profiles profiles.yaml
+  homebox-export serve -schedule "0 3 * * *" -jitter 15m -metrics
+  homebox-export export-calendar -warranties -output webdavs://cloud.example.com/remote.php/dav/files/me/Homebox
+  homebox-export due -days 14 -notifier Family
+  homebox-export asset -sheet labels.pdf -location "Home / Garage"
+  homebox-export admin -backup -output ./before-cleanup ensure-asset-ids
+  homebox-export sync -from http://old.local -from-user me -to https://new.example.com -to-user me
+  homebox-export mock-server -latency 200ms -error-rate 0.05
 
 For more information, visit: https://github.com/kusold/homebox-export`
 
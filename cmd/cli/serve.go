@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kusold/homebox-export/internal/config"
+	"github.com/kusold/homebox-export/internal/logger"
+	"github.com/kusold/homebox-export/internal/metrics"
+	"github.com/kusold/homebox-export/internal/scheduler"
+)
+
+// shutdownTimeout bounds how long the HTTP server waits for open requests
+// when the daemon stops.
+const shutdownTimeout = 10 * time.Second
+
+type serveOptions struct {
+	Schedule   string
+	Jitter     time.Duration
+	Listen     string
+	Metrics    bool
+	RunOnStart bool
+}
+
+func (a *App) parseServeConfig(args []string) (config.Config, serveOptions, error) {
+	cmd := flag.NewFlagSet("serve", flag.ExitOnError)
+
+	var config config.Config
+	var opts serveOptions
+	finish := registerExportFlags(cmd, &config)
+	registerOutputFlags(cmd, &config)
+	loadProfiles := registerProfilesFlag(cmd, &config)
+
+	cmd.StringVar(&opts.Schedule, "schedule", os.Getenv("HOMEBOX_SCHEDULE"), "Cron schedule for exports, e.g. \"0 3 * * *\" (required)")
+	cmd.DurationVar(&opts.Jitter, "jitter", getEnvDurationOrDefault("HOMEBOX_JITTER", 0), "Random delay added to every scheduled run")
+	cmd.StringVar(&opts.Listen, "listen", getEnvOrDefault("HOMEBOX_LISTEN", ":8080"), "Address for the health and metrics endpoints (empty disables)")
+	cmd.BoolVar(&opts.Metrics, "metrics", getEnvBoolOrDefault("HOMEBOX_METRICS", false), "Expose Prometheus metrics on /metrics")
+	cmd.BoolVar(&opts.RunOnStart, "run-on-start", getEnvBoolOrDefault("HOMEBOX_RUN_ON_START", false), "Run an export immediately on startup")
+
+	if err := cmd.Parse(args); err != nil {
+		return config, opts, err
+	}
+	finish()
+	if err := loadProfiles(); err != nil {
+		return config, opts, err
+	}
+
+	// Scheduled runs only download what changed since the previous run.
+	config.Incremental = true
+
+	if err := validateRequired(config); err != nil {
+		return config, opts, err
+	}
+	if opts.Schedule == "" {
+		return config, opts, fmt.Errorf("schedule is required")
+	}
+	return config, opts, nil
+}
+
+func (a *App) handleServe(args []string) error {
+	config, opts, err := a.parseServeConfig(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	log, err := logger.NewSlog(os.Stderr, config.LogLevel, config.LogFormat)
+	if err != nil {
+		return err
+	}
+
+	recorder := metrics.NewRecorder()
+	if config.MetricsFile != "" {
+		if err := recorder.Load(config.MetricsFile); err != nil {
+			return err
+		}
+	}
+
+	job := func(ctx context.Context) error {
+		start := time.Now()
+		stats, err := runExport(ctx, config)
+		recorder.Record(metrics.Run{Start: start, End: time.Now(), Stats: stats, Err: err})
+		if config.MetricsFile != "" {
+			if merr := recorder.WriteTextfile(config.MetricsFile); merr != nil {
+				log.Error("failed to write metrics file", "error", merr)
+			}
+		}
+		return err
+	}
+
+	sched, err := scheduler.New(opts.Schedule, job, scheduler.WithJitter(opts.Jitter), scheduler.WithLogger(log))
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if opts.Listen != "" {
+		shutdown, err := startServer(opts.Listen, newServeMux(sched, recorder, opts.Metrics), log)
+		if err != nil {
+			return err
+		}
+		defer shutdown()
+	}
+
+	if opts.RunOnStart {
+		sched.Trigger(ctx)
+	}
+
+	return sched.Run(ctx)
+}
+
+func newServeMux(sched *scheduler.Scheduler, recorder *metrics.Recorder, withMetrics bool) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("GET /healthz", sched.HealthHandler())
+	if withMetrics {
+		mux.Handle("GET /metrics", recorder)
+	}
+	return mux
+}
+
+// startServer listens on addr and serves handler in the background. The
+// returned function gracefully shuts the server down.
+func startServer(addr string, handler http.Handler, log *slog.Logger) (func(), error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	srv := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("http server failed", "error", err)
+		}
+	}()
+	log.Info("listening", "addr", ln.Addr().String())
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		srv.Shutdown(ctx)
+	}, nil
+}
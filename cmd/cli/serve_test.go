@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kusold/homebox-export/internal/metrics"
+	"github.com/kusold/homebox-export/internal/scheduler"
+)
+
+func TestParseServeConfig(t *testing.T) {
+	baseArgs := []string{
+		"-server", "http://localhost:8080",
+		"-user", "testuser",
+		"-pass", "testpass",
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		env        map[string]string
+		wantErr    bool
+		errMsg     string
+		wantJitter time.Duration
+		wantListen string
+	}{
+		{
+			name:       "schedule flag",
+			args:       append([]string{"-schedule", "0 3 * * *"}, baseArgs...),
+			wantListen: ":8080",
+		},
+		{
+			name:       "environment variables",
+			args:       baseArgs,
+			env:        map[string]string{"HOMEBOX_SCHEDULE": "@daily", "HOMEBOX_JITTER": "5m", "HOMEBOX_LISTEN": ":9100"},
+			wantJitter: 5 * time.Minute,
+			wantListen: ":9100",
+		},
+		{
+			name:    "missing schedule",
+			args:    baseArgs,
+			wantErr: true,
+			errMsg:  "schedule is required",
+		},
+		{
+			name:    "missing server",
+			args:    []string{"-schedule", "@daily", "-user", "testuser", "-pass", "testpass"},
+			wantErr: true,
+			errMsg:  "server URL is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer setupTestEnvironment(tt.env)()
+
+			app := New()
+			cfg, opts, err := app.parseServeConfig(tt.args)
+			checkError(t, err, tt.wantErr, tt.errMsg)
+			if tt.wantErr {
+				return
+			}
+			if !cfg.Incremental {
+				t.Error("serve should always run incremental exports")
+			}
+			if opts.Jitter != tt.wantJitter {
+				t.Errorf("Jitter = %v, want %v", opts.Jitter, tt.wantJitter)
+			}
+			if opts.Listen != tt.wantListen {
+				t.Errorf("Listen = %q, want %q", opts.Listen, tt.wantListen)
+			}
+		})
+	}
+}
+
+func TestNewServeMux(t *testing.T) {
+	sched, err := scheduler.New("@daily", func(context.Context) error { return nil })
+	if err != nil {
+		t.Fatalf("scheduler.New() error = %v", err)
+	}
+	recorder := metrics.NewRecorder()
+
+	tests := []struct {
+		name        string
+		withMetrics bool
+		path        string
+		wantCode    int
+	}{
+		{name: "health", path: "/healthz", wantCode: http.StatusOK},
+		{name: "metrics enabled", withMetrics: true, path: "/metrics", wantCode: http.StatusOK},
+		{name: "metrics disabled", path: "/metrics", wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			newServeMux(sched, recorder, tt.withMetrics).ServeHTTP(rec, httptest.NewRequest("GET", tt.path, nil))
+			if rec.Code != tt.wantCode {
+				t.Errorf("GET %s = %d, want %d", tt.path, rec.Code, tt.wantCode)
+			}
+		})
+	}
+}
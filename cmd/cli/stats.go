@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/config"
+)
+
+type statsOptions struct {
+	From time.Time
+	To   time.Time
+}
+
+func (a *App) parseStatsConfig(args []string) (config.Config, statsOptions, error) {
+	cmd := flag.NewFlagSet("stats", flag.ExitOnError)
+
+	var config config.Config
+	var opts statsOptions
+	registerServerFlags(cmd, &config)
+
+	today := time.Now().Format(time.DateOnly)
+	yearAgo := time.Now().AddDate(-1, 0, 0).Format(time.DateOnly)
+	from := cmd.String("from", getEnvOrDefault("HOMEBOX_STATS_FROM", yearAgo), "Start of the value over time, YYYY-MM-DD")
+	to := cmd.String("to", getEnvOrDefault("HOMEBOX_STATS_TO", today), "End of the value over time, YYYY-MM-DD")
+
+	if err := cmd.Parse(args); err != nil {
+		return config, opts, err
+	}
+
+	if err := validateServer(config); err != nil {
+		return config, opts, err
+	}
+	var err error
+	if opts.From, err = time.Parse(time.DateOnly, *from); err != nil {
+		return config, opts, fmt.Errorf("invalid -from date %q, want YYYY-MM-DD", *from)
+	}
+	if opts.To, err = time.Parse(time.DateOnly, *to); err != nil {
+		return config, opts, fmt.Errorf("invalid -to date %q, want YYYY-MM-DD", *to)
+	}
+	if opts.To.Before(opts.From) {
+		return config, opts, fmt.Errorf("-to must not be before -from")
+	}
+	return config, opts, nil
+}
+
+func (a *App) handleStats(args []string) error {
+	config, opts, err := a.parseStatsConfig(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	client, err := login(config)
+	if err != nil {
+		return err
+	}
+	groups := homeboxclient.NewGroupsService(client)
+
+	var s groupStats
+	if s.group, err = groups.Get(); err != nil {
+		return fmt.Errorf("failed to get group: %w", err)
+	}
+	if s.totals, err = groups.Statistics(); err != nil {
+		return fmt.Errorf("failed to get statistics: %w", err)
+	}
+	if s.locations, err = groups.LocationStatistics(); err != nil {
+		return fmt.Errorf("failed to get location statistics: %w", err)
+	}
+	if s.labels, err = groups.LabelStatistics(); err != nil {
+		return fmt.Errorf("failed to get label statistics: %w", err)
+	}
+	if s.value, err = groups.PurchasePriceStatistics(opts.From, opts.To); err != nil {
+		return fmt.Errorf("failed to get value over time: %w", err)
+	}
+
+	return s.print(a.out)
+}
+
+// login connects to the server and logs in.
+func login(config config.Config) (*homeboxclient.Client, error) {
+	client, err := homeboxclient.NewClient(config.ServerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	if _, err := client.Login(config.Username, config.Password); err != nil {
+		return nil, fmt.Errorf("failed to login: %w", err)
+	}
+	return client, nil
+}
+
+// groupStats is everything the stats command prints.
+type groupStats struct {
+	group     *homeboxclient.Group
+	totals    *homeboxclient.GroupStatistics
+	locations []homeboxclient.TotalsByOrganizer
+	labels    []homeboxclient.TotalsByOrganizer
+	value     *homeboxclient.ValueOverTime
+}
+
+func (s groupStats) print(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	currency := strings.TrimSpace(" " + s.group.Currency)
+
+	fmt.Fprintf(w, "%s\n\n", s.group.Name)
+	fmt.Fprintf(w, "Items\t%d\n", s.totals.TotalItems)
+	fmt.Fprintf(w, "With warranty\t%d\n", s.totals.TotalWithWarranty)
+	fmt.Fprintf(w, "Locations\t%d\n", s.totals.TotalLocations)
+	fmt.Fprintf(w, "Labels\t%d\n", s.totals.TotalLabels)
+	fmt.Fprintf(w, "Users\t%d\n", s.totals.TotalUsers)
+	fmt.Fprintf(w, "Total value\t%s %s\n", formatMoney(s.totals.TotalItemPrice), currency)
+
+	printTotals(w, "Value by location", s.locations)
+	printTotals(w, "Value by label", s.labels)
+
+	fmt.Fprintf(w, "\nValue from %s to %s\n", dateOf(s.value.Start), dateOf(s.value.End))
+	fmt.Fprintf(w, "  %s\tstart\t%s\n", dateOf(s.value.Start), formatMoney(s.value.ValueAtStart))
+	for _, e := range s.value.Entries {
+		fmt.Fprintf(w, "  %s\t%s\t+%s\n", dateOf(e.Date), e.Name, formatMoney(e.Value))
+	}
+	fmt.Fprintf(w, "  %s\tend\t%s\n", dateOf(s.value.End), formatMoney(s.value.ValueAtEnd))
+
+	return w.Flush()
+}
+
+// printTotals prints totals by location or label, most valuable first.
+func printTotals(w io.Writer, title string, totals []homeboxclient.TotalsByOrganizer) {
+	sort.SliceStable(totals, func(i, j int) bool { return totals[i].Total > totals[j].Total })
+	fmt.Fprintf(w, "\n%s\n", title)
+	if len(totals) == 0 {
+		fmt.Fprintf(w, "  none\n")
+	}
+	for _, t := range totals {
+		fmt.Fprintf(w, "  %s\t%s\n", t.Name, formatMoney(t.Total))
+	}
+}
+
+// dateOf shortens a timestamp from the API to its date.
+func dateOf(s string) string {
+	if len(s) > len(time.DateOnly) {
+		return s[:len(time.DateOnly)]
+	}
+	return s
+}
+
+// formatMoney formats an amount with two decimals and thousands separators.
+func formatMoney(f float64) string {
+	s := strconv.FormatFloat(f, 'f', 2, 64)
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	whole, fraction, _ := strings.Cut(s, ".")
+	for i := len(whole) - 3; i > 0; i -= 3 {
+		whole = whole[:i] + "," + whole[i:]
+	}
+	return sign + whole + "." + fraction
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+)
+
+func TestParseStatsConfig(t *testing.T) {
+	login := []string{"-server", "http://localhost:8080", "-user", "testuser", "-pass", "testpass"}
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantFrom string
+		wantErr  bool
+		errMsg   string
+	}{
+		{
+			name:     "date range",
+			args:     append([]string{"-from", "2024-01-01", "-to", "2024-12-31"}, login...),
+			wantFrom: "2024-01-01",
+		},
+		{
+			name:     "default range",
+			args:     login,
+			wantFrom: time.Now().AddDate(-1, 0, 0).Format(time.DateOnly),
+		},
+		{
+			name:    "invalid date",
+			args:    append([]string{"-from", "01/01/2024"}, login...),
+			wantErr: true,
+			errMsg:  `invalid -from date "01/01/2024", want YYYY-MM-DD`,
+		},
+		{
+			name:    "reversed range",
+			args:    append([]string{"-from", "2024-12-31", "-to", "2024-01-01"}, login...),
+			wantErr: true,
+			errMsg:  "-to must not be before -from",
+		},
+		{
+			name:    "missing password",
+			args:    []string{"-server", "http://localhost:8080", "-user", "testuser"},
+			wantErr: true,
+			errMsg:  "password is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := New()
+			_, opts, err := app.parseStatsConfig(tt.args)
+			checkError(t, err, tt.wantErr, tt.errMsg)
+			if err == nil && opts.From.Format(time.DateOnly) != tt.wantFrom {
+				t.Errorf("From = %s, want %s", opts.From.Format(time.DateOnly), tt.wantFrom)
+			}
+		})
+	}
+}
+
+func TestHandleStats(t *testing.T) {
+	srv := startFake(t, fakehomebox.Fixtures{
+		Group:     homeboxclient.Group{Name: "Home", Currency: "EUR"},
+		Labels:    []homeboxclient.Label{{ID: "tools", Name: "Tools"}},
+		Locations: []fakehomebox.Location{{ID: "garage", Name: "Garage"}, {ID: "living", Name: "Living Room"}},
+		Items: []fakehomebox.Item{
+			{Item: homeboxclient.Item{Name: "Drill", PurchasePrice: 129.5, PurchaseTime: "2024-05-04", WarrantyExpires: "2099-01-01"}, LocationID: "garage", LabelIDs: []string{"tools"}},
+			{Item: homeboxclient.Item{Name: "TV", PurchasePrice: 1500, PurchaseTime: "2023-06-01"}, LocationID: "living"},
+			{Item: homeboxclient.Item{Name: "Lamp"}, LocationID: "living"},
+		},
+	})
+
+	var out bytes.Buffer
+	app := &App{out: &out}
+	err := app.handleStats(append(srv.login, "-from", "2024-01-01", "-to", "2024-12-31"))
+	if err != nil {
+		t.Fatalf("handleStats() error = %v", err)
+	}
+
+	query := srv.Queries("/api/v1/groups/statistics/purchase-price")[0]
+	if query.Get("start") != "2024-01-01" || query.Get("end") != "2024-12-31" {
+		t.Errorf("purchase price query = %v, want the date range", query)
+	}
+	got := out.String()
+	for _, want := range []string{
+		"Items          3",
+		"Total value    1,629.50 EUR",
+		"Value by location\n  Living Room  1,500.00\n  Garage       129.50\n",
+		"Value by label\n  Tools  129.50\n",
+		"Value from 2024-01-01 to 2024-12-31",
+		"2024-05-04  Drill  +129.50",
+		"2024-12-31  end    1,629.50",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestFormatMoney(t *testing.T) {
+	tests := map[float64]string{
+		0:          "0.00",
+		129.5:      "129.50",
+		1234.567:   "1,234.57",
+		1234567.1:  "1,234,567.10",
+		-98765.432: "-98,765.43",
+	}
+	for in, want := range tests {
+		if got := formatMoney(in); got != want {
+			t.Errorf("formatMoney(%v) = %s, want %s", in, got, want)
+		}
+	}
+}
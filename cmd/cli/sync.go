@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kusold/homebox-export/internal/config"
+	"github.com/kusold/homebox-export/internal/logger"
+	"github.com/kusold/homebox-export/internal/migrate"
+)
+
+type syncOptions struct {
+	From      config.Config
+	To        config.Config
+	State     string
+	Conflicts string
+	DryRun    bool
+	LogLevel  string
+	LogFormat string
+}
+
+func (a *App) parseSyncConfig(args []string) (syncOptions, error) {
+	cmd := flag.NewFlagSet("sync", flag.ExitOnError)
+
+	var opts syncOptions
+	cmd.StringVar(&opts.From.ServerURL, "from", os.Getenv("HOMEBOX_SYNC_FROM"), "URL of the Homebox server to copy from (required)")
+	cmd.StringVar(&opts.From.Username, "from-user", os.Getenv("HOMEBOX_SYNC_FROM_USER"), "Username on the server to copy from (required)")
+	cmd.StringVar(&opts.From.Password, "from-pass", os.Getenv("HOMEBOX_SYNC_FROM_PASS"), "Password on the server to copy from (required)")
+	cmd.StringVar(&opts.To.ServerURL, "to", os.Getenv("HOMEBOX_SYNC_TO"), "URL of the Homebox server to copy to (required)")
+	cmd.StringVar(&opts.To.Username, "to-user", os.Getenv("HOMEBOX_SYNC_TO_USER"), "Username on the server to copy to (required)")
+	cmd.StringVar(&opts.To.Password, "to-pass", os.Getenv("HOMEBOX_SYNC_TO_PASS"), "Password on the server to copy to (required)")
+	cmd.StringVar(&opts.State, "state", getEnvOrDefault("HOMEBOX_SYNC_STATE", "homebox-sync.json"), "File recording what was copied, to resume an interrupted sync")
+	cmd.StringVar(&opts.Conflicts, "conflicts", getEnvOrDefault("HOMEBOX_SYNC_CONFLICTS", migrate.ConflictFail), "What to do with items the target already has (fail, skip, duplicate)")
+	cmd.BoolVar(&opts.DryRun, "dry-run", getEnvBoolOrDefault("HOMEBOX_SYNC_DRY_RUN", false), "Only print what would be copied")
+	cmd.StringVar(&opts.LogLevel, "log-level", getEnvOrDefault("HOMEBOX_LOG_LEVEL", "info"), "Log level (debug, info, warn, error)")
+	cmd.StringVar(&opts.LogFormat, "log-format", getEnvOrDefault("HOMEBOX_LOG_FORMAT", "text"), "Log format (text, json)")
+
+	if err := cmd.Parse(args); err != nil {
+		return opts, err
+	}
+
+	if err := validateServer(opts.From); err != nil {
+		return opts, fmt.Errorf("source: %w", err)
+	}
+	if err := validateServer(opts.To); err != nil {
+		return opts, fmt.Errorf("target: %w", err)
+	}
+	if syncAccount(opts.From) == syncAccount(opts.To) {
+		return opts, fmt.Errorf("source and target are the same account")
+	}
+	switch opts.Conflicts {
+	case migrate.ConflictFail, migrate.ConflictSkip, migrate.ConflictDuplicate:
+	default:
+		return opts, fmt.Errorf("invalid conflicts %q (valid values: fail, skip, duplicate)", opts.Conflicts)
+	}
+	if opts.State == "" {
+		return opts, fmt.Errorf("state file is required")
+	}
+	return opts, nil
+}
+
+// syncAccount identifies an account in the state file.
+func syncAccount(cfg config.Config) string {
+	return cfg.Username + "@" + strings.TrimRight(cfg.ServerURL, "/")
+}
+
+// handleSync copies the inventory of one account to another, resuming from the
+// state file if an earlier run was interrupted.
+func (a *App) handleSync(args []string) error {
+	opts, err := a.parseSyncConfig(args)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	log, err := logger.NewSlog(os.Stderr, opts.LogLevel, opts.LogFormat)
+	if err != nil {
+		return err
+	}
+	state, err := migrate.LoadState(opts.State, syncAccount(opts.From), syncAccount(opts.To))
+	if err != nil {
+		return err
+	}
+	from, err := login(opts.From)
+	if err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+	to, err := login(opts.To)
+	if err != nil {
+		return fmt.Errorf("target: %w", err)
+	}
+
+	result, err := migrate.New(from, to, state, migrate.Options{
+		Conflicts: opts.Conflicts,
+		DryRun:    opts.DryRun,
+		Logger:    log,
+	}).Run()
+	if result != nil {
+		printSyncResult(a.out, result, opts)
+	}
+	if errors.Is(err, migrate.ErrConflicts) {
+		return fmt.Errorf("%w, nothing was copied; rerun with -conflicts skip or -conflicts duplicate", err)
+	}
+	return err
+}
+
+func printSyncResult(out io.Writer, result *migrate.Result, opts syncOptions) {
+	if len(result.Conflicts) > 0 {
+		fmt.Fprintf(out, "Conflicts (%s):\n", opts.Conflicts)
+		for _, c := range result.Conflicts {
+			fmt.Fprintf(out, "  %s\n", c)
+		}
+		fmt.Fprintln(out)
+	}
+
+	if opts.DryRun {
+		fmt.Fprintln(out, "Dry run, nothing was changed:")
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tCreated\tMatched\tSkipped\tResumed")
+	for _, row := range []struct {
+		name   string
+		counts migrate.Counts
+	}{
+		{"Labels", result.Labels},
+		{"Locations", result.Locations},
+		{"Items", result.Items},
+		{"Attachments", result.Attachments},
+		{"Maintenance", result.Maintenance},
+	} {
+		c := row.counts
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\n", row.name, c.Created, c.Matched, c.Skipped, c.Resumed)
+	}
+	w.Flush()
+}
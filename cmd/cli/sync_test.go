@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+)
+
+func TestParseSyncConfig(t *testing.T) {
+	from := []string{"-from", "http://old:7745", "-from-user", "me", "-from-pass", "secret"}
+	to := []string{"-to", "http://new:7745", "-to-user", "me", "-to-pass", "secret"}
+	both := append(append([]string{}, from...), to...)
+
+	tests := []struct {
+		name          string
+		args          []string
+		wantConflicts string
+		wantState     string
+		wantErr       bool
+		errMsg        string
+	}{
+		{
+			name:          "defaults",
+			args:          both,
+			wantConflicts: "fail",
+			wantState:     "homebox-sync.json",
+		},
+		{
+			name:          "options",
+			args:          append([]string{"-conflicts", "skip", "-state", "old-to-new.json", "-dry-run"}, both...),
+			wantConflicts: "skip",
+			wantState:     "old-to-new.json",
+		},
+		{
+			name:    "missing source",
+			args:    to,
+			wantErr: true,
+			errMsg:  "source: server URL is required",
+		},
+		{
+			name:    "missing target password",
+			args:    append(append([]string{}, from...), "-to", "http://new:7745", "-to-user", "me"),
+			wantErr: true,
+			errMsg:  "target: password is required",
+		},
+		{
+			name:    "same account",
+			args:    append(append([]string{}, from...), "-to", "http://old:7745/", "-to-user", "me", "-to-pass", "secret"),
+			wantErr: true,
+			errMsg:  "source and target are the same account",
+		},
+		{
+			name:    "invalid conflicts",
+			args:    append([]string{"-conflicts", "overwrite"}, both...),
+			wantErr: true,
+			errMsg:  `invalid conflicts "overwrite" (valid values: fail, skip, duplicate)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := New()
+			opts, err := app.parseSyncConfig(tt.args)
+			checkError(t, err, tt.wantErr, tt.errMsg)
+			if err != nil {
+				return
+			}
+			if opts.Conflicts != tt.wantConflicts || opts.State != tt.wantState {
+				t.Errorf("conflicts = %q, state = %q, want %q and %q", opts.Conflicts, opts.State, tt.wantConflicts, tt.wantState)
+			}
+		})
+	}
+}
+
+func TestHandleSync(t *testing.T) {
+	src := startFake(t, fakehomebox.Fixtures{})
+	dst := startFake(t, fakehomebox.Fixtures{})
+
+	var out bytes.Buffer
+	app := &App{out: &out}
+	err := app.handleSync([]string{
+		"-from", src.URL, "-from-user", "me", "-from-pass", "secret",
+		"-to", dst.URL, "-to-user", "me", "-to-pass", "secret",
+		"-state", filepath.Join(t.TempDir(), "state.json"), "-dry-run", "-log-level", "error",
+	})
+	if err != nil {
+		t.Fatalf("handleSync() error = %v", err)
+	}
+
+	got := out.String()
+	for _, want := range []string{"Dry run, nothing was changed:", "Created  Matched  Skipped  Resumed", "Items        0        0        0        0"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output = %q, want it to contain %q", got, want)
+		}
+	}
+}
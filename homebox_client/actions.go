@@ -0,0 +1,56 @@
+package homeboxclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kusold/homebox-export/homebox_client/api"
+)
+
+// ActionsService runs the server's maintenance actions. Each one changes
+// every item of the group that needs it.
+type ActionsService struct {
+	client *Client
+}
+
+func NewActionsService(c *Client) *ActionsService {
+	return &ActionsService{
+		client: c,
+	}
+}
+
+// ActionAmountResult is the number of items an action changed.
+type ActionAmountResult struct {
+	Completed int `json:"completed"`
+}
+
+// EnsureAssetIDs assigns the next free asset ID to every item without one.
+func (s *ActionsService) EnsureAssetIDs() (int, error) {
+	return s.run(s.client.api.PostV1ActionsEnsureAssetIds)
+}
+
+// EnsureImportRefs gives every item without an import ref a new one.
+func (s *ActionsService) EnsureImportRefs() (int, error) {
+	return s.run(s.client.api.PostV1ActionsEnsureImportRefs)
+}
+
+// SetPrimaryPhotos makes the first photo of every item without a primary
+// photo its primary photo.
+func (s *ActionsService) SetPrimaryPhotos() (int, error) {
+	return s.run(s.client.api.PostV1ActionsSetPrimaryPhotos)
+}
+
+// ZeroItemTimeFields resets the time of day of the items' dates to midnight.
+func (s *ActionsService) ZeroItemTimeFields() (int, error) {
+	return s.run(s.client.api.PostV1ActionsZeroItemTimeFields)
+}
+
+func (s *ActionsService) run(action func(context.Context, ...api.RequestEditorFn) (*http.Response, error)) (int, error) {
+	var result ActionAmountResult
+	resp, err := action(context.Background())
+	if err := decode(resp, err, &result); err != nil {
+		return 0, err
+	}
+
+	return result.Completed, nil
+}
@@ -0,0 +1,7834 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.1 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	BearerScopes bearerContextKey = "Bearer.Scopes"
+)
+
+// Defines values for RepoItemType.
+const (
+	ItemTypeItem     RepoItemType = "item"
+	ItemTypeLocation RepoItemType = "location"
+)
+
+// Valid indicates whether the value is a known member of the RepoItemType enum.
+func (e RepoItemType) Valid() bool {
+	switch e {
+	case ItemTypeItem:
+		return true
+	case ItemTypeLocation:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RepoMaintenanceFilterStatus.
+const (
+	MaintenanceFilterStatusBoth      RepoMaintenanceFilterStatus = "both"
+	MaintenanceFilterStatusCompleted RepoMaintenanceFilterStatus = "completed"
+	MaintenanceFilterStatusScheduled RepoMaintenanceFilterStatus = "scheduled"
+)
+
+// Valid indicates whether the value is a known member of the RepoMaintenanceFilterStatus enum.
+func (e RepoMaintenanceFilterStatus) Valid() bool {
+	switch e {
+	case MaintenanceFilterStatusBoth:
+		return true
+	case MaintenanceFilterStatusCompleted:
+		return true
+	case MaintenanceFilterStatusScheduled:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetV1ItemsIdMaintenanceParamsStatus.
+const (
+	GetV1ItemsIdMaintenanceParamsStatusBoth      GetV1ItemsIdMaintenanceParamsStatus = "both"
+	GetV1ItemsIdMaintenanceParamsStatusCompleted GetV1ItemsIdMaintenanceParamsStatus = "completed"
+	GetV1ItemsIdMaintenanceParamsStatusScheduled GetV1ItemsIdMaintenanceParamsStatus = "scheduled"
+)
+
+// Valid indicates whether the value is a known member of the GetV1ItemsIdMaintenanceParamsStatus enum.
+func (e GetV1ItemsIdMaintenanceParamsStatus) Valid() bool {
+	switch e {
+	case GetV1ItemsIdMaintenanceParamsStatusBoth:
+		return true
+	case GetV1ItemsIdMaintenanceParamsStatusCompleted:
+		return true
+	case GetV1ItemsIdMaintenanceParamsStatusScheduled:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetV1MaintenanceParamsStatus.
+const (
+	GetV1MaintenanceParamsStatusBoth      GetV1MaintenanceParamsStatus = "both"
+	GetV1MaintenanceParamsStatusCompleted GetV1MaintenanceParamsStatus = "completed"
+	GetV1MaintenanceParamsStatusScheduled GetV1MaintenanceParamsStatus = "scheduled"
+)
+
+// Valid indicates whether the value is a known member of the GetV1MaintenanceParamsStatus enum.
+func (e GetV1MaintenanceParamsStatus) Valid() bool {
+	switch e {
+	case GetV1MaintenanceParamsStatusBoth:
+		return true
+	case GetV1MaintenanceParamsStatusCompleted:
+		return true
+	case GetV1MaintenanceParamsStatusScheduled:
+		return true
+	default:
+		return false
+	}
+}
+
+// CurrenciesCurrency defines model for currencies.Currency.
+type CurrenciesCurrency struct {
+	Code   *string `json:"code,omitempty"`
+	Local  *string `json:"local,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	Symbol *string `json:"symbol,omitempty"`
+}
+
+// RepoDocumentOut defines model for repo.DocumentOut.
+type RepoDocumentOut struct {
+	Id    *string `json:"id,omitempty"`
+	Path  *string `json:"path,omitempty"`
+	Title *string `json:"title,omitempty"`
+}
+
+// RepoGroup defines model for repo.Group.
+type RepoGroup struct {
+	CreatedAt *string `json:"createdAt,omitempty"`
+	Currency  *string `json:"currency,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+}
+
+// RepoGroupStatistics defines model for repo.GroupStatistics.
+type RepoGroupStatistics struct {
+	TotalItemPrice    *float32 `json:"totalItemPrice,omitempty"`
+	TotalItems        *int     `json:"totalItems,omitempty"`
+	TotalLabels       *int     `json:"totalLabels,omitempty"`
+	TotalLocations    *int     `json:"totalLocations,omitempty"`
+	TotalUsers        *int     `json:"totalUsers,omitempty"`
+	TotalWithWarranty *int     `json:"totalWithWarranty,omitempty"`
+}
+
+// RepoGroupUpdate defines model for repo.GroupUpdate.
+type RepoGroupUpdate struct {
+	Currency *string `json:"currency,omitempty"`
+	Name     *string `json:"name,omitempty"`
+}
+
+// RepoItemAttachment defines model for repo.ItemAttachment.
+type RepoItemAttachment struct {
+	CreatedAt *string          `json:"createdAt,omitempty"`
+	Document  *RepoDocumentOut `json:"document,omitempty"`
+	Id        *string          `json:"id,omitempty"`
+	Primary   *bool            `json:"primary,omitempty"`
+	Type      *string          `json:"type,omitempty"`
+	UpdatedAt *string          `json:"updatedAt,omitempty"`
+}
+
+// RepoItemAttachmentUpdate defines model for repo.ItemAttachmentUpdate.
+type RepoItemAttachmentUpdate struct {
+	Primary *bool   `json:"primary,omitempty"`
+	Title   *string `json:"title,omitempty"`
+	Type    *string `json:"type,omitempty"`
+}
+
+// RepoItemCreate defines model for repo.ItemCreate.
+type RepoItemCreate struct {
+	Description *string   `json:"description,omitempty"`
+	LabelIds    *[]string `json:"labelIds,omitempty"`
+
+	// LocationId Edges
+	LocationId *string `json:"locationId,omitempty"`
+	Name       string  `json:"name"`
+	ParentId   *string `json:"parentId,omitempty"`
+}
+
+// RepoItemField defines model for repo.ItemField.
+type RepoItemField struct {
+	BooleanValue *bool   `json:"booleanValue,omitempty"`
+	Id           *string `json:"id,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	NumberValue  *int    `json:"numberValue,omitempty"`
+	TextValue    *string `json:"textValue,omitempty"`
+	Type         *string `json:"type,omitempty"`
+}
+
+// RepoItemOut defines model for repo.ItemOut.
+type RepoItemOut struct {
+	Archived    *bool                 `json:"archived,omitempty"`
+	AssetId     *string               `json:"assetId,omitempty"`
+	Attachments *[]RepoItemAttachment `json:"attachments,omitempty"`
+	CreatedAt   *string               `json:"createdAt,omitempty"`
+	Description *string               `json:"description,omitempty"`
+	Fields      *[]RepoItemField      `json:"fields,omitempty"`
+	Id          *string               `json:"id,omitempty"`
+	ImageId     *string               `json:"imageId,omitempty"`
+	Insured     *bool                 `json:"insured,omitempty"`
+	Labels      *[]RepoLabelSummary   `json:"labels,omitempty"`
+
+	// LifetimeWarranty Warranty
+	LifetimeWarranty *bool `json:"lifetimeWarranty,omitempty"`
+
+	// Location Edges
+	Location     *RepoLocationSummary `json:"location,omitempty"`
+	Manufacturer *string              `json:"manufacturer,omitempty"`
+	ModelNumber  *string              `json:"modelNumber,omitempty"`
+	Name         *string              `json:"name,omitempty"`
+
+	// Notes Extras
+	Notes         *string          `json:"notes,omitempty"`
+	Parent        *RepoItemSummary `json:"parent,omitempty"`
+	PurchaseFrom  *string          `json:"purchaseFrom,omitempty"`
+	PurchasePrice *float32         `json:"purchasePrice,omitempty"`
+
+	// PurchaseTime Purchase
+	PurchaseTime *string  `json:"purchaseTime,omitempty"`
+	Quantity     *int     `json:"quantity,omitempty"`
+	SerialNumber *string  `json:"serialNumber,omitempty"`
+	SoldNotes    *string  `json:"soldNotes,omitempty"`
+	SoldPrice    *float32 `json:"soldPrice,omitempty"`
+
+	// SoldTime Sold
+	SoldTime        *string `json:"soldTime,omitempty"`
+	SoldTo          *string `json:"soldTo,omitempty"`
+	UpdatedAt       *string `json:"updatedAt,omitempty"`
+	WarrantyDetails *string `json:"warrantyDetails,omitempty"`
+	WarrantyExpires *string `json:"warrantyExpires,omitempty"`
+}
+
+// RepoItemPatch defines model for repo.ItemPatch.
+type RepoItemPatch struct {
+	Id       *string `json:"id,omitempty"`
+	Quantity *int    `json:"quantity,omitempty"`
+}
+
+// RepoItemPath defines model for repo.ItemPath.
+type RepoItemPath struct {
+	Id   *string       `json:"id,omitempty"`
+	Name *string       `json:"name,omitempty"`
+	Type *RepoItemType `json:"type,omitempty"`
+}
+
+// RepoItemSummary defines model for repo.ItemSummary.
+type RepoItemSummary struct {
+	Archived    *bool               `json:"archived,omitempty"`
+	AssetId     *string             `json:"assetId,omitempty"`
+	CreatedAt   *string             `json:"createdAt,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Id          *string             `json:"id,omitempty"`
+	ImageId     *string             `json:"imageId,omitempty"`
+	Insured     *bool               `json:"insured,omitempty"`
+	Labels      *[]RepoLabelSummary `json:"labels,omitempty"`
+
+	// Location Edges
+	Location      *RepoLocationSummary `json:"location,omitempty"`
+	Name          *string              `json:"name,omitempty"`
+	PurchasePrice *float32             `json:"purchasePrice,omitempty"`
+	Quantity      *int                 `json:"quantity,omitempty"`
+	UpdatedAt     *string              `json:"updatedAt,omitempty"`
+}
+
+// RepoItemType defines model for repo.ItemType.
+type RepoItemType string
+
+// RepoItemUpdate defines model for repo.ItemUpdate.
+type RepoItemUpdate struct {
+	Archived    *bool            `json:"archived,omitempty"`
+	AssetId     *string          `json:"assetId,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Fields      *[]RepoItemField `json:"fields,omitempty"`
+	Id          *string          `json:"id,omitempty"`
+	Insured     *bool            `json:"insured,omitempty"`
+	LabelIds    *[]string        `json:"labelIds,omitempty"`
+
+	// LifetimeWarranty Warranty
+	LifetimeWarranty *bool `json:"lifetimeWarranty,omitempty"`
+
+	// LocationId Edges
+	LocationId   *string `json:"locationId,omitempty"`
+	Manufacturer *string `json:"manufacturer,omitempty"`
+	ModelNumber  *string `json:"modelNumber,omitempty"`
+	Name         string  `json:"name"`
+
+	// Notes Extras
+	Notes         *string  `json:"notes,omitempty"`
+	ParentId      *string  `json:"parentId,omitempty"`
+	PurchaseFrom  *string  `json:"purchaseFrom,omitempty"`
+	PurchasePrice *float32 `json:"purchasePrice,omitempty"`
+
+	// PurchaseTime Purchase
+	PurchaseTime *string `json:"purchaseTime,omitempty"`
+	Quantity     *int    `json:"quantity,omitempty"`
+
+	// SerialNumber Identifications
+	SerialNumber *string  `json:"serialNumber,omitempty"`
+	SoldNotes    *string  `json:"soldNotes,omitempty"`
+	SoldPrice    *float32 `json:"soldPrice,omitempty"`
+
+	// SoldTime Sold
+	SoldTime        *string `json:"soldTime,omitempty"`
+	SoldTo          *string `json:"soldTo,omitempty"`
+	WarrantyDetails *string `json:"warrantyDetails,omitempty"`
+	WarrantyExpires *string `json:"warrantyExpires,omitempty"`
+}
+
+// RepoLabelCreate defines model for repo.LabelCreate.
+type RepoLabelCreate struct {
+	Color       *string `json:"color,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// RepoLabelOut defines model for repo.LabelOut.
+type RepoLabelOut struct {
+	CreatedAt   *string `json:"createdAt,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Id          *string `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	UpdatedAt   *string `json:"updatedAt,omitempty"`
+}
+
+// RepoLabelSummary defines model for repo.LabelSummary.
+type RepoLabelSummary struct {
+	CreatedAt   *string `json:"createdAt,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Id          *string `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	UpdatedAt   *string `json:"updatedAt,omitempty"`
+}
+
+// RepoLocationCreate defines model for repo.LocationCreate.
+type RepoLocationCreate struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	ParentId    *string `json:"parentId,omitempty"`
+}
+
+// RepoLocationOut defines model for repo.LocationOut.
+type RepoLocationOut struct {
+	Children    *[]RepoLocationSummary `json:"children,omitempty"`
+	CreatedAt   *string                `json:"createdAt,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Id          *string                `json:"id,omitempty"`
+	Name        *string                `json:"name,omitempty"`
+	Parent      *RepoLocationSummary   `json:"parent,omitempty"`
+	TotalPrice  *float32               `json:"totalPrice,omitempty"`
+	UpdatedAt   *string                `json:"updatedAt,omitempty"`
+}
+
+// RepoLocationOutCount defines model for repo.LocationOutCount.
+type RepoLocationOutCount struct {
+	CreatedAt   *string `json:"createdAt,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Id          *string `json:"id,omitempty"`
+	ItemCount   *int    `json:"itemCount,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	UpdatedAt   *string `json:"updatedAt,omitempty"`
+}
+
+// RepoLocationSummary defines model for repo.LocationSummary.
+type RepoLocationSummary struct {
+	CreatedAt   *string `json:"createdAt,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Id          *string `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	UpdatedAt   *string `json:"updatedAt,omitempty"`
+}
+
+// RepoLocationUpdate defines model for repo.LocationUpdate.
+type RepoLocationUpdate struct {
+	Description *string `json:"description,omitempty"`
+	Id          *string `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	ParentId    *string `json:"parentId,omitempty"`
+}
+
+// RepoMaintenanceEntry defines model for repo.MaintenanceEntry.
+type RepoMaintenanceEntry struct {
+	CompletedDate *string `json:"completedDate,omitempty"`
+	Cost          *string `json:"cost,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Id            *string `json:"id,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	ScheduledDate *string `json:"scheduledDate,omitempty"`
+}
+
+// RepoMaintenanceEntryCreate defines model for repo.MaintenanceEntryCreate.
+type RepoMaintenanceEntryCreate struct {
+	CompletedDate *string `json:"completedDate,omitempty"`
+	Cost          *string `json:"cost,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Name          string  `json:"name"`
+	ScheduledDate *string `json:"scheduledDate,omitempty"`
+}
+
+// RepoMaintenanceEntryUpdate defines model for repo.MaintenanceEntryUpdate.
+type RepoMaintenanceEntryUpdate struct {
+	CompletedDate *string `json:"completedDate,omitempty"`
+	Cost          *string `json:"cost,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	ScheduledDate *string `json:"scheduledDate,omitempty"`
+}
+
+// RepoMaintenanceEntryWithDetails defines model for repo.MaintenanceEntryWithDetails.
+type RepoMaintenanceEntryWithDetails struct {
+	CompletedDate *string `json:"completedDate,omitempty"`
+	Cost          *string `json:"cost,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Id            *string `json:"id,omitempty"`
+	ItemID        *string `json:"itemID,omitempty"`
+	ItemName      *string `json:"itemName,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	ScheduledDate *string `json:"scheduledDate,omitempty"`
+}
+
+// RepoMaintenanceFilterStatus defines model for repo.MaintenanceFilterStatus.
+type RepoMaintenanceFilterStatus string
+
+// RepoNotifierCreate defines model for repo.NotifierCreate.
+type RepoNotifierCreate struct {
+	IsActive *bool  `json:"isActive,omitempty"`
+	Name     string `json:"name"`
+	Url      string `json:"url"`
+}
+
+// RepoNotifierOut defines model for repo.NotifierOut.
+type RepoNotifierOut struct {
+	CreatedAt *string `json:"createdAt,omitempty"`
+	GroupId   *string `json:"groupId,omitempty"`
+	Id        *string `json:"id,omitempty"`
+	IsActive  *bool   `json:"isActive,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+	Url       *string `json:"url,omitempty"`
+	UserId    *string `json:"userId,omitempty"`
+}
+
+// RepoNotifierUpdate defines model for repo.NotifierUpdate.
+type RepoNotifierUpdate struct {
+	IsActive *bool   `json:"isActive,omitempty"`
+	Name     string  `json:"name"`
+	Url      *string `json:"url,omitempty"`
+}
+
+// RepoPaginationResultRepoItemSummary defines model for repo.PaginationResult-repo_ItemSummary.
+type RepoPaginationResultRepoItemSummary struct {
+	Items    *[]RepoItemSummary `json:"items,omitempty"`
+	Page     *int               `json:"page,omitempty"`
+	PageSize *int               `json:"pageSize,omitempty"`
+	Total    *int               `json:"total,omitempty"`
+}
+
+// RepoTotalsByOrganizer defines model for repo.TotalsByOrganizer.
+type RepoTotalsByOrganizer struct {
+	Id    *string  `json:"id,omitempty"`
+	Name  *string  `json:"name,omitempty"`
+	Total *float32 `json:"total,omitempty"`
+}
+
+// RepoTreeItem defines model for repo.TreeItem.
+type RepoTreeItem struct {
+	Children *[]RepoTreeItem `json:"children,omitempty"`
+	Id       *string         `json:"id,omitempty"`
+	Name     *string         `json:"name,omitempty"`
+	Type     *string         `json:"type,omitempty"`
+}
+
+// RepoUserOut defines model for repo.UserOut.
+type RepoUserOut struct {
+	Email       *string `json:"email,omitempty"`
+	GroupId     *string `json:"groupId,omitempty"`
+	GroupName   *string `json:"groupName,omitempty"`
+	Id          *string `json:"id,omitempty"`
+	IsOwner     *bool   `json:"isOwner,omitempty"`
+	IsSuperuser *bool   `json:"isSuperuser,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// RepoUserUpdate defines model for repo.UserUpdate.
+type RepoUserUpdate struct {
+	Email *string `json:"email,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// RepoValueOverTime defines model for repo.ValueOverTime.
+type RepoValueOverTime struct {
+	End          *string                   `json:"end,omitempty"`
+	Entries      *[]RepoValueOverTimeEntry `json:"entries,omitempty"`
+	Start        *string                   `json:"start,omitempty"`
+	ValueAtEnd   *float32                  `json:"valueAtEnd,omitempty"`
+	ValueAtStart *float32                  `json:"valueAtStart,omitempty"`
+}
+
+// RepoValueOverTimeEntry defines model for repo.ValueOverTimeEntry.
+type RepoValueOverTimeEntry struct {
+	Date  *string  `json:"date,omitempty"`
+	Name  *string  `json:"name,omitempty"`
+	Value *float32 `json:"value,omitempty"`
+}
+
+// ServicesUserRegistration defines model for services.UserRegistration.
+type ServicesUserRegistration struct {
+	Email    *string `json:"email,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Password *string `json:"password,omitempty"`
+	Token    *string `json:"token,omitempty"`
+}
+
+// V1APISummary defines model for v1.APISummary.
+type V1APISummary struct {
+	AllowRegistration *bool     `json:"allowRegistration,omitempty"`
+	Build             *V1Build  `json:"build,omitempty"`
+	Demo              *bool     `json:"demo,omitempty"`
+	Health            *bool     `json:"health,omitempty"`
+	Message           *string   `json:"message,omitempty"`
+	Title             *string   `json:"title,omitempty"`
+	Versions          *[]string `json:"versions,omitempty"`
+}
+
+// V1ActionAmountResult defines model for v1.ActionAmountResult.
+type V1ActionAmountResult struct {
+	Completed *int `json:"completed,omitempty"`
+}
+
+// V1Build defines model for v1.Build.
+type V1Build struct {
+	BuildTime *string `json:"buildTime,omitempty"`
+	Commit    *string `json:"commit,omitempty"`
+	Version   *string `json:"version,omitempty"`
+}
+
+// V1ChangePassword defines model for v1.ChangePassword.
+type V1ChangePassword struct {
+	Current *string `json:"current,omitempty"`
+	New     *string `json:"new,omitempty"`
+}
+
+// V1GroupInvitation defines model for v1.GroupInvitation.
+type V1GroupInvitation struct {
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+	Token     *string `json:"token,omitempty"`
+	Uses      *int    `json:"uses,omitempty"`
+}
+
+// V1GroupInvitationCreate defines model for v1.GroupInvitationCreate.
+type V1GroupInvitationCreate struct {
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+	Uses      int     `json:"uses"`
+}
+
+// V1ItemAttachmentToken defines model for v1.ItemAttachmentToken.
+type V1ItemAttachmentToken struct {
+	Token *string `json:"token,omitempty"`
+}
+
+// V1LoginForm defines model for v1.LoginForm.
+type V1LoginForm struct {
+	Password     *string `json:"password,omitempty"`
+	StayLoggedIn *bool   `json:"stayLoggedIn,omitempty"`
+	Username     *string `json:"username,omitempty"`
+}
+
+// V1TokenResponse defines model for v1.TokenResponse.
+type V1TokenResponse struct {
+	AttachmentToken *string `json:"attachmentToken,omitempty"`
+	ExpiresAt       *string `json:"expiresAt,omitempty"`
+	Token           *string `json:"token,omitempty"`
+}
+
+// V1Wrapped defines model for v1.Wrapped.
+type V1Wrapped struct {
+	Item interface{} `json:"item,omitempty"`
+}
+
+// ValidateErrorResponse defines model for validate.ErrorResponse.
+type ValidateErrorResponse struct {
+	Error  *string `json:"error,omitempty"`
+	Fields *string `json:"fields,omitempty"`
+}
+
+// bearerContextKey is the context key for Bearer security scheme
+type bearerContextKey string
+
+// GetV1GroupsStatisticsPurchasePriceParams defines parameters for GetV1GroupsStatisticsPurchasePrice.
+type GetV1GroupsStatisticsPurchasePriceParams struct {
+	// Start start date
+	Start *string `form:"start,omitempty" json:"start,omitempty"`
+
+	// End end date
+	End *string `form:"end,omitempty" json:"end,omitempty"`
+}
+
+// GetV1ItemsParams defines parameters for GetV1Items.
+type GetV1ItemsParams struct {
+	// Q search string
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Page page number
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize items per page
+	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
+
+	// Labels label Ids
+	Labels *[]string `form:"labels,omitempty" json:"labels,omitempty"`
+
+	// Locations location Ids
+	Locations *[]string `form:"locations,omitempty" json:"locations,omitempty"`
+
+	// ParentIds parent Ids
+	ParentIds *[]string `form:"parentIds,omitempty" json:"parentIds,omitempty"`
+}
+
+// GetV1ItemsIdMaintenanceParams defines parameters for GetV1ItemsIdMaintenance.
+type GetV1ItemsIdMaintenanceParams struct {
+	Status *GetV1ItemsIdMaintenanceParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetV1ItemsIdMaintenanceParamsStatus defines parameters for GetV1ItemsIdMaintenance.
+type GetV1ItemsIdMaintenanceParamsStatus string
+
+// GetV1LocationsParams defines parameters for GetV1Locations.
+type GetV1LocationsParams struct {
+	// FilterChildren Filter locations with parents
+	FilterChildren *bool `form:"filterChildren,omitempty" json:"filterChildren,omitempty"`
+}
+
+// GetV1LocationsTreeParams defines parameters for GetV1LocationsTree.
+type GetV1LocationsTreeParams struct {
+	// WithItems include items in response tree
+	WithItems *bool `form:"withItems,omitempty" json:"withItems,omitempty"`
+}
+
+// GetV1MaintenanceParams defines parameters for GetV1Maintenance.
+type GetV1MaintenanceParams struct {
+	Status *GetV1MaintenanceParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetV1MaintenanceParamsStatus defines parameters for GetV1Maintenance.
+type GetV1MaintenanceParamsStatus string
+
+// PostV1NotifiersTestParams defines parameters for PostV1NotifiersTest.
+type PostV1NotifiersTestParams struct {
+	// Url URL
+	Url string `form:"url" json:"url"`
+}
+
+// GetV1QrcodeParams defines parameters for GetV1Qrcode.
+type GetV1QrcodeParams struct {
+	// Data data to be encoded into qrcode
+	Data *string `form:"data,omitempty" json:"data,omitempty"`
+}
+
+// PostV1UsersLoginParams defines parameters for PostV1UsersLogin.
+type PostV1UsersLoginParams struct {
+	// Provider auth provider
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty"`
+}
+
+// PostV1UsersLoginJSONRequestBody defines body for PostV1UsersLogin for application/json ContentType.
+type PostV1UsersLoginJSONRequestBody = V1LoginForm
+
+// PostV1UsersLoginFormdataRequestBody defines body for PostV1UsersLogin for application/x-www-form-urlencoded ContentType.
+type PostV1UsersLoginFormdataRequestBody = V1LoginForm
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// PostV1ActionsEnsureAssetIds request
+	PostV1ActionsEnsureAssetIds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1ActionsEnsureImportRefs request
+	PostV1ActionsEnsureImportRefs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1ActionsSetPrimaryPhotos request
+	PostV1ActionsSetPrimaryPhotos(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1ActionsZeroItemTimeFields request
+	PostV1ActionsZeroItemTimeFields(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1AssetsId request
+	GetV1AssetsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Currency request
+	GetV1Currency(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Groups request
+	GetV1Groups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV1GroupsWithBody request with any body
+	PutV1GroupsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1GroupsInvitationsWithBody request with any body
+	PostV1GroupsInvitationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1GroupsStatistics request
+	GetV1GroupsStatistics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1GroupsStatisticsLabels request
+	GetV1GroupsStatisticsLabels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1GroupsStatisticsLocations request
+	GetV1GroupsStatisticsLocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1GroupsStatisticsPurchasePrice request
+	GetV1GroupsStatisticsPurchasePrice(ctx context.Context, params *GetV1GroupsStatisticsPurchasePriceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Items request
+	GetV1Items(ctx context.Context, params *GetV1ItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1ItemsWithBody request with any body
+	PostV1ItemsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ItemsExport request
+	GetV1ItemsExport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ItemsFields request
+	GetV1ItemsFields(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ItemsFieldsValues request
+	GetV1ItemsFieldsValues(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1ItemsImportWithBody request with any body
+	PostV1ItemsImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1ItemsId request
+	DeleteV1ItemsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ItemsId request
+	GetV1ItemsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchV1ItemsIdWithBody request with any body
+	PatchV1ItemsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV1ItemsIdWithBody request with any body
+	PutV1ItemsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1ItemsIdAttachmentsWithBody request with any body
+	PostV1ItemsIdAttachmentsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1ItemsIdAttachmentsAttachmentId request
+	DeleteV1ItemsIdAttachmentsAttachmentId(ctx context.Context, id string, attachmentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ItemsIdAttachmentsAttachmentId request
+	GetV1ItemsIdAttachmentsAttachmentId(ctx context.Context, id string, attachmentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV1ItemsIdAttachmentsAttachmentIdWithBody request with any body
+	PutV1ItemsIdAttachmentsAttachmentIdWithBody(ctx context.Context, id string, attachmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ItemsIdMaintenance request
+	GetV1ItemsIdMaintenance(ctx context.Context, id string, params *GetV1ItemsIdMaintenanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1ItemsIdMaintenanceWithBody request with any body
+	PostV1ItemsIdMaintenanceWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ItemsIdPath request
+	GetV1ItemsIdPath(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Labels request
+	GetV1Labels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1LabelsWithBody request with any body
+	PostV1LabelsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1LabelsId request
+	DeleteV1LabelsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1LabelsId request
+	GetV1LabelsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV1LabelsId request
+	PutV1LabelsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Locations request
+	GetV1Locations(ctx context.Context, params *GetV1LocationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1LocationsWithBody request with any body
+	PostV1LocationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1LocationsTree request
+	GetV1LocationsTree(ctx context.Context, params *GetV1LocationsTreeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1LocationsId request
+	DeleteV1LocationsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1LocationsId request
+	GetV1LocationsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV1LocationsIdWithBody request with any body
+	PutV1LocationsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Maintenance request
+	GetV1Maintenance(ctx context.Context, params *GetV1MaintenanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1MaintenanceId request
+	DeleteV1MaintenanceId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV1MaintenanceIdWithBody request with any body
+	PutV1MaintenanceIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Notifiers request
+	GetV1Notifiers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1NotifiersWithBody request with any body
+	PostV1NotifiersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1NotifiersTest request
+	PostV1NotifiersTest(ctx context.Context, params *PostV1NotifiersTestParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1NotifiersId request
+	DeleteV1NotifiersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV1NotifiersIdWithBody request with any body
+	PutV1NotifiersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Qrcode request
+	GetV1Qrcode(ctx context.Context, params *GetV1QrcodeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1ReportingBillOfMaterials request
+	GetV1ReportingBillOfMaterials(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1Status request
+	GetV1Status(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV1UsersChangePasswordWithBody request with any body
+	PutV1UsersChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1UsersLoginWithBody request with any body
+	PostV1UsersLoginWithBody(ctx context.Context, params *PostV1UsersLoginParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1UsersLogin(ctx context.Context, params *PostV1UsersLoginParams, body PostV1UsersLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostV1UsersLoginWithFormdataBody(ctx context.Context, params *PostV1UsersLoginParams, body PostV1UsersLoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1UsersLogout request
+	PostV1UsersLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1UsersRefresh request
+	GetV1UsersRefresh(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostV1UsersRegisterWithBody request with any body
+	PostV1UsersRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteV1UsersSelf request
+	DeleteV1UsersSelf(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetV1UsersSelf request
+	GetV1UsersSelf(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutV1UsersSelfWithBody request with any body
+	PutV1UsersSelfWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) PostV1ActionsEnsureAssetIds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ActionsEnsureAssetIdsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1ActionsEnsureImportRefs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ActionsEnsureImportRefsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1ActionsSetPrimaryPhotos(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ActionsSetPrimaryPhotosRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1ActionsZeroItemTimeFields(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ActionsZeroItemTimeFieldsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1AssetsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1AssetsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Currency(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1CurrencyRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Groups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1GroupsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1GroupsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1GroupsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1GroupsInvitationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1GroupsInvitationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1GroupsStatistics(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1GroupsStatisticsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1GroupsStatisticsLabels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1GroupsStatisticsLabelsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1GroupsStatisticsLocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1GroupsStatisticsLocationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1GroupsStatisticsPurchasePrice(ctx context.Context, params *GetV1GroupsStatisticsPurchasePriceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1GroupsStatisticsPurchasePriceRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Items(ctx context.Context, params *GetV1ItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ItemsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1ItemsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ItemsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1ItemsExport(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ItemsExportRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1ItemsFields(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ItemsFieldsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1ItemsFieldsValues(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ItemsFieldsValuesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1ItemsImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ItemsImportRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1ItemsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1ItemsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1ItemsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ItemsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchV1ItemsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchV1ItemsIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1ItemsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1ItemsIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1ItemsIdAttachmentsWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ItemsIdAttachmentsRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1ItemsIdAttachmentsAttachmentId(ctx context.Context, id string, attachmentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1ItemsIdAttachmentsAttachmentIdRequest(c.Server, id, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1ItemsIdAttachmentsAttachmentId(ctx context.Context, id string, attachmentId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ItemsIdAttachmentsAttachmentIdRequest(c.Server, id, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1ItemsIdAttachmentsAttachmentIdWithBody(ctx context.Context, id string, attachmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1ItemsIdAttachmentsAttachmentIdRequestWithBody(c.Server, id, attachmentId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1ItemsIdMaintenance(ctx context.Context, id string, params *GetV1ItemsIdMaintenanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ItemsIdMaintenanceRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1ItemsIdMaintenanceWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1ItemsIdMaintenanceRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1ItemsIdPath(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ItemsIdPathRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Labels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1LabelsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1LabelsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1LabelsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1LabelsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1LabelsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1LabelsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1LabelsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1LabelsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1LabelsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Locations(ctx context.Context, params *GetV1LocationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1LocationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1LocationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1LocationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1LocationsTree(ctx context.Context, params *GetV1LocationsTreeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1LocationsTreeRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1LocationsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1LocationsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1LocationsId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1LocationsIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1LocationsIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1LocationsIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Maintenance(ctx context.Context, params *GetV1MaintenanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1MaintenanceRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1MaintenanceId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1MaintenanceIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1MaintenanceIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1MaintenanceIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Notifiers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1NotifiersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1NotifiersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1NotifiersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1NotifiersTest(ctx context.Context, params *PostV1NotifiersTestParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1NotifiersTestRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1NotifiersId(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1NotifiersIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1NotifiersIdWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1NotifiersIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Qrcode(ctx context.Context, params *GetV1QrcodeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1QrcodeRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1ReportingBillOfMaterials(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1ReportingBillOfMaterialsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1Status(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1StatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1UsersChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1UsersChangePasswordRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1UsersLoginWithBody(ctx context.Context, params *PostV1UsersLoginParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1UsersLoginRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1UsersLogin(ctx context.Context, params *PostV1UsersLoginParams, body PostV1UsersLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1UsersLoginRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1UsersLoginWithFormdataBody(ctx context.Context, params *PostV1UsersLoginParams, body PostV1UsersLoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1UsersLoginRequestWithFormdataBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1UsersLogout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1UsersLogoutRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1UsersRefresh(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1UsersRefreshRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostV1UsersRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostV1UsersRegisterRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteV1UsersSelf(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteV1UsersSelfRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetV1UsersSelf(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetV1UsersSelfRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutV1UsersSelfWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutV1UsersSelfRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewPostV1ActionsEnsureAssetIdsRequest generates requests for PostV1ActionsEnsureAssetIds
+func NewPostV1ActionsEnsureAssetIdsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/actions/ensure-asset-ids")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1ActionsEnsureImportRefsRequest generates requests for PostV1ActionsEnsureImportRefs
+func NewPostV1ActionsEnsureImportRefsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/actions/ensure-import-refs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1ActionsSetPrimaryPhotosRequest generates requests for PostV1ActionsSetPrimaryPhotos
+func NewPostV1ActionsSetPrimaryPhotosRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/actions/set-primary-photos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1ActionsZeroItemTimeFieldsRequest generates requests for PostV1ActionsZeroItemTimeFields
+func NewPostV1ActionsZeroItemTimeFieldsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/actions/zero-item-time-fields")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1AssetsIdRequest generates requests for GetV1AssetsId
+func NewGetV1AssetsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/assets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1CurrencyRequest generates requests for GetV1Currency
+func NewGetV1CurrencyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/currency")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1GroupsRequest generates requests for GetV1Groups
+func NewGetV1GroupsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutV1GroupsRequestWithBody generates requests for PutV1Groups with any type of body
+func NewPutV1GroupsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostV1GroupsInvitationsRequestWithBody generates requests for PostV1GroupsInvitations with any type of body
+func NewPostV1GroupsInvitationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/groups/invitations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1GroupsStatisticsRequest generates requests for GetV1GroupsStatistics
+func NewGetV1GroupsStatisticsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/groups/statistics")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1GroupsStatisticsLabelsRequest generates requests for GetV1GroupsStatisticsLabels
+func NewGetV1GroupsStatisticsLabelsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/groups/statistics/labels")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1GroupsStatisticsLocationsRequest generates requests for GetV1GroupsStatisticsLocations
+func NewGetV1GroupsStatisticsLocationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/groups/statistics/locations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1GroupsStatisticsPurchasePriceRequest generates requests for GetV1GroupsStatisticsPurchasePrice
+func NewGetV1GroupsStatisticsPurchasePriceRequest(server string, params *GetV1GroupsStatisticsPurchasePriceParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/groups/statistics/purchase-price")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Start != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "start", *params.Start, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.End != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "end", *params.End, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1ItemsRequest generates requests for GetV1Items
+func NewGetV1ItemsRequest(server string, params *GetV1ItemsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "q", *params.Q, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "page", *params.Page, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "pageSize", *params.PageSize, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Labels != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "labels", *params.Labels, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.Locations != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "locations", *params.Locations, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if params.ParentIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "parentIds", *params.ParentIds, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1ItemsRequestWithBody generates requests for PostV1Items with any type of body
+func NewPostV1ItemsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1ItemsExportRequest generates requests for GetV1ItemsExport
+func NewGetV1ItemsExportRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1ItemsFieldsRequest generates requests for GetV1ItemsFields
+func NewGetV1ItemsFieldsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/fields")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1ItemsFieldsValuesRequest generates requests for GetV1ItemsFieldsValues
+func NewGetV1ItemsFieldsValuesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/fields/values")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1ItemsImportRequestWithBody generates requests for PostV1ItemsImport with any type of body
+func NewPostV1ItemsImportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV1ItemsIdRequest generates requests for DeleteV1ItemsId
+func NewDeleteV1ItemsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1ItemsIdRequest generates requests for GetV1ItemsId
+func NewGetV1ItemsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchV1ItemsIdRequestWithBody generates requests for PatchV1ItemsId with any type of body
+func NewPatchV1ItemsIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPatch, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutV1ItemsIdRequestWithBody generates requests for PutV1ItemsId with any type of body
+func NewPutV1ItemsIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostV1ItemsIdAttachmentsRequestWithBody generates requests for PostV1ItemsIdAttachments with any type of body
+func NewPostV1ItemsIdAttachmentsRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s/attachments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV1ItemsIdAttachmentsAttachmentIdRequest generates requests for DeleteV1ItemsIdAttachmentsAttachmentId
+func NewDeleteV1ItemsIdAttachmentsAttachmentIdRequest(server string, id string, attachmentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "attachment_id", attachmentId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s/attachments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1ItemsIdAttachmentsAttachmentIdRequest generates requests for GetV1ItemsIdAttachmentsAttachmentId
+func NewGetV1ItemsIdAttachmentsAttachmentIdRequest(server string, id string, attachmentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "attachment_id", attachmentId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s/attachments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutV1ItemsIdAttachmentsAttachmentIdRequestWithBody generates requests for PutV1ItemsIdAttachmentsAttachmentId with any type of body
+func NewPutV1ItemsIdAttachmentsAttachmentIdRequestWithBody(server string, id string, attachmentId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "attachment_id", attachmentId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s/attachments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1ItemsIdMaintenanceRequest generates requests for GetV1ItemsIdMaintenance
+func NewGetV1ItemsIdMaintenanceRequest(server string, id string, params *GetV1ItemsIdMaintenanceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s/maintenance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "status", *params.Status, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1ItemsIdMaintenanceRequestWithBody generates requests for PostV1ItemsIdMaintenance with any type of body
+func NewPostV1ItemsIdMaintenanceRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s/maintenance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1ItemsIdPathRequest generates requests for GetV1ItemsIdPath
+func NewGetV1ItemsIdPathRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/items/%s/path", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1LabelsRequest generates requests for GetV1Labels
+func NewGetV1LabelsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/labels")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1LabelsRequestWithBody generates requests for PostV1Labels with any type of body
+func NewPostV1LabelsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/labels")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV1LabelsIdRequest generates requests for DeleteV1LabelsId
+func NewDeleteV1LabelsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/labels/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1LabelsIdRequest generates requests for GetV1LabelsId
+func NewGetV1LabelsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/labels/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutV1LabelsIdRequest generates requests for PutV1LabelsId
+func NewPutV1LabelsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/labels/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1LocationsRequest generates requests for GetV1Locations
+func NewGetV1LocationsRequest(server string, params *GetV1LocationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/locations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.FilterChildren != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "filterChildren", *params.FilterChildren, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1LocationsRequestWithBody generates requests for PostV1Locations with any type of body
+func NewPostV1LocationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/locations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1LocationsTreeRequest generates requests for GetV1LocationsTree
+func NewGetV1LocationsTreeRequest(server string, params *GetV1LocationsTreeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/locations/tree")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.WithItems != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "withItems", *params.WithItems, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteV1LocationsIdRequest generates requests for DeleteV1LocationsId
+func NewDeleteV1LocationsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/locations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1LocationsIdRequest generates requests for GetV1LocationsId
+func NewGetV1LocationsIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/locations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutV1LocationsIdRequestWithBody generates requests for PutV1LocationsId with any type of body
+func NewPutV1LocationsIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/locations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1MaintenanceRequest generates requests for GetV1Maintenance
+func NewGetV1MaintenanceRequest(server string, params *GetV1MaintenanceParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/maintenance")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "status", *params.Status, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteV1MaintenanceIdRequest generates requests for DeleteV1MaintenanceId
+func NewDeleteV1MaintenanceIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/maintenance/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutV1MaintenanceIdRequestWithBody generates requests for PutV1MaintenanceId with any type of body
+func NewPutV1MaintenanceIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/maintenance/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1NotifiersRequest generates requests for GetV1Notifiers
+func NewGetV1NotifiersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifiers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1NotifiersRequestWithBody generates requests for PostV1Notifiers with any type of body
+func NewPostV1NotifiersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifiers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostV1NotifiersTestRequest generates requests for PostV1NotifiersTest
+func NewPostV1NotifiersTestRequest(server string, params *PostV1NotifiersTestParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifiers/test")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "url", params.Url, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else {
+			for _, qp := range strings.Split(queryFrag, "&") {
+				rawQueryFragments = append(rawQueryFragments, qp)
+			}
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteV1NotifiersIdRequest generates requests for DeleteV1NotifiersId
+func NewDeleteV1NotifiersIdRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifiers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutV1NotifiersIdRequestWithBody generates requests for PutV1NotifiersId with any type of body
+func NewPutV1NotifiersIdRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notifiers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetV1QrcodeRequest generates requests for GetV1Qrcode
+func NewGetV1QrcodeRequest(server string, params *GetV1QrcodeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/qrcode")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Data != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "data", *params.Data, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1ReportingBillOfMaterialsRequest generates requests for GetV1ReportingBillOfMaterials
+func NewGetV1ReportingBillOfMaterialsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/reporting/bill-of-materials")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1StatusRequest generates requests for GetV1Status
+func NewGetV1StatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutV1UsersChangePasswordRequestWithBody generates requests for PutV1UsersChangePassword with any type of body
+func NewPutV1UsersChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/change-password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostV1UsersLoginRequest calls the generic PostV1UsersLogin builder with application/json body
+func NewPostV1UsersLoginRequest(server string, params *PostV1UsersLoginParams, body PostV1UsersLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostV1UsersLoginRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostV1UsersLoginRequestWithFormdataBody calls the generic PostV1UsersLogin builder with application/x-www-form-urlencoded body
+func NewPostV1UsersLoginRequestWithFormdataBody(server string, params *PostV1UsersLoginParams, body PostV1UsersLoginFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostV1UsersLoginRequestWithBody(server, params, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostV1UsersLoginRequestWithBody generates requests for PostV1UsersLogin with any type of body
+func NewPostV1UsersLoginRequestWithBody(server string, params *PostV1UsersLoginParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		// queryValues collects non-styled parameters (passthrough, JSON)
+		// that are safe to round-trip through url.Values.Encode().
+		queryValues := queryURL.Query()
+		// rawQueryFragments collects pre-encoded query fragments from
+		// styled parameters, preserving literal commas as delimiters
+		// per the OpenAPI spec (e.g. "color=blue,black,brown").
+		var rawQueryFragments []string
+
+		if params.Provider != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "provider", *params.Provider, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else {
+				for _, qp := range strings.Split(queryFrag, "&") {
+					rawQueryFragments = append(rawQueryFragments, qp)
+				}
+			}
+
+		}
+
+		if encoded := queryValues.Encode(); encoded != "" {
+			rawQueryFragments = append(rawQueryFragments, encoded)
+		}
+		queryURL.RawQuery = strings.Join(rawQueryFragments, "&")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostV1UsersLogoutRequest generates requests for PostV1UsersLogout
+func NewPostV1UsersLogoutRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1UsersRefreshRequest generates requests for GetV1UsersRefresh
+func NewGetV1UsersRefreshRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostV1UsersRegisterRequestWithBody generates requests for PostV1UsersRegister with any type of body
+func NewPostV1UsersRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteV1UsersSelfRequest generates requests for DeleteV1UsersSelf
+func NewDeleteV1UsersSelfRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/self")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetV1UsersSelfRequest generates requests for GetV1UsersSelf
+func NewGetV1UsersSelfRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/self")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutV1UsersSelfRequestWithBody generates requests for PutV1UsersSelf with any type of body
+func NewPutV1UsersSelfRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/self")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// PostV1ActionsEnsureAssetIdsWithResponse request
+	PostV1ActionsEnsureAssetIdsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1ActionsEnsureAssetIdsResponse, error)
+
+	// PostV1ActionsEnsureImportRefsWithResponse request
+	PostV1ActionsEnsureImportRefsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1ActionsEnsureImportRefsResponse, error)
+
+	// PostV1ActionsSetPrimaryPhotosWithResponse request
+	PostV1ActionsSetPrimaryPhotosWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1ActionsSetPrimaryPhotosResponse, error)
+
+	// PostV1ActionsZeroItemTimeFieldsWithResponse request
+	PostV1ActionsZeroItemTimeFieldsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1ActionsZeroItemTimeFieldsResponse, error)
+
+	// GetV1AssetsIdWithResponse request
+	GetV1AssetsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1AssetsIdResponse, error)
+
+	// GetV1CurrencyWithResponse request
+	GetV1CurrencyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1CurrencyResponse, error)
+
+	// GetV1GroupsWithResponse request
+	GetV1GroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1GroupsResponse, error)
+
+	// PutV1GroupsWithBodyWithResponse request with any body
+	PutV1GroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1GroupsResponse, error)
+
+	// PostV1GroupsInvitationsWithBodyWithResponse request with any body
+	PostV1GroupsInvitationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1GroupsInvitationsResponse, error)
+
+	// GetV1GroupsStatisticsWithResponse request
+	GetV1GroupsStatisticsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1GroupsStatisticsResponse, error)
+
+	// GetV1GroupsStatisticsLabelsWithResponse request
+	GetV1GroupsStatisticsLabelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1GroupsStatisticsLabelsResponse, error)
+
+	// GetV1GroupsStatisticsLocationsWithResponse request
+	GetV1GroupsStatisticsLocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1GroupsStatisticsLocationsResponse, error)
+
+	// GetV1GroupsStatisticsPurchasePriceWithResponse request
+	GetV1GroupsStatisticsPurchasePriceWithResponse(ctx context.Context, params *GetV1GroupsStatisticsPurchasePriceParams, reqEditors ...RequestEditorFn) (*GetV1GroupsStatisticsPurchasePriceResponse, error)
+
+	// GetV1ItemsWithResponse request
+	GetV1ItemsWithResponse(ctx context.Context, params *GetV1ItemsParams, reqEditors ...RequestEditorFn) (*GetV1ItemsResponse, error)
+
+	// PostV1ItemsWithBodyWithResponse request with any body
+	PostV1ItemsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ItemsResponse, error)
+
+	// GetV1ItemsExportWithResponse request
+	GetV1ItemsExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ItemsExportResponse, error)
+
+	// GetV1ItemsFieldsWithResponse request
+	GetV1ItemsFieldsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ItemsFieldsResponse, error)
+
+	// GetV1ItemsFieldsValuesWithResponse request
+	GetV1ItemsFieldsValuesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ItemsFieldsValuesResponse, error)
+
+	// PostV1ItemsImportWithBodyWithResponse request with any body
+	PostV1ItemsImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ItemsImportResponse, error)
+
+	// DeleteV1ItemsIdWithResponse request
+	DeleteV1ItemsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1ItemsIdResponse, error)
+
+	// GetV1ItemsIdWithResponse request
+	GetV1ItemsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1ItemsIdResponse, error)
+
+	// PatchV1ItemsIdWithBodyWithResponse request with any body
+	PatchV1ItemsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ItemsIdResponse, error)
+
+	// PutV1ItemsIdWithBodyWithResponse request with any body
+	PutV1ItemsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1ItemsIdResponse, error)
+
+	// PostV1ItemsIdAttachmentsWithBodyWithResponse request with any body
+	PostV1ItemsIdAttachmentsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ItemsIdAttachmentsResponse, error)
+
+	// DeleteV1ItemsIdAttachmentsAttachmentIdWithResponse request
+	DeleteV1ItemsIdAttachmentsAttachmentIdWithResponse(ctx context.Context, id string, attachmentId string, reqEditors ...RequestEditorFn) (*DeleteV1ItemsIdAttachmentsAttachmentIdResponse, error)
+
+	// GetV1ItemsIdAttachmentsAttachmentIdWithResponse request
+	GetV1ItemsIdAttachmentsAttachmentIdWithResponse(ctx context.Context, id string, attachmentId string, reqEditors ...RequestEditorFn) (*GetV1ItemsIdAttachmentsAttachmentIdResponse, error)
+
+	// PutV1ItemsIdAttachmentsAttachmentIdWithBodyWithResponse request with any body
+	PutV1ItemsIdAttachmentsAttachmentIdWithBodyWithResponse(ctx context.Context, id string, attachmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1ItemsIdAttachmentsAttachmentIdResponse, error)
+
+	// GetV1ItemsIdMaintenanceWithResponse request
+	GetV1ItemsIdMaintenanceWithResponse(ctx context.Context, id string, params *GetV1ItemsIdMaintenanceParams, reqEditors ...RequestEditorFn) (*GetV1ItemsIdMaintenanceResponse, error)
+
+	// PostV1ItemsIdMaintenanceWithBodyWithResponse request with any body
+	PostV1ItemsIdMaintenanceWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ItemsIdMaintenanceResponse, error)
+
+	// GetV1ItemsIdPathWithResponse request
+	GetV1ItemsIdPathWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1ItemsIdPathResponse, error)
+
+	// GetV1LabelsWithResponse request
+	GetV1LabelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1LabelsResponse, error)
+
+	// PostV1LabelsWithBodyWithResponse request with any body
+	PostV1LabelsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1LabelsResponse, error)
+
+	// DeleteV1LabelsIdWithResponse request
+	DeleteV1LabelsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1LabelsIdResponse, error)
+
+	// GetV1LabelsIdWithResponse request
+	GetV1LabelsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1LabelsIdResponse, error)
+
+	// PutV1LabelsIdWithResponse request
+	PutV1LabelsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PutV1LabelsIdResponse, error)
+
+	// GetV1LocationsWithResponse request
+	GetV1LocationsWithResponse(ctx context.Context, params *GetV1LocationsParams, reqEditors ...RequestEditorFn) (*GetV1LocationsResponse, error)
+
+	// PostV1LocationsWithBodyWithResponse request with any body
+	PostV1LocationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1LocationsResponse, error)
+
+	// GetV1LocationsTreeWithResponse request
+	GetV1LocationsTreeWithResponse(ctx context.Context, params *GetV1LocationsTreeParams, reqEditors ...RequestEditorFn) (*GetV1LocationsTreeResponse, error)
+
+	// DeleteV1LocationsIdWithResponse request
+	DeleteV1LocationsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1LocationsIdResponse, error)
+
+	// GetV1LocationsIdWithResponse request
+	GetV1LocationsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1LocationsIdResponse, error)
+
+	// PutV1LocationsIdWithBodyWithResponse request with any body
+	PutV1LocationsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1LocationsIdResponse, error)
+
+	// GetV1MaintenanceWithResponse request
+	GetV1MaintenanceWithResponse(ctx context.Context, params *GetV1MaintenanceParams, reqEditors ...RequestEditorFn) (*GetV1MaintenanceResponse, error)
+
+	// DeleteV1MaintenanceIdWithResponse request
+	DeleteV1MaintenanceIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1MaintenanceIdResponse, error)
+
+	// PutV1MaintenanceIdWithBodyWithResponse request with any body
+	PutV1MaintenanceIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1MaintenanceIdResponse, error)
+
+	// GetV1NotifiersWithResponse request
+	GetV1NotifiersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1NotifiersResponse, error)
+
+	// PostV1NotifiersWithBodyWithResponse request with any body
+	PostV1NotifiersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1NotifiersResponse, error)
+
+	// PostV1NotifiersTestWithResponse request
+	PostV1NotifiersTestWithResponse(ctx context.Context, params *PostV1NotifiersTestParams, reqEditors ...RequestEditorFn) (*PostV1NotifiersTestResponse, error)
+
+	// DeleteV1NotifiersIdWithResponse request
+	DeleteV1NotifiersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1NotifiersIdResponse, error)
+
+	// PutV1NotifiersIdWithBodyWithResponse request with any body
+	PutV1NotifiersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1NotifiersIdResponse, error)
+
+	// GetV1QrcodeWithResponse request
+	GetV1QrcodeWithResponse(ctx context.Context, params *GetV1QrcodeParams, reqEditors ...RequestEditorFn) (*GetV1QrcodeResponse, error)
+
+	// GetV1ReportingBillOfMaterialsWithResponse request
+	GetV1ReportingBillOfMaterialsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ReportingBillOfMaterialsResponse, error)
+
+	// GetV1StatusWithResponse request
+	GetV1StatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1StatusResponse, error)
+
+	// PutV1UsersChangePasswordWithBodyWithResponse request with any body
+	PutV1UsersChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1UsersChangePasswordResponse, error)
+
+	// PostV1UsersLoginWithBodyWithResponse request with any body
+	PostV1UsersLoginWithBodyWithResponse(ctx context.Context, params *PostV1UsersLoginParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1UsersLoginResponse, error)
+
+	PostV1UsersLoginWithResponse(ctx context.Context, params *PostV1UsersLoginParams, body PostV1UsersLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1UsersLoginResponse, error)
+
+	PostV1UsersLoginWithFormdataBodyWithResponse(ctx context.Context, params *PostV1UsersLoginParams, body PostV1UsersLoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostV1UsersLoginResponse, error)
+
+	// PostV1UsersLogoutWithResponse request
+	PostV1UsersLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1UsersLogoutResponse, error)
+
+	// GetV1UsersRefreshWithResponse request
+	GetV1UsersRefreshWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1UsersRefreshResponse, error)
+
+	// PostV1UsersRegisterWithBodyWithResponse request with any body
+	PostV1UsersRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1UsersRegisterResponse, error)
+
+	// DeleteV1UsersSelfWithResponse request
+	DeleteV1UsersSelfWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteV1UsersSelfResponse, error)
+
+	// GetV1UsersSelfWithResponse request
+	GetV1UsersSelfWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1UsersSelfResponse, error)
+
+	// PutV1UsersSelfWithBodyWithResponse request with any body
+	PutV1UsersSelfWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1UsersSelfResponse, error)
+}
+
+type PostV1ActionsEnsureAssetIdsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ActionAmountResult
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ActionsEnsureAssetIdsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ActionsEnsureAssetIdsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1ActionsEnsureAssetIdsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1ActionsEnsureImportRefsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ActionAmountResult
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ActionsEnsureImportRefsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ActionsEnsureImportRefsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1ActionsEnsureImportRefsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1ActionsSetPrimaryPhotosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ActionAmountResult
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ActionsSetPrimaryPhotosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ActionsSetPrimaryPhotosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1ActionsSetPrimaryPhotosResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1ActionsZeroItemTimeFieldsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ActionAmountResult
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ActionsZeroItemTimeFieldsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ActionsZeroItemTimeFieldsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1ActionsZeroItemTimeFieldsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1AssetsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoPaginationResultRepoItemSummary
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1AssetsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1AssetsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1AssetsIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1CurrencyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CurrenciesCurrency
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1CurrencyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1CurrencyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1CurrencyResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1GroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1GroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1GroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1GroupsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PutV1GroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1GroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1GroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutV1GroupsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1GroupsInvitationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1GroupInvitation
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1GroupsInvitationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1GroupsInvitationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1GroupsInvitationsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1GroupsStatisticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoGroupStatistics
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1GroupsStatisticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1GroupsStatisticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1GroupsStatisticsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1GroupsStatisticsLabelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RepoTotalsByOrganizer
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1GroupsStatisticsLabelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1GroupsStatisticsLabelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1GroupsStatisticsLabelsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1GroupsStatisticsLocationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RepoTotalsByOrganizer
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1GroupsStatisticsLocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1GroupsStatisticsLocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1GroupsStatisticsLocationsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1GroupsStatisticsPurchasePriceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoValueOverTime
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1GroupsStatisticsPurchasePriceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1GroupsStatisticsPurchasePriceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1GroupsStatisticsPurchasePriceResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1ItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoPaginationResultRepoItemSummary
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1ItemsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1ItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RepoItemSummary
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1ItemsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1ItemsExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ItemsExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ItemsExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1ItemsExportResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1ItemsFieldsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ItemsFieldsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ItemsFieldsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1ItemsFieldsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1ItemsFieldsValuesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ItemsFieldsValuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ItemsFieldsValuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1ItemsFieldsValuesResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1ItemsImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ItemsImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ItemsImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1ItemsImportResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteV1ItemsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1ItemsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1ItemsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteV1ItemsIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1ItemsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoItemOut
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ItemsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ItemsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1ItemsIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PatchV1ItemsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoItemOut
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchV1ItemsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchV1ItemsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PatchV1ItemsIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PutV1ItemsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoItemOut
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1ItemsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1ItemsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutV1ItemsIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1ItemsIdAttachmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoItemOut
+	JSON422      *ValidateErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ItemsIdAttachmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ItemsIdAttachmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1ItemsIdAttachmentsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteV1ItemsIdAttachmentsAttachmentIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1ItemsIdAttachmentsAttachmentIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1ItemsIdAttachmentsAttachmentIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteV1ItemsIdAttachmentsAttachmentIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1ItemsIdAttachmentsAttachmentIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ItemsIdAttachmentsAttachmentIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ItemsIdAttachmentsAttachmentIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1ItemsIdAttachmentsAttachmentIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PutV1ItemsIdAttachmentsAttachmentIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoItemOut
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1ItemsIdAttachmentsAttachmentIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1ItemsIdAttachmentsAttachmentIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutV1ItemsIdAttachmentsAttachmentIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1ItemsIdMaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RepoMaintenanceEntryWithDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ItemsIdMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ItemsIdMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1ItemsIdMaintenanceResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1ItemsIdMaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RepoMaintenanceEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1ItemsIdMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1ItemsIdMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1ItemsIdMaintenanceResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1ItemsIdPathResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RepoItemPath
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ItemsIdPathResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ItemsIdPathResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1ItemsIdPathResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1LabelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RepoLabelOut
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1LabelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1LabelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1LabelsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1LabelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoLabelSummary
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1LabelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1LabelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1LabelsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteV1LabelsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1LabelsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1LabelsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteV1LabelsIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1LabelsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoLabelOut
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1LabelsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1LabelsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1LabelsIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PutV1LabelsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoLabelOut
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1LabelsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1LabelsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutV1LabelsIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1LocationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RepoLocationOutCount
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1LocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1LocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1LocationsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1LocationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoLocationSummary
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1LocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1LocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1LocationsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1LocationsTreeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RepoTreeItem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1LocationsTreeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1LocationsTreeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1LocationsTreeResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteV1LocationsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1LocationsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1LocationsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteV1LocationsIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1LocationsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoLocationOut
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1LocationsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1LocationsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1LocationsIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PutV1LocationsIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoLocationOut
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1LocationsIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1LocationsIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutV1LocationsIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1MaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RepoMaintenanceEntryWithDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1MaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1MaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1MaintenanceResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteV1MaintenanceIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1MaintenanceIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1MaintenanceIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteV1MaintenanceIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PutV1MaintenanceIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoMaintenanceEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1MaintenanceIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1MaintenanceIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutV1MaintenanceIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1NotifiersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RepoNotifierOut
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1NotifiersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1NotifiersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1NotifiersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1NotifiersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoNotifierOut
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1NotifiersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1NotifiersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1NotifiersResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1NotifiersTestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1NotifiersTestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1NotifiersTestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1NotifiersTestResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteV1NotifiersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1NotifiersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1NotifiersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteV1NotifiersIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PutV1NotifiersIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RepoNotifierOut
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1NotifiersIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1NotifiersIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutV1NotifiersIdResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1QrcodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1QrcodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1QrcodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1QrcodeResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1ReportingBillOfMaterialsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1ReportingBillOfMaterialsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1ReportingBillOfMaterialsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1ReportingBillOfMaterialsResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1StatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1APISummary
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1StatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1StatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1StatusResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PutV1UsersChangePasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1UsersChangePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1UsersChangePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutV1UsersChangePasswordResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1UsersLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1TokenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1UsersLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1UsersLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1UsersLoginResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1UsersLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1UsersLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1UsersLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1UsersLogoutResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1UsersRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1UsersRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1UsersRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1UsersRefreshResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PostV1UsersRegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostV1UsersRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostV1UsersRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PostV1UsersRegisterResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type DeleteV1UsersSelfResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteV1UsersSelfResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteV1UsersSelfResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r DeleteV1UsersSelfResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type GetV1UsersSelfResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Item *RepoUserOut `json:"item,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetV1UsersSelfResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetV1UsersSelfResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r GetV1UsersSelfResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+type PutV1UsersSelfResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Item *RepoUserUpdate `json:"item,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PutV1UsersSelfResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutV1UsersSelfResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ContentType is a convenience method to retrieve the Content-Type value from the HTTP response headers
+func (r PutV1UsersSelfResponse) ContentType() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("Content-Type")
+	}
+	return ""
+}
+
+// PostV1ActionsEnsureAssetIdsWithResponse request returning *PostV1ActionsEnsureAssetIdsResponse
+func (c *ClientWithResponses) PostV1ActionsEnsureAssetIdsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1ActionsEnsureAssetIdsResponse, error) {
+	rsp, err := c.PostV1ActionsEnsureAssetIds(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ActionsEnsureAssetIdsResponse(rsp)
+}
+
+// PostV1ActionsEnsureImportRefsWithResponse request returning *PostV1ActionsEnsureImportRefsResponse
+func (c *ClientWithResponses) PostV1ActionsEnsureImportRefsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1ActionsEnsureImportRefsResponse, error) {
+	rsp, err := c.PostV1ActionsEnsureImportRefs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ActionsEnsureImportRefsResponse(rsp)
+}
+
+// PostV1ActionsSetPrimaryPhotosWithResponse request returning *PostV1ActionsSetPrimaryPhotosResponse
+func (c *ClientWithResponses) PostV1ActionsSetPrimaryPhotosWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1ActionsSetPrimaryPhotosResponse, error) {
+	rsp, err := c.PostV1ActionsSetPrimaryPhotos(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ActionsSetPrimaryPhotosResponse(rsp)
+}
+
+// PostV1ActionsZeroItemTimeFieldsWithResponse request returning *PostV1ActionsZeroItemTimeFieldsResponse
+func (c *ClientWithResponses) PostV1ActionsZeroItemTimeFieldsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1ActionsZeroItemTimeFieldsResponse, error) {
+	rsp, err := c.PostV1ActionsZeroItemTimeFields(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ActionsZeroItemTimeFieldsResponse(rsp)
+}
+
+// GetV1AssetsIdWithResponse request returning *GetV1AssetsIdResponse
+func (c *ClientWithResponses) GetV1AssetsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1AssetsIdResponse, error) {
+	rsp, err := c.GetV1AssetsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1AssetsIdResponse(rsp)
+}
+
+// GetV1CurrencyWithResponse request returning *GetV1CurrencyResponse
+func (c *ClientWithResponses) GetV1CurrencyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1CurrencyResponse, error) {
+	rsp, err := c.GetV1Currency(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1CurrencyResponse(rsp)
+}
+
+// GetV1GroupsWithResponse request returning *GetV1GroupsResponse
+func (c *ClientWithResponses) GetV1GroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1GroupsResponse, error) {
+	rsp, err := c.GetV1Groups(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1GroupsResponse(rsp)
+}
+
+// PutV1GroupsWithBodyWithResponse request with arbitrary body returning *PutV1GroupsResponse
+func (c *ClientWithResponses) PutV1GroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1GroupsResponse, error) {
+	rsp, err := c.PutV1GroupsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1GroupsResponse(rsp)
+}
+
+// PostV1GroupsInvitationsWithBodyWithResponse request with arbitrary body returning *PostV1GroupsInvitationsResponse
+func (c *ClientWithResponses) PostV1GroupsInvitationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1GroupsInvitationsResponse, error) {
+	rsp, err := c.PostV1GroupsInvitationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1GroupsInvitationsResponse(rsp)
+}
+
+// GetV1GroupsStatisticsWithResponse request returning *GetV1GroupsStatisticsResponse
+func (c *ClientWithResponses) GetV1GroupsStatisticsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1GroupsStatisticsResponse, error) {
+	rsp, err := c.GetV1GroupsStatistics(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1GroupsStatisticsResponse(rsp)
+}
+
+// GetV1GroupsStatisticsLabelsWithResponse request returning *GetV1GroupsStatisticsLabelsResponse
+func (c *ClientWithResponses) GetV1GroupsStatisticsLabelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1GroupsStatisticsLabelsResponse, error) {
+	rsp, err := c.GetV1GroupsStatisticsLabels(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1GroupsStatisticsLabelsResponse(rsp)
+}
+
+// GetV1GroupsStatisticsLocationsWithResponse request returning *GetV1GroupsStatisticsLocationsResponse
+func (c *ClientWithResponses) GetV1GroupsStatisticsLocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1GroupsStatisticsLocationsResponse, error) {
+	rsp, err := c.GetV1GroupsStatisticsLocations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1GroupsStatisticsLocationsResponse(rsp)
+}
+
+// GetV1GroupsStatisticsPurchasePriceWithResponse request returning *GetV1GroupsStatisticsPurchasePriceResponse
+func (c *ClientWithResponses) GetV1GroupsStatisticsPurchasePriceWithResponse(ctx context.Context, params *GetV1GroupsStatisticsPurchasePriceParams, reqEditors ...RequestEditorFn) (*GetV1GroupsStatisticsPurchasePriceResponse, error) {
+	rsp, err := c.GetV1GroupsStatisticsPurchasePrice(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1GroupsStatisticsPurchasePriceResponse(rsp)
+}
+
+// GetV1ItemsWithResponse request returning *GetV1ItemsResponse
+func (c *ClientWithResponses) GetV1ItemsWithResponse(ctx context.Context, params *GetV1ItemsParams, reqEditors ...RequestEditorFn) (*GetV1ItemsResponse, error) {
+	rsp, err := c.GetV1Items(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ItemsResponse(rsp)
+}
+
+// PostV1ItemsWithBodyWithResponse request with arbitrary body returning *PostV1ItemsResponse
+func (c *ClientWithResponses) PostV1ItemsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ItemsResponse, error) {
+	rsp, err := c.PostV1ItemsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ItemsResponse(rsp)
+}
+
+// GetV1ItemsExportWithResponse request returning *GetV1ItemsExportResponse
+func (c *ClientWithResponses) GetV1ItemsExportWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ItemsExportResponse, error) {
+	rsp, err := c.GetV1ItemsExport(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ItemsExportResponse(rsp)
+}
+
+// GetV1ItemsFieldsWithResponse request returning *GetV1ItemsFieldsResponse
+func (c *ClientWithResponses) GetV1ItemsFieldsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ItemsFieldsResponse, error) {
+	rsp, err := c.GetV1ItemsFields(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ItemsFieldsResponse(rsp)
+}
+
+// GetV1ItemsFieldsValuesWithResponse request returning *GetV1ItemsFieldsValuesResponse
+func (c *ClientWithResponses) GetV1ItemsFieldsValuesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ItemsFieldsValuesResponse, error) {
+	rsp, err := c.GetV1ItemsFieldsValues(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ItemsFieldsValuesResponse(rsp)
+}
+
+// PostV1ItemsImportWithBodyWithResponse request with arbitrary body returning *PostV1ItemsImportResponse
+func (c *ClientWithResponses) PostV1ItemsImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ItemsImportResponse, error) {
+	rsp, err := c.PostV1ItemsImportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ItemsImportResponse(rsp)
+}
+
+// DeleteV1ItemsIdWithResponse request returning *DeleteV1ItemsIdResponse
+func (c *ClientWithResponses) DeleteV1ItemsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1ItemsIdResponse, error) {
+	rsp, err := c.DeleteV1ItemsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1ItemsIdResponse(rsp)
+}
+
+// GetV1ItemsIdWithResponse request returning *GetV1ItemsIdResponse
+func (c *ClientWithResponses) GetV1ItemsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1ItemsIdResponse, error) {
+	rsp, err := c.GetV1ItemsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ItemsIdResponse(rsp)
+}
+
+// PatchV1ItemsIdWithBodyWithResponse request with arbitrary body returning *PatchV1ItemsIdResponse
+func (c *ClientWithResponses) PatchV1ItemsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchV1ItemsIdResponse, error) {
+	rsp, err := c.PatchV1ItemsIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchV1ItemsIdResponse(rsp)
+}
+
+// PutV1ItemsIdWithBodyWithResponse request with arbitrary body returning *PutV1ItemsIdResponse
+func (c *ClientWithResponses) PutV1ItemsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1ItemsIdResponse, error) {
+	rsp, err := c.PutV1ItemsIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1ItemsIdResponse(rsp)
+}
+
+// PostV1ItemsIdAttachmentsWithBodyWithResponse request with arbitrary body returning *PostV1ItemsIdAttachmentsResponse
+func (c *ClientWithResponses) PostV1ItemsIdAttachmentsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ItemsIdAttachmentsResponse, error) {
+	rsp, err := c.PostV1ItemsIdAttachmentsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ItemsIdAttachmentsResponse(rsp)
+}
+
+// DeleteV1ItemsIdAttachmentsAttachmentIdWithResponse request returning *DeleteV1ItemsIdAttachmentsAttachmentIdResponse
+func (c *ClientWithResponses) DeleteV1ItemsIdAttachmentsAttachmentIdWithResponse(ctx context.Context, id string, attachmentId string, reqEditors ...RequestEditorFn) (*DeleteV1ItemsIdAttachmentsAttachmentIdResponse, error) {
+	rsp, err := c.DeleteV1ItemsIdAttachmentsAttachmentId(ctx, id, attachmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1ItemsIdAttachmentsAttachmentIdResponse(rsp)
+}
+
+// GetV1ItemsIdAttachmentsAttachmentIdWithResponse request returning *GetV1ItemsIdAttachmentsAttachmentIdResponse
+func (c *ClientWithResponses) GetV1ItemsIdAttachmentsAttachmentIdWithResponse(ctx context.Context, id string, attachmentId string, reqEditors ...RequestEditorFn) (*GetV1ItemsIdAttachmentsAttachmentIdResponse, error) {
+	rsp, err := c.GetV1ItemsIdAttachmentsAttachmentId(ctx, id, attachmentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ItemsIdAttachmentsAttachmentIdResponse(rsp)
+}
+
+// PutV1ItemsIdAttachmentsAttachmentIdWithBodyWithResponse request with arbitrary body returning *PutV1ItemsIdAttachmentsAttachmentIdResponse
+func (c *ClientWithResponses) PutV1ItemsIdAttachmentsAttachmentIdWithBodyWithResponse(ctx context.Context, id string, attachmentId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1ItemsIdAttachmentsAttachmentIdResponse, error) {
+	rsp, err := c.PutV1ItemsIdAttachmentsAttachmentIdWithBody(ctx, id, attachmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1ItemsIdAttachmentsAttachmentIdResponse(rsp)
+}
+
+// GetV1ItemsIdMaintenanceWithResponse request returning *GetV1ItemsIdMaintenanceResponse
+func (c *ClientWithResponses) GetV1ItemsIdMaintenanceWithResponse(ctx context.Context, id string, params *GetV1ItemsIdMaintenanceParams, reqEditors ...RequestEditorFn) (*GetV1ItemsIdMaintenanceResponse, error) {
+	rsp, err := c.GetV1ItemsIdMaintenance(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ItemsIdMaintenanceResponse(rsp)
+}
+
+// PostV1ItemsIdMaintenanceWithBodyWithResponse request with arbitrary body returning *PostV1ItemsIdMaintenanceResponse
+func (c *ClientWithResponses) PostV1ItemsIdMaintenanceWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1ItemsIdMaintenanceResponse, error) {
+	rsp, err := c.PostV1ItemsIdMaintenanceWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1ItemsIdMaintenanceResponse(rsp)
+}
+
+// GetV1ItemsIdPathWithResponse request returning *GetV1ItemsIdPathResponse
+func (c *ClientWithResponses) GetV1ItemsIdPathWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1ItemsIdPathResponse, error) {
+	rsp, err := c.GetV1ItemsIdPath(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ItemsIdPathResponse(rsp)
+}
+
+// GetV1LabelsWithResponse request returning *GetV1LabelsResponse
+func (c *ClientWithResponses) GetV1LabelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1LabelsResponse, error) {
+	rsp, err := c.GetV1Labels(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1LabelsResponse(rsp)
+}
+
+// PostV1LabelsWithBodyWithResponse request with arbitrary body returning *PostV1LabelsResponse
+func (c *ClientWithResponses) PostV1LabelsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1LabelsResponse, error) {
+	rsp, err := c.PostV1LabelsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1LabelsResponse(rsp)
+}
+
+// DeleteV1LabelsIdWithResponse request returning *DeleteV1LabelsIdResponse
+func (c *ClientWithResponses) DeleteV1LabelsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1LabelsIdResponse, error) {
+	rsp, err := c.DeleteV1LabelsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1LabelsIdResponse(rsp)
+}
+
+// GetV1LabelsIdWithResponse request returning *GetV1LabelsIdResponse
+func (c *ClientWithResponses) GetV1LabelsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1LabelsIdResponse, error) {
+	rsp, err := c.GetV1LabelsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1LabelsIdResponse(rsp)
+}
+
+// PutV1LabelsIdWithResponse request returning *PutV1LabelsIdResponse
+func (c *ClientWithResponses) PutV1LabelsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PutV1LabelsIdResponse, error) {
+	rsp, err := c.PutV1LabelsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1LabelsIdResponse(rsp)
+}
+
+// GetV1LocationsWithResponse request returning *GetV1LocationsResponse
+func (c *ClientWithResponses) GetV1LocationsWithResponse(ctx context.Context, params *GetV1LocationsParams, reqEditors ...RequestEditorFn) (*GetV1LocationsResponse, error) {
+	rsp, err := c.GetV1Locations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1LocationsResponse(rsp)
+}
+
+// PostV1LocationsWithBodyWithResponse request with arbitrary body returning *PostV1LocationsResponse
+func (c *ClientWithResponses) PostV1LocationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1LocationsResponse, error) {
+	rsp, err := c.PostV1LocationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1LocationsResponse(rsp)
+}
+
+// GetV1LocationsTreeWithResponse request returning *GetV1LocationsTreeResponse
+func (c *ClientWithResponses) GetV1LocationsTreeWithResponse(ctx context.Context, params *GetV1LocationsTreeParams, reqEditors ...RequestEditorFn) (*GetV1LocationsTreeResponse, error) {
+	rsp, err := c.GetV1LocationsTree(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1LocationsTreeResponse(rsp)
+}
+
+// DeleteV1LocationsIdWithResponse request returning *DeleteV1LocationsIdResponse
+func (c *ClientWithResponses) DeleteV1LocationsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1LocationsIdResponse, error) {
+	rsp, err := c.DeleteV1LocationsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1LocationsIdResponse(rsp)
+}
+
+// GetV1LocationsIdWithResponse request returning *GetV1LocationsIdResponse
+func (c *ClientWithResponses) GetV1LocationsIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetV1LocationsIdResponse, error) {
+	rsp, err := c.GetV1LocationsId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1LocationsIdResponse(rsp)
+}
+
+// PutV1LocationsIdWithBodyWithResponse request with arbitrary body returning *PutV1LocationsIdResponse
+func (c *ClientWithResponses) PutV1LocationsIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1LocationsIdResponse, error) {
+	rsp, err := c.PutV1LocationsIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1LocationsIdResponse(rsp)
+}
+
+// GetV1MaintenanceWithResponse request returning *GetV1MaintenanceResponse
+func (c *ClientWithResponses) GetV1MaintenanceWithResponse(ctx context.Context, params *GetV1MaintenanceParams, reqEditors ...RequestEditorFn) (*GetV1MaintenanceResponse, error) {
+	rsp, err := c.GetV1Maintenance(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1MaintenanceResponse(rsp)
+}
+
+// DeleteV1MaintenanceIdWithResponse request returning *DeleteV1MaintenanceIdResponse
+func (c *ClientWithResponses) DeleteV1MaintenanceIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1MaintenanceIdResponse, error) {
+	rsp, err := c.DeleteV1MaintenanceId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1MaintenanceIdResponse(rsp)
+}
+
+// PutV1MaintenanceIdWithBodyWithResponse request with arbitrary body returning *PutV1MaintenanceIdResponse
+func (c *ClientWithResponses) PutV1MaintenanceIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1MaintenanceIdResponse, error) {
+	rsp, err := c.PutV1MaintenanceIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1MaintenanceIdResponse(rsp)
+}
+
+// GetV1NotifiersWithResponse request returning *GetV1NotifiersResponse
+func (c *ClientWithResponses) GetV1NotifiersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1NotifiersResponse, error) {
+	rsp, err := c.GetV1Notifiers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1NotifiersResponse(rsp)
+}
+
+// PostV1NotifiersWithBodyWithResponse request with arbitrary body returning *PostV1NotifiersResponse
+func (c *ClientWithResponses) PostV1NotifiersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1NotifiersResponse, error) {
+	rsp, err := c.PostV1NotifiersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1NotifiersResponse(rsp)
+}
+
+// PostV1NotifiersTestWithResponse request returning *PostV1NotifiersTestResponse
+func (c *ClientWithResponses) PostV1NotifiersTestWithResponse(ctx context.Context, params *PostV1NotifiersTestParams, reqEditors ...RequestEditorFn) (*PostV1NotifiersTestResponse, error) {
+	rsp, err := c.PostV1NotifiersTest(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1NotifiersTestResponse(rsp)
+}
+
+// DeleteV1NotifiersIdWithResponse request returning *DeleteV1NotifiersIdResponse
+func (c *ClientWithResponses) DeleteV1NotifiersIdWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteV1NotifiersIdResponse, error) {
+	rsp, err := c.DeleteV1NotifiersId(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1NotifiersIdResponse(rsp)
+}
+
+// PutV1NotifiersIdWithBodyWithResponse request with arbitrary body returning *PutV1NotifiersIdResponse
+func (c *ClientWithResponses) PutV1NotifiersIdWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1NotifiersIdResponse, error) {
+	rsp, err := c.PutV1NotifiersIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1NotifiersIdResponse(rsp)
+}
+
+// GetV1QrcodeWithResponse request returning *GetV1QrcodeResponse
+func (c *ClientWithResponses) GetV1QrcodeWithResponse(ctx context.Context, params *GetV1QrcodeParams, reqEditors ...RequestEditorFn) (*GetV1QrcodeResponse, error) {
+	rsp, err := c.GetV1Qrcode(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1QrcodeResponse(rsp)
+}
+
+// GetV1ReportingBillOfMaterialsWithResponse request returning *GetV1ReportingBillOfMaterialsResponse
+func (c *ClientWithResponses) GetV1ReportingBillOfMaterialsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1ReportingBillOfMaterialsResponse, error) {
+	rsp, err := c.GetV1ReportingBillOfMaterials(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1ReportingBillOfMaterialsResponse(rsp)
+}
+
+// GetV1StatusWithResponse request returning *GetV1StatusResponse
+func (c *ClientWithResponses) GetV1StatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1StatusResponse, error) {
+	rsp, err := c.GetV1Status(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1StatusResponse(rsp)
+}
+
+// PutV1UsersChangePasswordWithBodyWithResponse request with arbitrary body returning *PutV1UsersChangePasswordResponse
+func (c *ClientWithResponses) PutV1UsersChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1UsersChangePasswordResponse, error) {
+	rsp, err := c.PutV1UsersChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1UsersChangePasswordResponse(rsp)
+}
+
+// PostV1UsersLoginWithBodyWithResponse request with arbitrary body returning *PostV1UsersLoginResponse
+func (c *ClientWithResponses) PostV1UsersLoginWithBodyWithResponse(ctx context.Context, params *PostV1UsersLoginParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1UsersLoginResponse, error) {
+	rsp, err := c.PostV1UsersLoginWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1UsersLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1UsersLoginWithResponse(ctx context.Context, params *PostV1UsersLoginParams, body PostV1UsersLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostV1UsersLoginResponse, error) {
+	rsp, err := c.PostV1UsersLogin(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1UsersLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostV1UsersLoginWithFormdataBodyWithResponse(ctx context.Context, params *PostV1UsersLoginParams, body PostV1UsersLoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostV1UsersLoginResponse, error) {
+	rsp, err := c.PostV1UsersLoginWithFormdataBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1UsersLoginResponse(rsp)
+}
+
+// PostV1UsersLogoutWithResponse request returning *PostV1UsersLogoutResponse
+func (c *ClientWithResponses) PostV1UsersLogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostV1UsersLogoutResponse, error) {
+	rsp, err := c.PostV1UsersLogout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1UsersLogoutResponse(rsp)
+}
+
+// GetV1UsersRefreshWithResponse request returning *GetV1UsersRefreshResponse
+func (c *ClientWithResponses) GetV1UsersRefreshWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1UsersRefreshResponse, error) {
+	rsp, err := c.GetV1UsersRefresh(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1UsersRefreshResponse(rsp)
+}
+
+// PostV1UsersRegisterWithBodyWithResponse request with arbitrary body returning *PostV1UsersRegisterResponse
+func (c *ClientWithResponses) PostV1UsersRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostV1UsersRegisterResponse, error) {
+	rsp, err := c.PostV1UsersRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostV1UsersRegisterResponse(rsp)
+}
+
+// DeleteV1UsersSelfWithResponse request returning *DeleteV1UsersSelfResponse
+func (c *ClientWithResponses) DeleteV1UsersSelfWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteV1UsersSelfResponse, error) {
+	rsp, err := c.DeleteV1UsersSelf(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteV1UsersSelfResponse(rsp)
+}
+
+// GetV1UsersSelfWithResponse request returning *GetV1UsersSelfResponse
+func (c *ClientWithResponses) GetV1UsersSelfWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetV1UsersSelfResponse, error) {
+	rsp, err := c.GetV1UsersSelf(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetV1UsersSelfResponse(rsp)
+}
+
+// PutV1UsersSelfWithBodyWithResponse request with arbitrary body returning *PutV1UsersSelfResponse
+func (c *ClientWithResponses) PutV1UsersSelfWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutV1UsersSelfResponse, error) {
+	rsp, err := c.PutV1UsersSelfWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutV1UsersSelfResponse(rsp)
+}
+
+// ParsePostV1ActionsEnsureAssetIdsResponse parses an HTTP response from a PostV1ActionsEnsureAssetIdsWithResponse call
+func ParsePostV1ActionsEnsureAssetIdsResponse(rsp *http.Response) (*PostV1ActionsEnsureAssetIdsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1ActionsEnsureAssetIdsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ActionAmountResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1ActionsEnsureImportRefsResponse parses an HTTP response from a PostV1ActionsEnsureImportRefsWithResponse call
+func ParsePostV1ActionsEnsureImportRefsResponse(rsp *http.Response) (*PostV1ActionsEnsureImportRefsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1ActionsEnsureImportRefsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ActionAmountResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1ActionsSetPrimaryPhotosResponse parses an HTTP response from a PostV1ActionsSetPrimaryPhotosWithResponse call
+func ParsePostV1ActionsSetPrimaryPhotosResponse(rsp *http.Response) (*PostV1ActionsSetPrimaryPhotosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1ActionsSetPrimaryPhotosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ActionAmountResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1ActionsZeroItemTimeFieldsResponse parses an HTTP response from a PostV1ActionsZeroItemTimeFieldsWithResponse call
+func ParsePostV1ActionsZeroItemTimeFieldsResponse(rsp *http.Response) (*PostV1ActionsZeroItemTimeFieldsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1ActionsZeroItemTimeFieldsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1ActionAmountResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1AssetsIdResponse parses an HTTP response from a GetV1AssetsIdWithResponse call
+func ParseGetV1AssetsIdResponse(rsp *http.Response) (*GetV1AssetsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1AssetsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoPaginationResultRepoItemSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1CurrencyResponse parses an HTTP response from a GetV1CurrencyWithResponse call
+func ParseGetV1CurrencyResponse(rsp *http.Response) (*GetV1CurrencyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1CurrencyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CurrenciesCurrency
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1GroupsResponse parses an HTTP response from a GetV1GroupsWithResponse call
+func ParseGetV1GroupsResponse(rsp *http.Response) (*GetV1GroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1GroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV1GroupsResponse parses an HTTP response from a PutV1GroupsWithResponse call
+func ParsePutV1GroupsResponse(rsp *http.Response) (*PutV1GroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV1GroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1GroupsInvitationsResponse parses an HTTP response from a PostV1GroupsInvitationsWithResponse call
+func ParsePostV1GroupsInvitationsResponse(rsp *http.Response) (*PostV1GroupsInvitationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1GroupsInvitationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1GroupInvitation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1GroupsStatisticsResponse parses an HTTP response from a GetV1GroupsStatisticsWithResponse call
+func ParseGetV1GroupsStatisticsResponse(rsp *http.Response) (*GetV1GroupsStatisticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1GroupsStatisticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoGroupStatistics
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1GroupsStatisticsLabelsResponse parses an HTTP response from a GetV1GroupsStatisticsLabelsWithResponse call
+func ParseGetV1GroupsStatisticsLabelsResponse(rsp *http.Response) (*GetV1GroupsStatisticsLabelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1GroupsStatisticsLabelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RepoTotalsByOrganizer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1GroupsStatisticsLocationsResponse parses an HTTP response from a GetV1GroupsStatisticsLocationsWithResponse call
+func ParseGetV1GroupsStatisticsLocationsResponse(rsp *http.Response) (*GetV1GroupsStatisticsLocationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1GroupsStatisticsLocationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RepoTotalsByOrganizer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1GroupsStatisticsPurchasePriceResponse parses an HTTP response from a GetV1GroupsStatisticsPurchasePriceWithResponse call
+func ParseGetV1GroupsStatisticsPurchasePriceResponse(rsp *http.Response) (*GetV1GroupsStatisticsPurchasePriceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1GroupsStatisticsPurchasePriceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoValueOverTime
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ItemsResponse parses an HTTP response from a GetV1ItemsWithResponse call
+func ParseGetV1ItemsResponse(rsp *http.Response) (*GetV1ItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoPaginationResultRepoItemSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1ItemsResponse parses an HTTP response from a PostV1ItemsWithResponse call
+func ParsePostV1ItemsResponse(rsp *http.Response) (*PostV1ItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1ItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RepoItemSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ItemsExportResponse parses an HTTP response from a GetV1ItemsExportWithResponse call
+func ParseGetV1ItemsExportResponse(rsp *http.Response) (*GetV1ItemsExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ItemsExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ItemsFieldsResponse parses an HTTP response from a GetV1ItemsFieldsWithResponse call
+func ParseGetV1ItemsFieldsResponse(rsp *http.Response) (*GetV1ItemsFieldsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ItemsFieldsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ItemsFieldsValuesResponse parses an HTTP response from a GetV1ItemsFieldsValuesWithResponse call
+func ParseGetV1ItemsFieldsValuesResponse(rsp *http.Response) (*GetV1ItemsFieldsValuesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ItemsFieldsValuesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1ItemsImportResponse parses an HTTP response from a PostV1ItemsImportWithResponse call
+func ParsePostV1ItemsImportResponse(rsp *http.Response) (*PostV1ItemsImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1ItemsImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1ItemsIdResponse parses an HTTP response from a DeleteV1ItemsIdWithResponse call
+func ParseDeleteV1ItemsIdResponse(rsp *http.Response) (*DeleteV1ItemsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1ItemsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ItemsIdResponse parses an HTTP response from a GetV1ItemsIdWithResponse call
+func ParseGetV1ItemsIdResponse(rsp *http.Response) (*GetV1ItemsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ItemsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoItemOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchV1ItemsIdResponse parses an HTTP response from a PatchV1ItemsIdWithResponse call
+func ParsePatchV1ItemsIdResponse(rsp *http.Response) (*PatchV1ItemsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchV1ItemsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoItemOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV1ItemsIdResponse parses an HTTP response from a PutV1ItemsIdWithResponse call
+func ParsePutV1ItemsIdResponse(rsp *http.Response) (*PutV1ItemsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV1ItemsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoItemOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1ItemsIdAttachmentsResponse parses an HTTP response from a PostV1ItemsIdAttachmentsWithResponse call
+func ParsePostV1ItemsIdAttachmentsResponse(rsp *http.Response) (*PostV1ItemsIdAttachmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1ItemsIdAttachmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoItemOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ValidateErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1ItemsIdAttachmentsAttachmentIdResponse parses an HTTP response from a DeleteV1ItemsIdAttachmentsAttachmentIdWithResponse call
+func ParseDeleteV1ItemsIdAttachmentsAttachmentIdResponse(rsp *http.Response) (*DeleteV1ItemsIdAttachmentsAttachmentIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1ItemsIdAttachmentsAttachmentIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ItemsIdAttachmentsAttachmentIdResponse parses an HTTP response from a GetV1ItemsIdAttachmentsAttachmentIdWithResponse call
+func ParseGetV1ItemsIdAttachmentsAttachmentIdResponse(rsp *http.Response) (*GetV1ItemsIdAttachmentsAttachmentIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ItemsIdAttachmentsAttachmentIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutV1ItemsIdAttachmentsAttachmentIdResponse parses an HTTP response from a PutV1ItemsIdAttachmentsAttachmentIdWithResponse call
+func ParsePutV1ItemsIdAttachmentsAttachmentIdResponse(rsp *http.Response) (*PutV1ItemsIdAttachmentsAttachmentIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV1ItemsIdAttachmentsAttachmentIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoItemOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ItemsIdMaintenanceResponse parses an HTTP response from a GetV1ItemsIdMaintenanceWithResponse call
+func ParseGetV1ItemsIdMaintenanceResponse(rsp *http.Response) (*GetV1ItemsIdMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ItemsIdMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RepoMaintenanceEntryWithDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1ItemsIdMaintenanceResponse parses an HTTP response from a PostV1ItemsIdMaintenanceWithResponse call
+func ParsePostV1ItemsIdMaintenanceResponse(rsp *http.Response) (*PostV1ItemsIdMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1ItemsIdMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RepoMaintenanceEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ItemsIdPathResponse parses an HTTP response from a GetV1ItemsIdPathWithResponse call
+func ParseGetV1ItemsIdPathResponse(rsp *http.Response) (*GetV1ItemsIdPathResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ItemsIdPathResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RepoItemPath
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1LabelsResponse parses an HTTP response from a GetV1LabelsWithResponse call
+func ParseGetV1LabelsResponse(rsp *http.Response) (*GetV1LabelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1LabelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RepoLabelOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1LabelsResponse parses an HTTP response from a PostV1LabelsWithResponse call
+func ParsePostV1LabelsResponse(rsp *http.Response) (*PostV1LabelsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1LabelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoLabelSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1LabelsIdResponse parses an HTTP response from a DeleteV1LabelsIdWithResponse call
+func ParseDeleteV1LabelsIdResponse(rsp *http.Response) (*DeleteV1LabelsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1LabelsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetV1LabelsIdResponse parses an HTTP response from a GetV1LabelsIdWithResponse call
+func ParseGetV1LabelsIdResponse(rsp *http.Response) (*GetV1LabelsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1LabelsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoLabelOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV1LabelsIdResponse parses an HTTP response from a PutV1LabelsIdWithResponse call
+func ParsePutV1LabelsIdResponse(rsp *http.Response) (*PutV1LabelsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV1LabelsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoLabelOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1LocationsResponse parses an HTTP response from a GetV1LocationsWithResponse call
+func ParseGetV1LocationsResponse(rsp *http.Response) (*GetV1LocationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1LocationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RepoLocationOutCount
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1LocationsResponse parses an HTTP response from a PostV1LocationsWithResponse call
+func ParsePostV1LocationsResponse(rsp *http.Response) (*PostV1LocationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1LocationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoLocationSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1LocationsTreeResponse parses an HTTP response from a GetV1LocationsTreeWithResponse call
+func ParseGetV1LocationsTreeResponse(rsp *http.Response) (*GetV1LocationsTreeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1LocationsTreeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RepoTreeItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1LocationsIdResponse parses an HTTP response from a DeleteV1LocationsIdWithResponse call
+func ParseDeleteV1LocationsIdResponse(rsp *http.Response) (*DeleteV1LocationsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1LocationsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetV1LocationsIdResponse parses an HTTP response from a GetV1LocationsIdWithResponse call
+func ParseGetV1LocationsIdResponse(rsp *http.Response) (*GetV1LocationsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1LocationsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoLocationOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV1LocationsIdResponse parses an HTTP response from a PutV1LocationsIdWithResponse call
+func ParsePutV1LocationsIdResponse(rsp *http.Response) (*PutV1LocationsIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV1LocationsIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoLocationOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1MaintenanceResponse parses an HTTP response from a GetV1MaintenanceWithResponse call
+func ParseGetV1MaintenanceResponse(rsp *http.Response) (*GetV1MaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1MaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RepoMaintenanceEntryWithDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1MaintenanceIdResponse parses an HTTP response from a DeleteV1MaintenanceIdWithResponse call
+func ParseDeleteV1MaintenanceIdResponse(rsp *http.Response) (*DeleteV1MaintenanceIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1MaintenanceIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutV1MaintenanceIdResponse parses an HTTP response from a PutV1MaintenanceIdWithResponse call
+func ParsePutV1MaintenanceIdResponse(rsp *http.Response) (*PutV1MaintenanceIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV1MaintenanceIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoMaintenanceEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1NotifiersResponse parses an HTTP response from a GetV1NotifiersWithResponse call
+func ParseGetV1NotifiersResponse(rsp *http.Response) (*GetV1NotifiersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1NotifiersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RepoNotifierOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1NotifiersResponse parses an HTTP response from a PostV1NotifiersWithResponse call
+func ParsePostV1NotifiersResponse(rsp *http.Response) (*PostV1NotifiersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1NotifiersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoNotifierOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1NotifiersTestResponse parses an HTTP response from a PostV1NotifiersTestWithResponse call
+func ParsePostV1NotifiersTestResponse(rsp *http.Response) (*PostV1NotifiersTestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1NotifiersTestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1NotifiersIdResponse parses an HTTP response from a DeleteV1NotifiersIdWithResponse call
+func ParseDeleteV1NotifiersIdResponse(rsp *http.Response) (*DeleteV1NotifiersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1NotifiersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutV1NotifiersIdResponse parses an HTTP response from a PutV1NotifiersIdWithResponse call
+func ParsePutV1NotifiersIdResponse(rsp *http.Response) (*PutV1NotifiersIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV1NotifiersIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RepoNotifierOut
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1QrcodeResponse parses an HTTP response from a GetV1QrcodeWithResponse call
+func ParseGetV1QrcodeResponse(rsp *http.Response) (*GetV1QrcodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1QrcodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1ReportingBillOfMaterialsResponse parses an HTTP response from a GetV1ReportingBillOfMaterialsWithResponse call
+func ParseGetV1ReportingBillOfMaterialsResponse(rsp *http.Response) (*GetV1ReportingBillOfMaterialsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1ReportingBillOfMaterialsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetV1StatusResponse parses an HTTP response from a GetV1StatusWithResponse call
+func ParseGetV1StatusResponse(rsp *http.Response) (*GetV1StatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1StatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1APISummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV1UsersChangePasswordResponse parses an HTTP response from a PutV1UsersChangePasswordWithResponse call
+func ParsePutV1UsersChangePasswordResponse(rsp *http.Response) (*PutV1UsersChangePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV1UsersChangePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostV1UsersLoginResponse parses an HTTP response from a PostV1UsersLoginWithResponse call
+func ParsePostV1UsersLoginResponse(rsp *http.Response) (*PostV1UsersLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1UsersLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1TokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostV1UsersLogoutResponse parses an HTTP response from a PostV1UsersLogoutWithResponse call
+func ParsePostV1UsersLogoutResponse(rsp *http.Response) (*PostV1UsersLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1UsersLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetV1UsersRefreshResponse parses an HTTP response from a GetV1UsersRefreshWithResponse call
+func ParseGetV1UsersRefreshResponse(rsp *http.Response) (*GetV1UsersRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1UsersRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostV1UsersRegisterResponse parses an HTTP response from a PostV1UsersRegisterWithResponse call
+func ParsePostV1UsersRegisterResponse(rsp *http.Response) (*PostV1UsersRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostV1UsersRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDeleteV1UsersSelfResponse parses an HTTP response from a DeleteV1UsersSelfWithResponse call
+func ParseDeleteV1UsersSelfResponse(rsp *http.Response) (*DeleteV1UsersSelfResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteV1UsersSelfResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetV1UsersSelfResponse parses an HTTP response from a GetV1UsersSelfWithResponse call
+func ParseGetV1UsersSelfResponse(rsp *http.Response) (*GetV1UsersSelfResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetV1UsersSelfResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Item *RepoUserOut `json:"item,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutV1UsersSelfResponse parses an HTTP response from a PutV1UsersSelfWithResponse call
+func ParsePutV1UsersSelfResponse(rsp *http.Response) (*PutV1UsersSelfResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutV1UsersSelfResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Item *RepoUserUpdate `json:"item,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
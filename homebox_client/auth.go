@@ -1,6 +1,11 @@
 package homeboxclient
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+
+	"github.com/kusold/homebox-export/homebox_client/api"
+)
 
 type LoginForm struct {
 	Username     string `json:"username"`
@@ -20,25 +25,22 @@ func (c *Client) Login(username, password string) (*TokenResponse, error) {
 		Password: password,
 	}
 
-	req, err := c.newRequest("POST", "/v1/users/login", form)
+	body, err := jsonBody(form)
 	if err != nil {
 		return nil, err
 	}
 
-	var resp TokenResponse
-	if err := c.do(req, &resp); err != nil {
+	var token TokenResponse
+	resp, err := c.api.PostV1UsersLoginWithBody(context.Background(), &api.PostV1UsersLoginParams{}, "application/json", body)
+	if err := decode(resp, err, &token); err != nil {
 		return nil, fmt.Errorf("login failed: %w", err)
 	}
 
-	c.token = resp.Token
-	return &resp, nil
+	c.token = token.Token
+	return &token, nil
 }
 
 func (c *Client) Logout() error {
-	req, err := c.newRequest("POST", "/v1/users/logout", nil)
-	if err != nil {
-		return err
-	}
-
-	return c.do(req, nil)
+	resp, err := c.api.PostV1UsersLogout(context.Background())
+	return decode(resp, err, nil)
 }
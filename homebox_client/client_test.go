@@ -0,0 +1,190 @@
+package homeboxclient_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+)
+
+func newFake() *fakehomebox.Server {
+	return fakehomebox.New(fakehomebox.Fixtures{
+		Users:     []fakehomebox.User{{Email: "me@example.com", Password: "secret"}},
+		Locations: []fakehomebox.Location{{ID: "garage", Name: "Garage"}},
+		Items: []fakehomebox.Item{
+			{
+				Item:        homeboxclient.Item{ID: "drill", Name: "Drill", AssetID: "000-001"},
+				LocationID:  "garage",
+				Attachments: []fakehomebox.Attachment{{ID: "photo", Title: "drill.jpg", Type: "photo", Primary: true, Content: "jpeg"}},
+			},
+			{Item: homeboxclient.Item{ID: "saw", Name: "Saw"}},
+		},
+	})
+}
+
+func login(t *testing.T, url string, options ...homeboxclient.Option) *homeboxclient.Client {
+	t.Helper()
+	c, err := homeboxclient.NewClient(url, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Login("me@example.com", "secret"); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	return c
+}
+
+func TestClient_Login(t *testing.T) {
+	srv := newFake().Start()
+	defer srv.Close()
+
+	c, err := homeboxclient.NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := homeboxclient.NewItemsService(c).List(1, 10); err == nil || err.Error() != `request failed with status 401: {"error":"unauthorized"}`+"\n" {
+		t.Errorf("List() before login error = %v, want unauthorized", err)
+	}
+	if _, err := c.Login("me@example.com", "wrong"); err == nil || err.Error() != `login failed: request failed with status 401: {"error":"authentication failed"}`+"\n" {
+		t.Errorf("Login() with wrong password error = %v", err)
+	}
+
+	token, err := c.Login("me@example.com", "secret")
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if !strings.HasPrefix(token.Token, "Bearer ") {
+		t.Errorf("token = %q", token.Token)
+	}
+	if _, err := homeboxclient.NewItemsService(c).List(1, 10); err != nil {
+		t.Errorf("List() after login error = %v", err)
+	}
+
+	if err := c.Logout(); err != nil {
+		t.Fatalf("Logout() error = %v", err)
+	}
+	if _, err := homeboxclient.NewItemsService(c).List(1, 10); err == nil {
+		t.Error("List() after logout succeeded")
+	}
+}
+
+func TestClient_BasePath(t *testing.T) {
+	// Homebox behind a reverse proxy below /homebox.
+	fake := newFake()
+	srv := httptest.NewServer(http.StripPrefix("/homebox", fake))
+	defer srv.Close()
+
+	for _, url := range []string{srv.URL + "/homebox", srv.URL + "/homebox/"} {
+		c := login(t, url)
+		item, err := homeboxclient.NewItemsService(c).Get("drill")
+		if err != nil {
+			t.Fatalf("Get() with base URL %s error = %v", url, err)
+		}
+		if item.Name != "Drill" || item.Location == nil || item.Location.Name != "Garage" {
+			t.Errorf("Get() = %+v", item)
+		}
+	}
+}
+
+func TestItemsService(t *testing.T) {
+	srv := newFake().Start()
+	defer srv.Close()
+	items := homeboxclient.NewItemsService(login(t, srv.URL))
+
+	page, err := items.List(2, 1)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if page.Total != 2 || len(page.Items) != 1 || page.Items[0].Name != "Saw" {
+		t.Errorf("List(2, 1) = %+v", page)
+	}
+
+	found, err := items.GetByAssetID("1")
+	if err != nil || len(found.Items) != 1 || found.Items[0].ID != "drill" {
+		t.Errorf("GetByAssetID() = %+v, %v", found, err)
+	}
+
+	if _, err := items.Get("missing"); err == nil || err.Error() != `request failed with status 404: {"error":"item missing not found"}`+"\n" {
+		t.Errorf("Get() of a missing item error = %v", err)
+	}
+
+	content, err := items.OpenAttachment("drill", "photo")
+	if err != nil {
+		t.Fatalf("OpenAttachment() error = %v", err)
+	}
+	data, _ := io.ReadAll(content)
+	content.Close()
+	if string(data) != "jpeg" || content.Size != 4 || content.ContentType != "image/jpeg" {
+		t.Errorf("OpenAttachment() = %q, size %d, type %q", data, content.Size, content.ContentType)
+	}
+
+	item, err := items.UploadAttachment("saw", "saw.txt", homeboxclient.AttachmentTypeManual, strings.NewReader("cut"))
+	if err != nil {
+		t.Fatalf("UploadAttachment() error = %v", err)
+	}
+	if len(item.Attachments) != 1 || item.Attachments[0].Document.Title != "saw.txt" || item.Attachments[0].Type != "manual" {
+		t.Errorf("UploadAttachment() = %+v", item.Attachments)
+	}
+
+	// The content is streamed, so a failing read fails the upload.
+	failing := io.MultiReader(strings.NewReader("half"), iotest.ErrReader(errors.New("disk error")))
+	if _, err := items.UploadAttachment("saw", "saw2.txt", homeboxclient.AttachmentTypeManual, failing); err == nil || !strings.Contains(err.Error(), "failed to read attachment: disk error") {
+		t.Errorf("UploadAttachment() of a failing reader error = %v", err)
+	}
+	if saw, err := items.Get("saw"); err != nil || len(saw.Attachments) != 1 {
+		t.Errorf("the failed upload added an attachment: %+v, %v", saw, err)
+	}
+}
+
+func TestClient_Faults(t *testing.T) {
+	tests := []struct {
+		name    string
+		fault   fakehomebox.Fault
+		timeout time.Duration
+		errMsg  string
+	}{
+		{
+			name:   "server error",
+			fault:  fakehomebox.Fault{Status: http.StatusBadGateway},
+			errMsg: `request failed with status 502: {"error":"Bad Gateway"}` + "\n",
+		},
+		{
+			name:   "truncated body",
+			fault:  fakehomebox.Fault{Truncate: true},
+			errMsg: "failed to decode response: unexpected EOF",
+		},
+		{
+			name:    "latency",
+			fault:   fakehomebox.Fault{Latency: time.Second},
+			timeout: 50 * time.Millisecond,
+			errMsg:  "Client.Timeout exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newFake()
+			srv := fake.Start()
+			defer srv.Close()
+			c := login(t, srv.URL, homeboxclient.WithHTTPClient(&http.Client{Timeout: tt.timeout}))
+
+			fake.AddFault(tt.fault)
+			_, err := homeboxclient.NewItemsService(c).Get("drill")
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("Get() error = %v, want %q", err, tt.errMsg)
+			}
+
+			fake.ClearFaults()
+			if _, err := homeboxclient.NewItemsService(c).Get("drill"); err != nil {
+				t.Errorf("Get() without fault error = %v", err)
+			}
+		})
+	}
+}
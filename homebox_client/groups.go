@@ -0,0 +1,165 @@
+package homeboxclient
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kusold/homebox-export/homebox_client/api"
+)
+
+type GroupsService struct {
+	client *Client
+}
+
+func NewGroupsService(c *Client) *GroupsService {
+	return &GroupsService{
+		client: c,
+	}
+}
+
+type Group struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Currency  string `json:"currency"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type GroupUpdate struct {
+	Name     string `json:"name,omitempty"`
+	Currency string `json:"currency,omitempty"`
+}
+
+type GroupInvitationCreate struct {
+	ExpiresAt string `json:"expiresAt,omitempty"`
+	Uses      int    `json:"uses"`
+}
+
+type GroupInvitation struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expiresAt"`
+	Uses      int    `json:"uses"`
+}
+
+type GroupStatistics struct {
+	TotalItemPrice    float64 `json:"totalItemPrice"`
+	TotalItems        int     `json:"totalItems"`
+	TotalLabels       int     `json:"totalLabels"`
+	TotalLocations    int     `json:"totalLocations"`
+	TotalUsers        int     `json:"totalUsers"`
+	TotalWithWarranty int     `json:"totalWithWarranty"`
+}
+
+// TotalsByOrganizer is the value of the items with a label or in a location.
+type TotalsByOrganizer struct {
+	ID    string  `json:"id"`
+	Name  string  `json:"name"`
+	Total float64 `json:"total"`
+}
+
+// ValueOverTime is the value of the group's items at the start and end of a
+// date range, with an entry for every item purchased in between.
+type ValueOverTime struct {
+	Start        string               `json:"start"`
+	End          string               `json:"end"`
+	ValueAtStart float64              `json:"valueAtStart"`
+	ValueAtEnd   float64              `json:"valueAtEnd"`
+	Entries      []ValueOverTimeEntry `json:"entries"`
+}
+
+type ValueOverTimeEntry struct {
+	Date  string  `json:"date"`
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+}
+
+func (s *GroupsService) Get() (*Group, error) {
+	var group Group
+	resp, err := s.client.api.GetV1Groups(context.Background())
+	if err := decode(resp, err, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+func (s *GroupsService) Update(group *GroupUpdate) (*Group, error) {
+	body, err := jsonBody(group)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated Group
+	resp, err := s.client.api.PutV1GroupsWithBody(context.Background(), "application/json", body)
+	if err := decode(resp, err, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (s *GroupsService) CreateInvitation(invitation *GroupInvitationCreate) (*GroupInvitation, error) {
+	body, err := jsonBody(invitation)
+	if err != nil {
+		return nil, err
+	}
+
+	var created GroupInvitation
+	resp, err := s.client.api.PostV1GroupsInvitationsWithBody(context.Background(), "application/json", body)
+	if err := decode(resp, err, &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (s *GroupsService) Statistics() (*GroupStatistics, error) {
+	var stats GroupStatistics
+	resp, err := s.client.api.GetV1GroupsStatistics(context.Background())
+	if err := decode(resp, err, &stats); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func (s *GroupsService) LabelStatistics() ([]TotalsByOrganizer, error) {
+	return s.totals(s.client.api.GetV1GroupsStatisticsLabels)
+}
+
+func (s *GroupsService) LocationStatistics() ([]TotalsByOrganizer, error) {
+	return s.totals(s.client.api.GetV1GroupsStatisticsLocations)
+}
+
+func (s *GroupsService) totals(get func(context.Context, ...api.RequestEditorFn) (*http.Response, error)) ([]TotalsByOrganizer, error) {
+	var totals []TotalsByOrganizer
+	resp, err := get(context.Background())
+	if err := decode(resp, err, &totals); err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+// PurchasePriceStatistics returns the value of the group's items over the
+// dates from start to end. Zero times leave the range open on that side.
+func (s *GroupsService) PurchasePriceStatistics(start, end time.Time) (*ValueOverTime, error) {
+	params := &api.GetV1GroupsStatisticsPurchasePriceParams{}
+	if !start.IsZero() {
+		from := start.Format(time.DateOnly)
+		params.Start = &from
+	}
+	if !end.IsZero() {
+		to := end.Format(time.DateOnly)
+		params.End = &to
+	}
+
+	var value ValueOverTime
+	resp, err := s.client.api.GetV1GroupsStatisticsPurchasePrice(context.Background(), params)
+	if err := decode(resp, err, &value); err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
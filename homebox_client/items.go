@@ -1,10 +1,14 @@
 package homeboxclient
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
-	"net/url"
+	"mime/multipart"
 	"os"
+
+	"github.com/kusold/homebox-export/homebox_client/api"
 )
 
 type ItemsService struct {
@@ -17,32 +21,170 @@ func NewItemsService(c *Client) *ItemsService {
 	}
 }
 
+// List returns a page of the items that aren't archived.
 func (s *ItemsService) List(page, pageSize int) (*PaginationResult[Item], error) {
-	u := url.Values{}
-	u.Set("page", fmt.Sprintf("%d", page))
-	u.Set("pageSize", fmt.Sprintf("%d", pageSize))
+	return s.list(page, pageSize)
+}
+
+// ListWithArchived returns a page of all items, archived ones included.
+func (s *ItemsService) ListWithArchived(page, pageSize int) (*PaginationResult[Item], error) {
+	return s.list(page, pageSize, withQuery("includeArchived", "true"))
+}
+
+func (s *ItemsService) list(page, pageSize int, editors ...api.RequestEditorFn) (*PaginationResult[Item], error) {
+	params := &api.GetV1ItemsParams{
+		Page:     &page,
+		PageSize: &pageSize,
+	}
+
+	var result PaginationResult[Item]
+	resp, err := s.client.api.GetV1Items(context.Background(), params, editors...)
+	if err := decode(resp, err, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s *ItemsService) Get(id string) (*Item, error) {
+	var item Item
+	resp, err := s.client.api.GetV1ItemsId(context.Background(), id)
+	if err := decode(resp, err, &item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
 
-	req, err := s.client.newRequest("GET", "/v1/items?"+u.Encode(), nil)
+// Create creates an item with a name, labels and location. Set its other
+// fields with Update.
+func (s *ItemsService) Create(item *ItemCreate) (*Item, error) {
+	body, err := jsonBody(item)
 	if err != nil {
 		return nil, err
 	}
 
+	var created Item
+	resp, err := s.client.api.PostV1ItemsWithBody(context.Background(), "application/json", body)
+	if err := decode(resp, err, &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (s *ItemsService) Update(id string, item *ItemUpdate) (*Item, error) {
+	body, err := jsonBody(item)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated Item
+	resp, err := s.client.api.PutV1ItemsIdWithBody(context.Background(), id, "application/json", body)
+	if err := decode(resp, err, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// GetByAssetID returns the items with an asset ID, such as "000-042". The
+// items are summaries; use Get for their details.
+func (s *ItemsService) GetByAssetID(assetID string) (*PaginationResult[Item], error) {
 	var result PaginationResult[Item]
-	if err := s.client.do(req, &result); err != nil {
+	resp, err := s.client.api.GetV1AssetsId(context.Background(), assetID)
+	if err := decode(resp, err, &result); err != nil {
 		return nil, err
 	}
 
 	return &result, nil
 }
 
-func (s *ItemsService) Get(id string) (*Item, error) {
-	req, err := s.client.newRequest("GET", fmt.Sprintf("/v1/items/%s", id), nil)
+// Export returns all items in Homebox's CSV format. The caller must close it.
+func (s *ItemsService) Export() (io.ReadCloser, error) {
+	resp, err := s.client.api.GetV1ItemsExport(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to export items: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to export items: %s: %s", resp.Status, string(body))
+	}
+
+	return resp.Body, nil
+}
+
+// Import creates or updates items from a CSV file in Homebox's import format.
+func (s *ItemsService) Import(csv io.Reader) error {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreateFormFile("csv", "items.csv")
+	if err != nil {
+		return fmt.Errorf("failed to create request body: %w", err)
+	}
+	if _, err := io.Copy(part, csv); err != nil {
+		return fmt.Errorf("failed to read CSV: %w", err)
+	}
+	if err := mw.Close(); err != nil {
+		return fmt.Errorf("failed to create request body: %w", err)
+	}
+
+	resp, err := s.client.api.PostV1ItemsImportWithBody(context.Background(), mw.FormDataContentType(), &body)
+	return decode(resp, err, nil)
+}
+
+// UploadAttachment adds a file named name as an attachment of attachmentType
+// to an item, and returns the item with its attachments. content is streamed
+// to the server rather than read into memory first.
+func (s *ItemsService) UploadAttachment(itemID, name, attachmentType string, content io.Reader) (*Item, error) {
+	pr, pw := io.Pipe()
+	// Unblocks the writer if the request ends before the body was sent.
+	defer pr.Close()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeAttachment(mw, name, attachmentType, content))
+	}()
+
+	var item Item
+	resp, err := s.client.api.PostV1ItemsIdAttachmentsWithBody(context.Background(), itemID, mw.FormDataContentType(), pr)
+	if err := decode(resp, err, &item); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// writeAttachment writes the multipart form of an attachment upload, with the
+// file last so that the other fields don't wait for it.
+func writeAttachment(mw *multipart.Writer, name, attachmentType string, content io.Reader) error {
+	if err := mw.WriteField("type", attachmentType); err != nil {
+		return err
+	}
+	if err := mw.WriteField("name", name); err != nil {
+		return err
+	}
+	part, err := mw.CreateFormFile("file", name)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, content); err != nil {
+		return fmt.Errorf("failed to read attachment: %w", err)
+	}
+	return mw.Close()
+}
+
+// UpdateAttachment changes the title, type or primary flag of an attachment.
+func (s *ItemsService) UpdateAttachment(itemID, attachmentID string, update *AttachmentUpdate) (*Item, error) {
+	body, err := jsonBody(update)
 	if err != nil {
 		return nil, err
 	}
 
 	var item Item
-	if err := s.client.do(req, &item); err != nil {
+	resp, err := s.client.api.PutV1ItemsIdAttachmentsAttachmentIdWithBody(context.Background(), itemID, attachmentID, "application/json", body)
+	if err := decode(resp, err, &item); err != nil {
 		return nil, err
 	}
 
@@ -63,32 +205,40 @@ func (s *ItemsService) Get(id string) (*Item, error) {
 // 	return &token, nil
 // }
 
-func (s *ItemsService) DownloadAttachment(itemID, attachmentID string, destPath string) error {
-	// token, err := s.GetAttachmentToken(itemID, attachmentID)
-	// if err != nil {
-	// 	return fmt.Errorf("failed to get attachment token: %w", err)
-	// }
-
-	// u := *s.client.baseURL
-	// u.Path = filepath.Join(u.Path, "attachments", attachmentID)
-	// q := u.Query()
-	// q.Set("token", token.Token)
-	// u.RawQuery = q.Encode()
-
-	req, err := s.client.newRequest("GET", fmt.Sprintf("/v1/items/%s/attachments/%s", itemID, attachmentID), nil)
-	if err != nil {
-		return err
-	}
+// AttachmentContent is the body of an attachment being downloaded. The caller
+// must close it.
+type AttachmentContent struct {
+	io.ReadCloser
+	Size        int64 // -1 when the server didn't report the length
+	ContentType string
+}
 
-	resp, err := s.client.httpClient.Do(req)
+// OpenAttachment starts downloading an attachment and returns its content as a
+// stream.
+func (s *ItemsService) OpenAttachment(itemID, attachmentID string) (*AttachmentContent, error) {
+	resp, err := s.client.api.GetV1ItemsIdAttachmentsAttachmentId(context.Background(), itemID, attachmentID)
 	if err != nil {
-		return fmt.Errorf("failed to download attachment: %w", err)
+		return nil, fmt.Errorf("failed to download attachment: %w", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("failed to download attachment: %s", resp.Status)
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to download attachment: %s", resp.Status)
+	}
+
+	return &AttachmentContent{
+		ReadCloser:  resp.Body,
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+	}, nil
+}
+
+func (s *ItemsService) DownloadAttachment(itemID, attachmentID string, destPath string) error {
+	content, err := s.OpenAttachment(itemID, attachmentID)
+	if err != nil {
+		return err
 	}
+	defer content.Close()
 
 	out, err := os.Create(destPath)
 	if err != nil {
@@ -96,7 +246,7 @@ func (s *ItemsService) DownloadAttachment(itemID, attachmentID string, destPath
 	}
 	defer out.Close()
 
-	_, err = io.Copy(out, resp.Body)
+	_, err = io.Copy(out, content)
 	if err != nil {
 		return fmt.Errorf("failed to save file: %w", err)
 	}
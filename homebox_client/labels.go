@@ -1,11 +1,12 @@
 package homeboxclient
 
-import "fmt"
+import "context"
 
 type Label struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	Color       string `json:"color"`
 	CreatedAt   string `json:"createdAt"`
 	UpdatedAt   string `json:"updatedAt"`
 }
@@ -20,14 +21,16 @@ type LabelsService struct {
 	client *Client
 }
 
-func (s *LabelsService) List() ([]Label, error) {
-	req, err := s.client.newRequest("GET", "/v1/labels", nil)
-	if err != nil {
-		return nil, err
+func NewLabelsService(c *Client) *LabelsService {
+	return &LabelsService{
+		client: c,
 	}
+}
 
+func (s *LabelsService) List() ([]Label, error) {
 	var labels []Label
-	if err := s.client.do(req, &labels); err != nil {
+	resp, err := s.client.api.GetV1Labels(context.Background())
+	if err := decode(resp, err, &labels); err != nil {
 		return nil, err
 	}
 
@@ -35,13 +38,9 @@ func (s *LabelsService) List() ([]Label, error) {
 }
 
 func (s *LabelsService) Get(id string) (*Label, error) {
-	req, err := s.client.newRequest("GET", fmt.Sprintf("/v1/labels/%s", id), nil)
-	if err != nil {
-		return nil, err
-	}
-
 	var label Label
-	if err := s.client.do(req, &label); err != nil {
+	resp, err := s.client.api.GetV1LabelsId(context.Background(), id)
+	if err := decode(resp, err, &label); err != nil {
 		return nil, err
 	}
 
@@ -49,13 +48,14 @@ func (s *LabelsService) Get(id string) (*Label, error) {
 }
 
 func (s *LabelsService) Create(label *LabelCreate) (*Label, error) {
-	req, err := s.client.newRequest("POST", "/v1/labels", label)
+	body, err := jsonBody(label)
 	if err != nil {
 		return nil, err
 	}
 
 	var created Label
-	if err := s.client.do(req, &created); err != nil {
+	resp, err := s.client.api.PostV1LabelsWithBody(context.Background(), "application/json", body)
+	if err := decode(resp, err, &created); err != nil {
 		return nil, err
 	}
 
@@ -63,13 +63,9 @@ func (s *LabelsService) Create(label *LabelCreate) (*Label, error) {
 }
 
 func (s *LabelsService) Update(id string, label *Label) (*Label, error) {
-	req, err := s.client.newRequest("PUT", fmt.Sprintf("/v1/labels/%s", id), label)
-	if err != nil {
-		return nil, err
-	}
-
 	var updated Label
-	if err := s.client.do(req, &updated); err != nil {
+	resp, err := s.client.api.PutV1LabelsId(context.Background(), id, withJSON(label))
+	if err := decode(resp, err, &updated); err != nil {
 		return nil, err
 	}
 
@@ -77,10 +73,6 @@ func (s *LabelsService) Update(id string, label *Label) (*Label, error) {
 }
 
 func (s *LabelsService) Delete(id string) error {
-	req, err := s.client.newRequest("DELETE", fmt.Sprintf("/v1/labels/%s", id), nil)
-	if err != nil {
-		return err
-	}
-
-	return s.client.do(req, nil)
+	resp, err := s.client.api.DeleteV1LabelsId(context.Background(), id)
+	return decode(resp, err, nil)
 }
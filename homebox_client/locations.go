@@ -1,14 +1,21 @@
 package homeboxclient
 
 import (
-	"fmt"
-	"net/url"
+	"context"
+
+	"github.com/kusold/homebox-export/homebox_client/api"
 )
 
 type LocationsService struct {
 	client *Client
 }
 
+func NewLocationsService(c *Client) *LocationsService {
+	return &LocationsService{
+		client: c,
+	}
+}
+
 type Location struct {
 	ID          string     `json:"id"`
 	Name        string     `json:"name"`
@@ -34,18 +41,14 @@ type LocationUpdate struct {
 }
 
 func (s *LocationsService) List(filterChildren bool) ([]Location, error) {
-	u := url.Values{}
+	params := &api.GetV1LocationsParams{}
 	if filterChildren {
-		u.Set("filterChildren", "true")
-	}
-
-	req, err := s.client.newRequest("GET", "/v1/locations?"+u.Encode(), nil)
-	if err != nil {
-		return nil, err
+		params.FilterChildren = &filterChildren
 	}
 
 	var locations []Location
-	if err := s.client.do(req, &locations); err != nil {
+	resp, err := s.client.api.GetV1Locations(context.Background(), params)
+	if err := decode(resp, err, &locations); err != nil {
 		return nil, err
 	}
 
@@ -53,13 +56,9 @@ func (s *LocationsService) List(filterChildren bool) ([]Location, error) {
 }
 
 func (s *LocationsService) Get(id string) (*Location, error) {
-	req, err := s.client.newRequest("GET", fmt.Sprintf("/v1/locations/%s", id), nil)
-	if err != nil {
-		return nil, err
-	}
-
 	var location Location
-	if err := s.client.do(req, &location); err != nil {
+	resp, err := s.client.api.GetV1LocationsId(context.Background(), id)
+	if err := decode(resp, err, &location); err != nil {
 		return nil, err
 	}
 
@@ -67,13 +66,14 @@ func (s *LocationsService) Get(id string) (*Location, error) {
 }
 
 func (s *LocationsService) Create(location *LocationCreate) (*Location, error) {
-	req, err := s.client.newRequest("POST", "/v1/locations", location)
+	body, err := jsonBody(location)
 	if err != nil {
 		return nil, err
 	}
 
 	var created Location
-	if err := s.client.do(req, &created); err != nil {
+	resp, err := s.client.api.PostV1LocationsWithBody(context.Background(), "application/json", body)
+	if err := decode(resp, err, &created); err != nil {
 		return nil, err
 	}
 
@@ -81,13 +81,14 @@ func (s *LocationsService) Create(location *LocationCreate) (*Location, error) {
 }
 
 func (s *LocationsService) Update(id string, location *LocationUpdate) (*Location, error) {
-	req, err := s.client.newRequest("PUT", fmt.Sprintf("/v1/locations/%s", id), location)
+	body, err := jsonBody(location)
 	if err != nil {
 		return nil, err
 	}
 
 	var updated Location
-	if err := s.client.do(req, &updated); err != nil {
+	resp, err := s.client.api.PutV1LocationsIdWithBody(context.Background(), id, "application/json", body)
+	if err := decode(resp, err, &updated); err != nil {
 		return nil, err
 	}
 
@@ -95,27 +96,19 @@ func (s *LocationsService) Update(id string, location *LocationUpdate) (*Locatio
 }
 
 func (s *LocationsService) Delete(id string) error {
-	req, err := s.client.newRequest("DELETE", fmt.Sprintf("/v1/locations/%s", id), nil)
-	if err != nil {
-		return err
-	}
-
-	return s.client.do(req, nil)
+	resp, err := s.client.api.DeleteV1LocationsId(context.Background(), id)
+	return decode(resp, err, nil)
 }
 
 func (s *LocationsService) GetTree(withItems bool) ([]Location, error) {
-	u := url.Values{}
+	params := &api.GetV1LocationsTreeParams{}
 	if withItems {
-		u.Set("withItems", "true")
-	}
-
-	req, err := s.client.newRequest("GET", "/v1/locations/tree?"+u.Encode(), nil)
-	if err != nil {
-		return nil, err
+		params.WithItems = &withItems
 	}
 
 	var tree []Location
-	if err := s.client.do(req, &tree); err != nil {
+	resp, err := s.client.api.GetV1LocationsTree(context.Background(), params)
+	if err := decode(resp, err, &tree); err != nil {
 		return nil, err
 	}
 
@@ -1,17 +1,20 @@
 package homeboxclient
 
 import (
-	"fmt"
-	"time"
+	"context"
+
+	"github.com/kusold/homebox-export/homebox_client/api"
 )
 
+// MaintenanceEntry is a maintenance task of an item. Like the item's dates,
+// its dates are YYYY-MM-DD or empty when unset.
 type MaintenanceEntry struct {
-	ID            string    `json:"id"`
-	Name          string    `json:"name"`
-	Description   string    `json:"description"`
-	Cost          string    `json:"cost"`
-	ScheduledDate time.Time `json:"scheduledDate"`
-	CompletedDate time.Time `json:"completedDate"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Cost          string `json:"cost"`
+	ScheduledDate string `json:"scheduledDate"`
+	CompletedDate string `json:"completedDate"`
 }
 
 type MaintenanceEntryWithDetails struct {
@@ -32,14 +35,18 @@ type MaintenanceService struct {
 	client *Client
 }
 
-func (s *MaintenanceService) List(status MaintenanceFilterStatus) ([]MaintenanceEntryWithDetails, error) {
-	req, err := s.client.newRequest("GET", fmt.Sprintf("/v1/maintenance?status=%s", status), nil)
-	if err != nil {
-		return nil, err
+func NewMaintenanceService(c *Client) *MaintenanceService {
+	return &MaintenanceService{
+		client: c,
 	}
+}
+
+func (s *MaintenanceService) List(status MaintenanceFilterStatus) ([]MaintenanceEntryWithDetails, error) {
+	filter := api.GetV1MaintenanceParamsStatus(status)
 
 	var entries []MaintenanceEntryWithDetails
-	if err := s.client.do(req, &entries); err != nil {
+	resp, err := s.client.api.GetV1Maintenance(context.Background(), &api.GetV1MaintenanceParams{Status: &filter})
+	if err := decode(resp, err, &entries); err != nil {
 		return nil, err
 	}
 
@@ -47,13 +54,11 @@ func (s *MaintenanceService) List(status MaintenanceFilterStatus) ([]Maintenance
 }
 
 func (s *MaintenanceService) GetItemMaintenance(itemID string, status MaintenanceFilterStatus) ([]MaintenanceEntryWithDetails, error) {
-	req, err := s.client.newRequest("GET", fmt.Sprintf("/v1/items/%s/maintenance?status=%s", itemID, status), nil)
-	if err != nil {
-		return nil, err
-	}
+	filter := api.GetV1ItemsIdMaintenanceParamsStatus(status)
 
 	var entries []MaintenanceEntryWithDetails
-	if err := s.client.do(req, &entries); err != nil {
+	resp, err := s.client.api.GetV1ItemsIdMaintenance(context.Background(), itemID, &api.GetV1ItemsIdMaintenanceParams{Status: &filter})
+	if err := decode(resp, err, &entries); err != nil {
 		return nil, err
 	}
 
@@ -61,13 +66,14 @@ func (s *MaintenanceService) GetItemMaintenance(itemID string, status Maintenanc
 }
 
 func (s *MaintenanceService) Create(itemID string, entry *MaintenanceEntry) (*MaintenanceEntry, error) {
-	req, err := s.client.newRequest("POST", fmt.Sprintf("/v1/items/%s/maintenance", itemID), entry)
+	body, err := jsonBody(entry)
 	if err != nil {
 		return nil, err
 	}
 
 	var created MaintenanceEntry
-	if err := s.client.do(req, &created); err != nil {
+	resp, err := s.client.api.PostV1ItemsIdMaintenanceWithBody(context.Background(), itemID, "application/json", body)
+	if err := decode(resp, err, &created); err != nil {
 		return nil, err
 	}
 
@@ -75,13 +81,14 @@ func (s *MaintenanceService) Create(itemID string, entry *MaintenanceEntry) (*Ma
 }
 
 func (s *MaintenanceService) Update(id string, entry *MaintenanceEntry) (*MaintenanceEntry, error) {
-	req, err := s.client.newRequest("PUT", fmt.Sprintf("/v1/maintenance/%s", id), entry)
+	body, err := jsonBody(entry)
 	if err != nil {
 		return nil, err
 	}
 
 	var updated MaintenanceEntry
-	if err := s.client.do(req, &updated); err != nil {
+	resp, err := s.client.api.PutV1MaintenanceIdWithBody(context.Background(), id, "application/json", body)
+	if err := decode(resp, err, &updated); err != nil {
 		return nil, err
 	}
 
@@ -89,10 +96,6 @@ func (s *MaintenanceService) Update(id string, entry *MaintenanceEntry) (*Mainte
 }
 
 func (s *MaintenanceService) Delete(id string) error {
-	req, err := s.client.newRequest("DELETE", fmt.Sprintf("/v1/maintenance/%s", id), nil)
-	if err != nil {
-		return err
-	}
-
-	return s.client.do(req, nil)
+	resp, err := s.client.api.DeleteV1MaintenanceId(context.Background(), id)
+	return decode(resp, err, nil)
 }
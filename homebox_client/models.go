@@ -17,23 +17,35 @@ type PaginatedItems struct {
 }
 
 type Item struct {
-	ID            string       `json:"id"`
-	Name          string       `json:"name"`
-	Description   string       `json:"description"`
-	Attachments   []Attachment `json:"attachments"`
-	ImageID       string       `json:"imageId"`
-	AssetID       string       `json:"assetId"`
-	Archived      bool         `json:"archived"`
-	Insured       bool         `json:"insured"`
-	Quantity      int          `json:"quantity"`
-	PurchasePrice float64      `json:"purchasePrice"`
-	PurchaseFrom  string       `json:"purchaseFrom"`
-	PurchaseTime  string       `json:"purchaseTime"`
-	CreatedAt     time.Time    `json:"createdAt"`
-	UpdatedAt     time.Time    `json:"updatedAt"`
-	Notes         string       `json:"notes"`
-	Labels        []Label      `json:"labels"`
-	Fields        []ItemField  `json:"fields"`
+	ID               string       `json:"id"`
+	Name             string       `json:"name"`
+	Description      string       `json:"description"`
+	Attachments      []Attachment `json:"attachments"`
+	ImageID          string       `json:"imageId"`
+	AssetID          string       `json:"assetId"`
+	Archived         bool         `json:"archived"`
+	Insured          bool         `json:"insured"`
+	Quantity         int          `json:"quantity"`
+	Location         *Location    `json:"location,omitempty"`
+	Parent           *Item        `json:"parent,omitempty"`
+	Manufacturer     string       `json:"manufacturer"`
+	ModelNumber      string       `json:"modelNumber"`
+	SerialNumber     string       `json:"serialNumber"`
+	PurchasePrice    float64      `json:"purchasePrice"`
+	PurchaseFrom     string       `json:"purchaseFrom"`
+	PurchaseTime     string       `json:"purchaseTime"`
+	LifetimeWarranty bool         `json:"lifetimeWarranty"`
+	WarrantyExpires  string       `json:"warrantyExpires"`
+	WarrantyDetails  string       `json:"warrantyDetails"`
+	SoldTo           string       `json:"soldTo"`
+	SoldPrice        float64      `json:"soldPrice"`
+	SoldTime         string       `json:"soldTime"`
+	SoldNotes        string       `json:"soldNotes"`
+	CreatedAt        time.Time    `json:"createdAt"`
+	UpdatedAt        time.Time    `json:"updatedAt"`
+	Notes            string       `json:"notes"`
+	Labels           []Label      `json:"labels"`
+	Fields           []ItemField  `json:"fields"`
 }
 
 type Attachment struct {
@@ -45,6 +57,15 @@ type Attachment struct {
 	Primary   bool        `json:"primary"`
 }
 
+// Attachment types used by Homebox for Attachment.Type.
+const (
+	AttachmentTypePhoto      = "photo"
+	AttachmentTypeManual     = "manual"
+	AttachmentTypeWarranty   = "warranty"
+	AttachmentTypeReceipt    = "receipt"
+	AttachmentTypeAttachment = "attachment"
+)
+
 type DocumentOut struct {
 	ID    string `json:"id"`
 	Path  string `json:"path"`
@@ -60,6 +81,12 @@ type ItemField struct {
 	BooleanValue bool   `json:"booleanValue"`
 }
 
+type AttachmentUpdate struct {
+	Title   string `json:"title"`
+	Type    string `json:"type"`
+	Primary bool   `json:"primary"`
+}
+
 type AttachmentToken struct {
 	Token string `json:"token"`
 }
@@ -73,23 +100,29 @@ type ItemCreate struct {
 }
 
 type ItemUpdate struct {
-	Name            string      `json:"name"`
-	Description     string      `json:"description"`
-	LabelIDs        []string    `json:"labelIds"`
-	LocationID      string      `json:"locationId"`
-	ParentID        string      `json:"parentId,omitempty"`
-	Fields          []ItemField `json:"fields"`
-	Archived        bool        `json:"archived"`
-	AssetID         string      `json:"assetId"`
-	Insured         bool        `json:"insured"`
-	Manufacturer    string      `json:"manufacturer"`
-	ModelNumber     string      `json:"modelNumber"`
-	SerialNumber    string      `json:"serialNumber"`
-	PurchaseFrom    string      `json:"purchaseFrom"`
-	PurchasePrice   float64     `json:"purchasePrice,omitempty"`
-	PurchaseTime    time.Time   `json:"purchaseTime"`
-	Quantity        int         `json:"quantity"`
-	Notes           string      `json:"notes"`
-	WarrantyDetails string      `json:"warrantyDetails"`
-	WarrantyExpires time.Time   `json:"warrantyExpires"`
+	ID               string      `json:"id"`
+	Name             string      `json:"name"`
+	Description      string      `json:"description"`
+	LabelIDs         []string    `json:"labelIds"`
+	LocationID       string      `json:"locationId"`
+	ParentID         string      `json:"parentId,omitempty"`
+	Fields           []ItemField `json:"fields"`
+	Archived         bool        `json:"archived"`
+	AssetID          string      `json:"assetId"`
+	Insured          bool        `json:"insured"`
+	Manufacturer     string      `json:"manufacturer"`
+	ModelNumber      string      `json:"modelNumber"`
+	SerialNumber     string      `json:"serialNumber"`
+	PurchaseFrom     string      `json:"purchaseFrom"`
+	PurchasePrice    float64     `json:"purchasePrice,omitempty"`
+	PurchaseTime     time.Time   `json:"purchaseTime"`
+	Quantity         int         `json:"quantity"`
+	Notes            string      `json:"notes"`
+	LifetimeWarranty bool        `json:"lifetimeWarranty"`
+	WarrantyDetails  string      `json:"warrantyDetails"`
+	WarrantyExpires  time.Time   `json:"warrantyExpires"`
+	SoldTo           string      `json:"soldTo"`
+	SoldPrice        float64     `json:"soldPrice,omitempty"`
+	SoldTime         time.Time   `json:"soldTime"`
+	SoldNotes        string      `json:"soldNotes"`
 }
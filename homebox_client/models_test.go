@@ -0,0 +1,175 @@
+package homeboxclient
+
+import (
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+type specSchema struct {
+	Type       string                `json:"type"`
+	Ref        string                `json:"$ref"`
+	AllOf      []specSchema          `json:"allOf"`
+	Properties map[string]specSchema `json:"properties"`
+}
+
+// kind is the JSON type of a property, with references counting as objects.
+func (s specSchema) kind() string {
+	if s.Ref != "" || len(s.AllOf) > 0 {
+		return "object"
+	}
+	return s.Type
+}
+
+// goKind is the JSON type t encodes to.
+func goKind(t reflect.Type) string {
+	if t == reflect.TypeOf(time.Time{}) {
+		return "string"
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice:
+		return "array"
+	case reflect.Struct:
+		return "object"
+	case reflect.Pointer:
+		return goKind(t.Elem())
+	}
+	return t.Kind().String()
+}
+
+// jsonFields returns the JSON fields of a struct, including those of embedded
+// structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous {
+			for name, ft := range jsonFields(f.Type) {
+				fields[name] = ft
+			}
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// TestModelsMatchSpec fails when the models and swagger.yaml disagree: when a
+// definition has a property its model lacks, or a model has a field that
+// isn't in the spec or has a different type. After updating swagger.yaml,
+// run go generate and update the models until this passes.
+func TestModelsMatchSpec(t *testing.T) {
+	data, err := os.ReadFile("swagger.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec struct {
+		Definitions map[string]specSchema `json:"definitions"`
+	}
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		model       any
+		definitions []string
+		// unused are spec properties the model leaves out on purpose.
+		unused []string
+	}{
+		{model: PaginationResult[Item]{}, definitions: []string{"repo.PaginationResult-repo_ItemSummary"}},
+		{model: Item{}, definitions: []string{"repo.ItemOut", "repo.ItemSummary"}},
+		{model: Attachment{}, definitions: []string{"repo.ItemAttachment"}},
+		{model: DocumentOut{}, definitions: []string{"repo.DocumentOut"}},
+		{model: ItemField{}, definitions: []string{"repo.ItemField"}},
+		{model: AttachmentUpdate{}, definitions: []string{"repo.ItemAttachmentUpdate"}},
+		{model: AttachmentToken{}, definitions: []string{"v1.ItemAttachmentToken"}},
+		{model: ItemCreate{}, definitions: []string{"repo.ItemCreate"}},
+		{model: ItemUpdate{}, definitions: []string{"repo.ItemUpdate"}},
+		{model: Label{}, definitions: []string{"repo.LabelOut", "repo.LabelSummary", "repo.LabelCreate"}},
+		{model: LabelCreate{}, definitions: []string{"repo.LabelCreate"}},
+		{model: Location{}, definitions: []string{"repo.LocationOut", "repo.LocationSummary"}},
+		{model: LocationCreate{}, definitions: []string{"repo.LocationCreate"}},
+		{model: LocationUpdate{}, definitions: []string{"repo.LocationUpdate"}},
+		{model: MaintenanceEntry{}, definitions: []string{"repo.MaintenanceEntry"}},
+		{model: MaintenanceEntryWithDetails{}, definitions: []string{"repo.MaintenanceEntryWithDetails"}},
+		{model: Notifier{}, definitions: []string{"repo.NotifierOut"}},
+		{model: NotifierCreate{}, definitions: []string{"repo.NotifierCreate"}},
+		{model: NotifierUpdate{}, definitions: []string{"repo.NotifierUpdate"}},
+		{model: UserOut{}, definitions: []string{"repo.UserOut"}},
+		{model: UserUpdate{}, definitions: []string{"repo.UserUpdate"}},
+		{model: ChangePassword{}, definitions: []string{"v1.ChangePassword"}},
+		{model: Group{}, definitions: []string{"repo.Group"}},
+		{model: GroupUpdate{}, definitions: []string{"repo.GroupUpdate"}},
+		{model: GroupInvitation{}, definitions: []string{"v1.GroupInvitation"}},
+		{model: GroupInvitationCreate{}, definitions: []string{"v1.GroupInvitationCreate"}},
+		{model: GroupStatistics{}, definitions: []string{"repo.GroupStatistics"}},
+		{model: TotalsByOrganizer{}, definitions: []string{"repo.TotalsByOrganizer"}},
+		{model: ValueOverTime{}, definitions: []string{"repo.ValueOverTime"}},
+		{model: ValueOverTimeEntry{}, definitions: []string{"repo.ValueOverTimeEntry"}},
+		{model: LoginForm{}, definitions: []string{"v1.LoginForm"}},
+		{model: TokenResponse{}, definitions: []string{"v1.TokenResponse"}},
+		{model: APISummary{}, definitions: []string{"v1.APISummary"}},
+		{model: Build{}, definitions: []string{"v1.Build"}},
+		{model: ActionAmountResult{}, definitions: []string{"v1.ActionAmountResult"}},
+	}
+
+	for _, tt := range tests {
+		typ := reflect.TypeOf(tt.model)
+		t.Run(typ.Name(), func(t *testing.T) {
+			fields := jsonFields(typ)
+			properties := map[string]specSchema{}
+			for _, name := range tt.definitions {
+				def, ok := spec.Definitions[name]
+				if !ok {
+					t.Fatalf("definition %s is not in swagger.yaml", name)
+				}
+				for prop, schema := range def.Properties {
+					properties[prop] = schema
+				}
+			}
+			unused := map[string]bool{}
+			for _, prop := range tt.unused {
+				unused[prop] = true
+			}
+
+			var missing []string
+			for prop := range properties {
+				if _, ok := fields[prop]; !ok && !unused[prop] {
+					missing = append(missing, prop)
+				}
+			}
+			sort.Strings(missing)
+			if len(missing) > 0 {
+				t.Errorf("%s lacks the properties %s of %s", typ.Name(), strings.Join(missing, ", "), strings.Join(tt.definitions, ", "))
+			}
+
+			for name, ft := range fields {
+				prop, ok := properties[name]
+				if !ok {
+					t.Errorf("%s has the field %q, which isn't in %s", typ.Name(), name, strings.Join(tt.definitions, ", "))
+					continue
+				}
+				if got, want := goKind(ft), prop.kind(); got != want {
+					t.Errorf("%s.%s is a %s in the spec but encodes as %s", typ.Name(), name, want, got)
+				}
+			}
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package homeboxclient
 
+import (
+	"context"
+
+	"github.com/kusold/homebox-export/homebox_client/api"
+)
+
 type Notifier struct {
 	ID        string `json:"id"`
 	Name      string `json:"name"`
@@ -27,14 +33,16 @@ type NotifiersService struct {
 	client *Client
 }
 
-func (s *NotifiersService) List() ([]Notifier, error) {
-	req, err := s.client.newRequest("GET", "/v1/notifiers", nil)
-	if err != nil {
-		return nil, err
+func NewNotifiersService(c *Client) *NotifiersService {
+	return &NotifiersService{
+		client: c,
 	}
+}
 
+func (s *NotifiersService) List() ([]Notifier, error) {
 	var notifiers []Notifier
-	if err := s.client.do(req, &notifiers); err != nil {
+	resp, err := s.client.api.GetV1Notifiers(context.Background())
+	if err := decode(resp, err, &notifiers); err != nil {
 		return nil, err
 	}
 
@@ -42,13 +50,14 @@ func (s *NotifiersService) List() ([]Notifier, error) {
 }
 
 func (s *NotifiersService) Create(notifier *NotifierCreate) (*Notifier, error) {
-	req, err := s.client.newRequest("POST", "/v1/notifiers", notifier)
+	body, err := jsonBody(notifier)
 	if err != nil {
 		return nil, err
 	}
 
 	var created Notifier
-	if err := s.client.do(req, &created); err != nil {
+	resp, err := s.client.api.PostV1NotifiersWithBody(context.Background(), "application/json", body)
+	if err := decode(resp, err, &created); err != nil {
 		return nil, err
 	}
 
@@ -56,13 +65,14 @@ func (s *NotifiersService) Create(notifier *NotifierCreate) (*Notifier, error) {
 }
 
 func (s *NotifiersService) Update(id string, notifier *NotifierUpdate) (*Notifier, error) {
-	req, err := s.client.newRequest("PUT", "/v1/notifiers/"+id, notifier)
+	body, err := jsonBody(notifier)
 	if err != nil {
 		return nil, err
 	}
 
 	var updated Notifier
-	if err := s.client.do(req, &updated); err != nil {
+	resp, err := s.client.api.PutV1NotifiersIdWithBody(context.Background(), id, "application/json", body)
+	if err := decode(resp, err, &updated); err != nil {
 		return nil, err
 	}
 
@@ -70,19 +80,11 @@ func (s *NotifiersService) Update(id string, notifier *NotifierUpdate) (*Notifie
 }
 
 func (s *NotifiersService) Delete(id string) error {
-	req, err := s.client.newRequest("DELETE", "/v1/notifiers/"+id, nil)
-	if err != nil {
-		return err
-	}
-
-	return s.client.do(req, nil)
+	resp, err := s.client.api.DeleteV1NotifiersId(context.Background(), id)
+	return decode(resp, err, nil)
 }
 
 func (s *NotifiersService) Test(id string, testURL string) error {
-	req, err := s.client.newRequest("POST", "/v1/notifiers/test?url="+testURL, nil)
-	if err != nil {
-		return err
-	}
-
-	return s.client.do(req, nil)
+	resp, err := s.client.api.PostV1NotifiersTest(context.Background(), &api.PostV1NotifiersTestParams{Url: testURL})
+	return decode(resp, err, nil)
 }
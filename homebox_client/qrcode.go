@@ -0,0 +1,29 @@
+package homeboxclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/kusold/homebox-export/homebox_client/api"
+)
+
+// QRCode returns an image of a QR code encoding data, rendered by the server.
+func (c *Client) QRCode(data string) ([]byte, error) {
+	resp, err := c.api.GetV1Qrcode(context.Background(), &api.GetV1QrcodeParams{Data: &data})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create QR code: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to create QR code: %s: %s", resp.Status, string(body))
+	}
+
+	image, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create QR code: %w", err)
+	}
+	return image, nil
+}
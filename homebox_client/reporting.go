@@ -0,0 +1,34 @@
+package homeboxclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+type ReportingService struct {
+	client *Client
+}
+
+func NewReportingService(c *Client) *ReportingService {
+	return &ReportingService{
+		client: c,
+	}
+}
+
+// BillOfMaterials returns the bill of materials of all items as CSV. The
+// caller must close it.
+func (s *ReportingService) BillOfMaterials() (io.ReadCloser, error) {
+	resp, err := s.client.api.GetV1ReportingBillOfMaterials(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to export bill of materials: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to export bill of materials: %s: %s", resp.Status, string(body))
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,101 @@
+package homeboxclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The Homebox releases the client supports. swagger.yaml can't tell: its
+// info.version is "1.0" in every release. Both are kept by hand instead, and
+// must be updated together with swagger.yaml.
+const (
+	// TargetVersion is the Homebox release swagger.yaml was copied from, and
+	// so the release the client is written against. Set it to the new tag
+	// when replacing swagger.yaml.
+	TargetVersion = "v0.16.0"
+	// MinVersion is the oldest Homebox release that serves every endpoint the
+	// export calls. Raise it when the client starts using an endpoint that
+	// older releases lack. Older servers fail doctor and get a warning before
+	// an export.
+	MinVersion = "v0.10.0"
+)
+
+type APISummary struct {
+	Title             string   `json:"title"`
+	Message           string   `json:"message"`
+	Health            bool     `json:"health"`
+	Demo              bool     `json:"demo"`
+	AllowRegistration bool     `json:"allowRegistration"`
+	Versions          []string `json:"versions"`
+	Build             Build    `json:"build"`
+}
+
+type Build struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"buildTime"`
+}
+
+// Status returns the server's name, health and build. It doesn't need a
+// login.
+func (c *Client) Status() (*APISummary, error) {
+	var summary APISummary
+	resp, err := c.api.GetV1Status(context.Background())
+	if err := decode(resp, err, &summary); err != nil {
+		return nil, fmt.Errorf("status check failed: %w", err)
+	}
+
+	return &summary, nil
+}
+
+// Version is a Homebox release version.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+// ParseVersion parses release versions such as "v0.16.0" or "0.16.0-rc.1".
+// It reports false for builds that aren't releases, such as "nightly".
+func ParseVersion(s string) (Version, bool) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return Version{}, false
+	}
+	var n [3]int
+	for i, p := range parts {
+		v, err := strconv.Atoi(p)
+		if err != nil || v < 0 {
+			return Version{}, false
+		}
+		n[i] = v
+	}
+	return Version{Major: n[0], Minor: n[1], Patch: n[2]}, true
+}
+
+// Compare returns -1, 0 or 1 if v is older than, the same as or newer than o.
+func (v Version) Compare(o Version) int {
+	for _, d := range [][2]int{{v.Major, o.Major}, {v.Minor, o.Minor}, {v.Patch, o.Patch}} {
+		if d[0] < d[1] {
+			return -1
+		}
+		if d[0] > d[1] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// SameMinor reports whether v and o only differ in their patch version,
+// which doesn't change the API.
+func (v Version) SameMinor(o Version) bool {
+	return v.Major == o.Major && v.Minor == o.Minor
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("v%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
@@ -1,9 +1,17 @@
 package homeboxclient
 
+import "context"
+
 type UsersService struct {
 	client *Client
 }
 
+func NewUsersService(c *Client) *UsersService {
+	return &UsersService{
+		client: c,
+	}
+}
+
 type UserOut struct {
 	ID          string `json:"id"`
 	Email       string `json:"email"`
@@ -25,15 +33,11 @@ type ChangePassword struct {
 }
 
 func (s *UsersService) GetSelf() (*UserOut, error) {
-	req, err := s.client.newRequest("GET", "/v1/users/self", nil)
-	if err != nil {
-		return nil, err
-	}
-
 	var wrapped struct {
 		Item UserOut `json:"item"`
 	}
-	if err := s.client.do(req, &wrapped); err != nil {
+	resp, err := s.client.api.GetV1UsersSelf(context.Background())
+	if err := decode(resp, err, &wrapped); err != nil {
 		return nil, err
 	}
 
@@ -41,7 +45,7 @@ func (s *UsersService) GetSelf() (*UserOut, error) {
 }
 
 func (s *UsersService) UpdateSelf(update UserUpdate) (*UserUpdate, error) {
-	req, err := s.client.newRequest("PUT", "/v1/users/self", update)
+	body, err := jsonBody(update)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +53,8 @@ func (s *UsersService) UpdateSelf(update UserUpdate) (*UserUpdate, error) {
 	var wrapped struct {
 		Item UserUpdate `json:"item"`
 	}
-	if err := s.client.do(req, &wrapped); err != nil {
+	resp, err := s.client.api.PutV1UsersSelfWithBody(context.Background(), "application/json", body)
+	if err := decode(resp, err, &wrapped); err != nil {
 		return nil, err
 	}
 
@@ -57,12 +62,8 @@ func (s *UsersService) UpdateSelf(update UserUpdate) (*UserUpdate, error) {
 }
 
 func (s *UsersService) DeleteSelf() error {
-	req, err := s.client.newRequest("DELETE", "/v1/users/self", nil)
-	if err != nil {
-		return err
-	}
-
-	return s.client.do(req, nil)
+	resp, err := s.client.api.DeleteV1UsersSelf(context.Background())
+	return decode(resp, err, nil)
 }
 
 func (s *UsersService) ChangePassword(current, new string) error {
@@ -71,10 +72,11 @@ func (s *UsersService) ChangePassword(current, new string) error {
 		New:     new,
 	}
 
-	req, err := s.client.newRequest("PUT", "/v1/users/change-password", change)
+	body, err := jsonBody(change)
 	if err != nil {
 		return err
 	}
 
-	return s.client.do(req, nil)
+	resp, err := s.client.api.PutV1UsersChangePasswordWithBody(context.Background(), "application/json", body)
+	return decode(resp, err, nil)
 }
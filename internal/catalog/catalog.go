@@ -0,0 +1,109 @@
+// Package catalog renders a static, offline browsable HTML catalog of an
+// export: a searchable index and a page per item linking to its attachments.
+package catalog
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/kusold/homebox-export/internal/csvexport"
+	"github.com/kusold/homebox-export/internal/report"
+)
+
+// IndexFile is the entry page of the catalog.
+const IndexFile = "index.html"
+
+// searchIndexFile holds the search index. It is a script rather than plain
+// JSON because browsers don't let pages opened from disk fetch other files.
+const searchIndexFile = "search-index.js"
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+var templates = template.Must(template.New("").Funcs(template.FuncMap{
+	"date":    csvexport.FormatDate,
+	"assetID": csvexport.FormatAssetID,
+	"field":   csvexport.FieldValue,
+	"money":   func(f float64) string { return strconv.FormatFloat(f, 'f', 2, 64) },
+	"link":    report.URLPath,
+	"page":    func(row report.Row) string { return report.URLPath(ItemPage(row)) },
+}).ParseFS(templateFS, "templates/*.html"))
+
+// entry is an item in the search index.
+type entry struct {
+	Name  string `json:"name"`
+	URL   string `json:"url"`
+	Text  string `json:"text"` // lower case text searched by the index page
+	Thumb string `json:"thumb,omitempty"`
+}
+
+// Render returns the files of the catalog of r. Attachment paths in r must be
+// relative to the export root, where the index is written.
+func Render(r *report.Report) ([]report.File, error) {
+	var files []report.File
+	var index []entry
+	for _, row := range r.Rows {
+		name := ItemPage(row)
+		var buf bytes.Buffer
+		if err := templates.ExecuteTemplate(&buf, "item.html", itemPage{Row: row, Root: "../"}); err != nil {
+			return nil, fmt.Errorf("failed to render %s: %w", name, err)
+		}
+		files = append(files, report.File{Name: name, Data: buf.Bytes()})
+
+		e := entry{Name: row.Item.Name, URL: report.URLPath(name), Text: searchText(row)}
+		if photos := row.Photos(); len(photos) > 0 {
+			e.Thumb = report.URLPath(photos[0].Path)
+		}
+		index = append(index, e)
+	}
+
+	data, err := json.Marshal(index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render search index: %w", err)
+	}
+	files = append(files, report.File{Name: searchIndexFile, Data: []byte("var catalogIndex = " + string(data) + ";\n")})
+
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, "index.html", r); err != nil {
+		return nil, fmt.Errorf("failed to render %s: %w", IndexFile, err)
+	}
+	files = append(files, report.File{Name: IndexFile, Data: buf.Bytes()})
+	return files, nil
+}
+
+// ItemPage returns the name of the page of an item.
+func ItemPage(row report.Row) string {
+	return path.Join("items", row.Item.ID+".html")
+}
+
+type itemPage struct {
+	report.Row
+	Root string // relative path from the page to the export root
+}
+
+// searchText is everything the index page searches an item by.
+func searchText(row report.Row) string {
+	item := row.Item
+	parts := []string{
+		item.Name,
+		csvexport.FormatAssetID(item.AssetID),
+		row.Location,
+		strings.Join(row.Labels, " "),
+		item.Description,
+		item.Notes,
+		item.Manufacturer,
+		item.ModelNumber,
+		item.SerialNumber,
+		item.PurchaseFrom,
+	}
+	for _, f := range item.Fields {
+		parts = append(parts, f.Name, csvexport.FieldValue(f))
+	}
+	return strings.ToLower(strings.Join(strings.Fields(strings.Join(parts, " ")), " "))
+}
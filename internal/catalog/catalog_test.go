@@ -0,0 +1,90 @@
+package catalog
+
+import (
+	"strings"
+	"testing"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/csvexport"
+	"github.com/kusold/homebox-export/internal/report"
+)
+
+func TestRender(t *testing.T) {
+	items := []homeboxclient.Item{
+		{
+			ID:           "drill-1",
+			Name:         "Drill <cordless>",
+			ImageID:      "front",
+			Quantity:     1,
+			SerialNumber: "SN-12345",
+			Notes:        "Battery in the drawer",
+			Location:     &homeboxclient.Location{ID: "garage"},
+			Labels:       []homeboxclient.Label{{Name: "Tools"}},
+			Fields:       []homeboxclient.ItemField{{Name: "Voltage", Type: "number", NumberValue: 18}},
+			Attachments: []homeboxclient.Attachment{
+				{ID: "side", Type: "photo", Document: homeboxclient.DocumentOut{Title: "side.jpg"}},
+				{ID: "front", Type: "photo", Document: homeboxclient.DocumentOut{Title: "front view.jpg"}},
+				{ID: "receipt", Type: "receipt", Document: homeboxclient.DocumentOut{Title: "receipt.pdf"}},
+			},
+		},
+		{ID: "ladder-1", Name: "Ladder", Quantity: 1},
+	}
+	paths := func(item homeboxclient.Item, a homeboxclient.Attachment) (string, bool) {
+		return "Drill_drill/" + a.Document.Title, true
+	}
+	r := report.New(items, csvexport.LocationPaths{"garage": "Home / Garage"}, paths)
+
+	files, err := Render(r)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	byName := make(map[string]string)
+	for _, f := range files {
+		byName[f.Name] = string(f.Data)
+	}
+
+	index, ok := byName[IndexFile]
+	if !ok {
+		t.Fatalf("missing %s, got %v", IndexFile, files)
+	}
+	for _, want := range []string{
+		`href="items/drill-1.html"`,
+		`Drill &lt;cordless&gt;`,
+		`src="Drill_drill/front%20view.jpg"`,
+		`<script src="search-index.js">`,
+	} {
+		if !strings.Contains(index, want) {
+			t.Errorf("index missing %s", want)
+		}
+	}
+
+	search := byName["search-index.js"]
+	for _, want := range []string{`"url":"items/drill-1.html"`, "sn-12345", "battery in the drawer", "voltage 18"} {
+		if !strings.Contains(search, want) {
+			t.Errorf("search index missing %s:\n%s", want, search)
+		}
+	}
+
+	page, ok := byName["items/drill-1.html"]
+	if !ok {
+		t.Fatal("missing item page")
+	}
+	for _, want := range []string{
+		`href="../index.html"`,
+		`<td>SN-12345</td>`,
+		`<td>Home / Garage</td>`,
+		`<th>Voltage</th><td>18</td>`,
+		`Battery in the drawer`,
+		`href="../Drill_drill/receipt.pdf"`,
+	} {
+		if !strings.Contains(page, want) {
+			t.Errorf("item page missing %s", want)
+		}
+	}
+	if front, side := strings.Index(page, "front%20view.jpg"), strings.Index(page, "side.jpg"); front < 0 || front > side {
+		t.Error("primary photo should come first")
+	}
+	if _, ok := byName["items/ladder-1.html"]; !ok {
+		t.Error("missing page of item without attachments")
+	}
+}
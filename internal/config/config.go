@@ -1,13 +1,53 @@
 package config
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/snapshot"
+	"github.com/kusold/homebox-export/internal/storage"
+)
+
+// Export formats.
+const (
+	FormatFiles    = "files"    // attachments as individual files
+	FormatCSV      = "csv"      // items as a CSV file in Homebox's import format
+	FormatMarkdown = "markdown" // attachments and a Markdown note per item, location and label
+)
+
+var validAttachmentTypes = []string{
+	homeboxclient.AttachmentTypePhoto,
+	homeboxclient.AttachmentTypeManual,
+	homeboxclient.AttachmentTypeWarranty,
+	homeboxclient.AttachmentTypeReceipt,
+	homeboxclient.AttachmentTypeAttachment,
+}
 
 type Config struct {
-	ServerURL    string
-	Username     string
-	Password     string
-	DownloadPath string
-	PageSize     int // optional, defaults to 100
+	ServerURL       string
+	Username        string
+	Password        string
+	DownloadPath    string
+	PageSize        int             // optional, defaults to 100
+	AttachmentTypes []string        // optional, empty downloads every type
+	TypeFolders     bool            // optional, places attachments in a subfolder per type
+	PrimaryOnly     bool            // optional, exports only each item's primary photo into one flat folder
+	LogLevel        string          // optional, debug, info, warn or error; defaults to info
+	LogFormat       string          // optional, text or json; defaults to text
+	Progress        string          // optional, auto, tty, json or none; defaults to auto
+	MetricsFile     string          // optional, node_exporter textfile to write run metrics to
+	Incremental     bool            // optional, skips attachments that are unchanged since the last export
+	Snapshots       bool            // optional, writes every run to a new timestamped directory below DownloadPath
+	Retention       snapshot.Policy // optional, snapshots to keep; the zero policy keeps all of them
+	LinkFrom        string          // optional, previous export to hardlink unchanged attachments from
+	Storage         storage.Options // optional, settings for remote outputs such as s3://bucket/prefix
+	Recipients      []string        // optional, age public keys or recipients files to encrypt attachments to
+	Passphrase      string          // optional, encrypts attachments with a passphrase instead of recipients
+	Format          string          // optional, files, csv or markdown; defaults to files
+	HTML            bool            // optional, writes a browsable HTML catalog of the items into the output
+	Profiles        []Profile       // optional, exports each profile into its own subdirectory instead of the credentials above
 }
 
 func (c *Config) Validate() error {
@@ -26,5 +66,62 @@ func (c *Config) Validate() error {
 	if c.PageSize == 0 {
 		c.PageSize = 100
 	}
+	switch c.Format {
+	case "":
+		c.Format = FormatFiles
+	case FormatFiles, FormatCSV, FormatMarkdown:
+	default:
+		return fmt.Errorf("invalid format %q (valid formats: %s, %s, %s)", c.Format, FormatFiles, FormatCSV, FormatMarkdown)
+	}
+	if c.HTML && c.Format == FormatCSV {
+		return errors.New("the HTML catalog can't be combined with the csv format")
+	}
+	encrypted := len(c.Recipients) > 0 || c.Passphrase != ""
+	if c.HTML && encrypted {
+		return errors.New("the HTML catalog can't be combined with encryption")
+	}
+	if c.Format == FormatMarkdown && encrypted {
+		return errors.New("the markdown format can't be combined with encryption")
+	}
+	if err := c.Retention.Validate(); err != nil {
+		return err
+	}
+	if !c.Snapshots && !c.Retention.IsZero() {
+		return errors.New("a retention policy requires snapshots")
+	}
+	for i, t := range c.AttachmentTypes {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if !isValidAttachmentType(t) {
+			return fmt.Errorf("invalid attachment type %q (valid types: %s)", t, strings.Join(validAttachmentTypes, ", "))
+		}
+		c.AttachmentTypes[i] = t
+	}
 	return nil
 }
+
+// IncludesAttachmentType reports whether attachments of type t should be
+// downloaded. An empty AttachmentTypes list includes every type. Untyped
+// attachments count as the generic "attachment" type, as they are filed.
+func (c *Config) IncludesAttachmentType(t string) bool {
+	if len(c.AttachmentTypes) == 0 {
+		return true
+	}
+	if strings.TrimSpace(t) == "" {
+		t = homeboxclient.AttachmentTypeAttachment
+	}
+	for _, want := range c.AttachmentTypes {
+		if strings.EqualFold(want, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidAttachmentType(t string) bool {
+	for _, valid := range validAttachmentTypes {
+		if t == valid {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,8 @@ package config
 
 import (
 	"testing"
+
+	"github.com/kusold/homebox-export/internal/snapshot"
 )
 
 func TestConfig_Validate(t *testing.T) {
@@ -75,6 +77,143 @@ func TestConfig_Validate(t *testing.T) {
             wantErr:      true,
             wantPageSize: 100,
         },
+        {
+            name: "valid attachment types",
+            config: Config{
+                ServerURL:       "http://localhost:8080",
+                Username:        "user",
+                Password:        "pass",
+                DownloadPath:    "/tmp",
+                AttachmentTypes: []string{"photo", " Receipt "},
+            },
+            wantErr:      false,
+            wantPageSize: 100,
+        },
+        {
+            name: "invalid attachment type",
+            config: Config{
+                ServerURL:       "http://localhost:8080",
+                Username:        "user",
+                Password:        "pass",
+                DownloadPath:    "/tmp",
+                AttachmentTypes: []string{"photo", "selfie"},
+            },
+            wantErr:      true,
+            wantPageSize: 100,
+        },
+        {
+            name: "csv format",
+            config: Config{
+                ServerURL:    "http://localhost:8080",
+                Username:     "user",
+                Password:     "pass",
+                DownloadPath: "/tmp",
+                Format:       FormatCSV,
+            },
+            wantErr:      false,
+            wantPageSize: 100,
+        },
+        {
+            name: "html catalog",
+            config: Config{
+                ServerURL:    "http://localhost:8080",
+                Username:     "user",
+                Password:     "pass",
+                DownloadPath: "/tmp",
+                HTML:         true,
+            },
+            wantErr:      false,
+            wantPageSize: 100,
+        },
+        {
+            name: "html catalog with csv",
+            config: Config{
+                ServerURL:    "http://localhost:8080",
+                Username:     "user",
+                Password:     "pass",
+                DownloadPath: "/tmp",
+                Format:       FormatCSV,
+                HTML:         true,
+            },
+            wantErr:      true,
+            wantPageSize: 100,
+        },
+        {
+            name: "encrypted html catalog",
+            config: Config{
+                ServerURL:    "http://localhost:8080",
+                Username:     "user",
+                Password:     "pass",
+                DownloadPath: "/tmp",
+                Passphrase:   "secret",
+                HTML:         true,
+            },
+            wantErr:      true,
+            wantPageSize: 100,
+        },
+        {
+            name: "markdown format with html catalog",
+            config: Config{
+                ServerURL:    "http://localhost:8080",
+                Username:     "user",
+                Password:     "pass",
+                DownloadPath: "/tmp",
+                Format:       FormatMarkdown,
+                HTML:         true,
+            },
+            wantErr:      false,
+            wantPageSize: 100,
+        },
+        {
+            name: "encrypted markdown",
+            config: Config{
+                ServerURL:    "http://localhost:8080",
+                Username:     "user",
+                Password:     "pass",
+                DownloadPath: "/tmp",
+                Format:       FormatMarkdown,
+                Recipients:   []string{"age1example"},
+            },
+            wantErr:      true,
+            wantPageSize: 100,
+        },
+        {
+            name: "invalid format",
+            config: Config{
+                ServerURL:    "http://localhost:8080",
+                Username:     "user",
+                Password:     "pass",
+                DownloadPath: "/tmp",
+                Format:       "xml",
+            },
+            wantErr:      true,
+            wantPageSize: 100,
+        },
+        {
+            name: "retention without snapshots",
+            config: Config{
+                ServerURL:    "http://localhost:8080",
+                Username:     "user",
+                Password:     "pass",
+                DownloadPath: "/tmp",
+                Retention:    snapshot.Policy{Daily: 7},
+            },
+            wantErr:      true,
+            wantPageSize: 100,
+        },
+        {
+            name: "retention of snapshots",
+            config: Config{
+                ServerURL:    "http://localhost:8080",
+                Username:     "user",
+                Password:     "pass",
+                DownloadPath: "/tmp",
+                Snapshots:    true,
+                Retention:    snapshot.Policy{Daily: 7},
+            },
+            wantErr:      false,
+            wantPageSize: 100,
+        },
     }
 
     for _, tt := range tests {
@@ -91,3 +230,28 @@ func TestConfig_Validate(t *testing.T) {
         })
     }
 }
+
+func TestConfig_IncludesAttachmentType(t *testing.T) {
+    tests := []struct {
+        name  string
+        types []string
+        typ   string
+        want  bool
+    }{
+        {name: "empty filter includes everything", types: nil, typ: "manual", want: true},
+        {name: "matching type", types: []string{"photo", "receipt"}, typ: "receipt", want: true},
+        {name: "non-matching type", types: []string{"photo", "receipt"}, typ: "manual", want: false},
+        {name: "case insensitive", types: []string{"photo"}, typ: "Photo", want: true},
+        {name: "untyped is attachment", types: []string{"attachment"}, typ: "", want: true},
+        {name: "untyped is not a photo", types: []string{"photo"}, typ: "", want: false},
+    }
+
+    for _, tt := range tests {
+        t.Run(tt.name, func(t *testing.T) {
+            c := Config{AttachmentTypes: tt.types}
+            if got := c.IncludesAttachmentType(tt.typ); got != tt.want {
+                t.Errorf("IncludesAttachmentType(%q) = %v, want %v", tt.typ, got, tt.want)
+            }
+        })
+    }
+}
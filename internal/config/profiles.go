@@ -0,0 +1,86 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Profile is one set of credentials of a multi-profile export. Each profile
+// is exported into a subdirectory of the output named after it, so that
+// families with a Homebox group each can back them all up in one run.
+type Profile struct {
+	Name        string `json:"name"`
+	ServerURL   string `json:"server"` // optional, defaults to the server of the config
+	Username    string `json:"user"`
+	Password    string `json:"pass"`    // optional if PasswordEnv is set
+	PasswordEnv string `json:"passEnv"` // optional, environment variable holding the password
+}
+
+// LoadProfiles reads the profiles from a YAML or JSON file with a list of
+// profiles under "profiles". Profiles without a server use serverURL.
+func LoadProfiles(path, serverURL string) ([]Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profiles: %w", err)
+	}
+	var file struct {
+		Profiles []Profile `json:"profiles"`
+	}
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse profiles %s: %w", path, err)
+	}
+	if len(file.Profiles) == 0 {
+		return nil, fmt.Errorf("no profiles in %s", path)
+	}
+
+	for i, p := range file.Profiles {
+		if p.ServerURL == "" {
+			p.ServerURL = serverURL
+		}
+		if p.Password == "" && p.PasswordEnv != "" {
+			p.Password = os.Getenv(p.PasswordEnv)
+		}
+		file.Profiles[i] = p
+	}
+	return file.Profiles, nil
+}
+
+// ValidateProfiles checks that every profile can log in and has a name that
+// is unique and usable as a directory name.
+func (c *Config) ValidateProfiles() error {
+	seen := make(map[string]bool)
+	for i, p := range c.Profiles {
+		switch {
+		case p.Name == "":
+			return fmt.Errorf("profile %d has no name", i+1)
+		case p.Name == "." || p.Name == ".." || strings.ContainsAny(p.Name, `/\`):
+			return fmt.Errorf("profile name %q can't be used as a directory name", p.Name)
+		case seen[strings.ToLower(p.Name)]:
+			return fmt.Errorf("profile %q is listed twice", p.Name)
+		case p.ServerURL == "":
+			return fmt.Errorf("profile %q: server URL is required", p.Name)
+		case p.Username == "":
+			return fmt.Errorf("profile %q: username is required", p.Name)
+		case p.Password == "" && p.PasswordEnv != "":
+			return fmt.Errorf("profile %q: password is required, but %s is not set", p.Name, p.PasswordEnv)
+		case p.Password == "":
+			return fmt.Errorf("profile %q: password is required", p.Name)
+		}
+		seen[strings.ToLower(p.Name)] = true
+	}
+	return nil
+}
+
+// ForProfile returns the config to export profile p with, which writes into
+// the subdirectory of the output named after p.
+func (c Config) ForProfile(p Profile) Config {
+	c.ServerURL = p.ServerURL
+	c.Username = p.Username
+	c.Password = p.Password
+	c.DownloadPath = strings.TrimRight(c.DownloadPath, `/\`) + "/" + p.Name
+	c.Profiles = nil
+	return c
+}
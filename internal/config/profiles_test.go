@@ -0,0 +1,137 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadProfiles(t *testing.T) {
+	t.Setenv("HOMEBOX_PASS_KIDS", "from-env")
+
+	tests := []struct {
+		name    string
+		content string
+		want    []Profile
+		wantErr bool
+	}{
+		{
+			name: "yaml",
+			content: `profiles:
+  - name: parents
+    user: alice@example.com
+    pass: secret
+  - name: kids
+    server: https://kids.example.com
+    user: bob@example.com
+    passEnv: HOMEBOX_PASS_KIDS
+`,
+			want: []Profile{
+				{Name: "parents", ServerURL: "https://homebox.example.com", Username: "alice@example.com", Password: "secret"},
+				{Name: "kids", ServerURL: "https://kids.example.com", Username: "bob@example.com", Password: "from-env", PasswordEnv: "HOMEBOX_PASS_KIDS"},
+			},
+		},
+		{
+			name:    "json",
+			content: `{"profiles": [{"name": "parents", "user": "alice@example.com", "pass": "secret"}]}`,
+			want:    []Profile{{Name: "parents", ServerURL: "https://homebox.example.com", Username: "alice@example.com", Password: "secret"}},
+		},
+		{
+			name:    "unknown field",
+			content: "profiles:\n  - name: parents\n    password: secret\n",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			content: "profiles: []\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "profiles.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			got, err := LoadProfiles(path, "https://homebox.example.com")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadProfiles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadProfiles() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := LoadProfiles(filepath.Join(t.TempDir(), "missing.yaml"), ""); err == nil {
+		t.Error("LoadProfiles() of a missing file succeeded")
+	}
+}
+
+func TestConfig_ValidateProfiles(t *testing.T) {
+	valid := Profile{Name: "parents", ServerURL: "http://localhost:8080", Username: "alice", Password: "secret"}
+	with := func(change func(p *Profile)) Profile {
+		p := valid
+		change(&p)
+		return p
+	}
+
+	tests := []struct {
+		name     string
+		profiles []Profile
+		wantErr  string
+	}{
+		{name: "valid", profiles: []Profile{valid, with(func(p *Profile) { p.Name = "kids" })}},
+		{name: "no name", profiles: []Profile{with(func(p *Profile) { p.Name = "" })}, wantErr: "profile 1 has no name"},
+		{name: "path", profiles: []Profile{with(func(p *Profile) { p.Name = "../kids" })}, wantErr: `profile name "../kids" can't be used as a directory name`},
+		{name: "duplicate", profiles: []Profile{valid, with(func(p *Profile) { p.Name = "Parents" })}, wantErr: `profile "Parents" is listed twice`},
+		{name: "no server", profiles: []Profile{with(func(p *Profile) { p.ServerURL = "" })}, wantErr: `profile "parents": server URL is required`},
+		{name: "no user", profiles: []Profile{with(func(p *Profile) { p.Username = "" })}, wantErr: `profile "parents": username is required`},
+		{name: "no password", profiles: []Profile{with(func(p *Profile) { p.Password = "" })}, wantErr: `profile "parents": password is required`},
+		{
+			name:     "unset password variable",
+			profiles: []Profile{with(func(p *Profile) { p.Password, p.PasswordEnv = "", "HOMEBOX_PASS_PARENTS" })},
+			wantErr:  `profile "parents": password is required, but HOMEBOX_PASS_PARENTS is not set`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Config{Profiles: tt.profiles}
+			err := c.ValidateProfiles()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateProfiles() error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateProfiles() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfig_ForProfile(t *testing.T) {
+	c := Config{
+		ServerURL:    "http://localhost:8080",
+		Username:     "admin",
+		DownloadPath: "s3://backups/homebox/",
+		PageSize:     50,
+		Profiles:     []Profile{{Name: "kids"}},
+	}
+	got := c.ForProfile(Profile{Name: "kids", ServerURL: "https://kids.example.com", Username: "bob", Password: "secret"})
+
+	want := Config{
+		ServerURL:    "https://kids.example.com",
+		Username:     "bob",
+		Password:     "secret",
+		DownloadPath: "s3://backups/homebox/kids",
+		PageSize:     50,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ForProfile() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,210 @@
+package csvexport
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+)
+
+// FieldPrefix starts the column name of every custom field.
+const FieldPrefix = "HB.field."
+
+// columns are the fixed columns of Homebox's import format, in the order
+// Homebox exports them.
+var columns = []string{
+	"HB.import_ref",
+	"HB.location",
+	"HB.labels",
+	"HB.asset_id",
+	"HB.archived",
+	"HB.name",
+	"HB.quantity",
+	"HB.description",
+	"HB.insured",
+	"HB.notes",
+	"HB.purchase_price",
+	"HB.purchase_from",
+	"HB.purchase_time",
+	"HB.manufacturer",
+	"HB.model_number",
+	"HB.serial_number",
+	"HB.lifetime_warranty",
+	"HB.warranty_expires",
+	"HB.warranty_details",
+	"HB.sold_to",
+	"HB.sold_price",
+	"HB.sold_time",
+	"HB.sold_notes",
+}
+
+// LocationPaths maps location IDs to their full path, such as
+// "Home / Garage / Shelf", which Homebox uses to recreate nested locations on
+// import.
+type LocationPaths map[string]string
+
+// NewLocationPaths builds the paths of all locations in a location tree.
+func NewLocationPaths(tree []homeboxclient.Location) LocationPaths {
+	paths := make(LocationPaths)
+	var walk func(locations []homeboxclient.Location, parent string)
+	walk = func(locations []homeboxclient.Location, parent string) {
+		for _, l := range locations {
+			p := l.Name
+			if parent != "" {
+				p = parent + " / " + l.Name
+			}
+			paths[l.ID] = p
+			walk(l.Children, p)
+		}
+	}
+	walk(tree, "")
+	return paths
+}
+
+// Path returns the full path of location, or its name if it is not part of
+// the tree. Items without a location have an empty path.
+func (p LocationPaths) Path(location *homeboxclient.Location) string {
+	if location == nil {
+		return ""
+	}
+	if path, ok := p[location.ID]; ok {
+		return path
+	}
+	return location.Name
+}
+
+// Write writes items as CSV in Homebox's import format. Custom fields become
+// one HB.field.<name> column each. The item ID is used as the import
+// reference, so importing the file twice updates the items rather than
+// duplicating them.
+func Write(w io.Writer, items []homeboxclient.Item, locations LocationPaths) error {
+	fieldNames := customFieldNames(items)
+
+	cw := csv.NewWriter(w)
+	header := append([]string{}, columns...)
+	for _, name := range fieldNames {
+		header = append(header, FieldPrefix+name)
+	}
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	for _, item := range items {
+		if err := cw.Write(row(item, locations, fieldNames)); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+func row(item homeboxclient.Item, locations LocationPaths, fieldNames []string) []string {
+	labels := make([]string, 0, len(item.Labels))
+	for _, l := range item.Labels {
+		labels = append(labels, l.Name)
+	}
+
+	record := []string{
+		item.ID,
+		locations.Path(item.Location),
+		strings.Join(labels, ";"),
+		FormatAssetID(item.AssetID),
+		strconv.FormatBool(item.Archived),
+		item.Name,
+		strconv.Itoa(item.Quantity),
+		item.Description,
+		strconv.FormatBool(item.Insured),
+		item.Notes,
+		formatPrice(item.PurchasePrice),
+		item.PurchaseFrom,
+		FormatDate(item.PurchaseTime),
+		item.Manufacturer,
+		item.ModelNumber,
+		item.SerialNumber,
+		strconv.FormatBool(item.LifetimeWarranty),
+		FormatDate(item.WarrantyExpires),
+		item.WarrantyDetails,
+		item.SoldTo,
+		formatPrice(item.SoldPrice),
+		FormatDate(item.SoldTime),
+		item.SoldNotes,
+	}
+
+	values := make(map[string]string, len(item.Fields))
+	for _, f := range item.Fields {
+		values[f.Name] = FieldValue(f)
+	}
+	for _, name := range fieldNames {
+		record = append(record, values[name])
+	}
+	return record
+}
+
+// customFieldNames returns the names of all custom fields used by items,
+// sorted so the column order is stable between exports.
+func customFieldNames(items []homeboxclient.Item) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, item := range items {
+		for _, f := range item.Fields {
+			if f.Name == "" || seen[f.Name] {
+				continue
+			}
+			seen[f.Name] = true
+			names = append(names, f.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FieldValue returns the value of a custom field as text.
+func FieldValue(f homeboxclient.ItemField) string {
+	switch f.Type {
+	case "number":
+		return strconv.Itoa(f.NumberValue)
+	case "boolean":
+		return strconv.FormatBool(f.BooleanValue)
+	default:
+		return f.TextValue
+	}
+}
+
+// FormatAssetID returns id, or an empty string for unset asset IDs, which
+// Homebox reports as zero.
+func FormatAssetID(id string) string {
+	if strings.Trim(id, "0-") == "" {
+		return ""
+	}
+	return id
+}
+
+func formatPrice(p float64) string {
+	if p == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(p, 'f', 2, 64)
+}
+
+// FormatDate converts a timestamp from the API to the YYYY-MM-DD form used by
+// the import. Unset dates, which Homebox reports as the zero time, are left
+// empty.
+func FormatDate(s string) string {
+	if len(s) < len(time.DateOnly) {
+		return ""
+	}
+	t, err := time.Parse(time.DateOnly, s[:len(time.DateOnly)])
+	if err != nil || t.Year() <= 1 {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
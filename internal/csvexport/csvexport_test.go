@@ -0,0 +1,142 @@
+package csvexport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+)
+
+func TestNewLocationPaths(t *testing.T) {
+	tree := []homeboxclient.Location{
+		{ID: "home", Name: "Home", Children: []homeboxclient.Location{
+			{ID: "garage", Name: "Garage", Children: []homeboxclient.Location{
+				{ID: "shelf", Name: "Shelf"},
+			}},
+		}},
+		{ID: "office", Name: "Office"},
+	}
+
+	paths := NewLocationPaths(tree)
+	want := map[string]string{
+		"home":   "Home",
+		"garage": "Home / Garage",
+		"shelf":  "Home / Garage / Shelf",
+		"office": "Office",
+	}
+	for id, p := range want {
+		if paths[id] != p {
+			t.Errorf("path of %s = %q, want %q", id, paths[id], p)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	items := []homeboxclient.Item{
+		{
+			ID:               "item-1",
+			Name:             "Drill, cordless",
+			Description:      "18V \"compact\"",
+			AssetID:          "000-012",
+			Quantity:         1,
+			Insured:          true,
+			Location:         &homeboxclient.Location{ID: "shelf", Name: "Shelf"},
+			Labels:           []homeboxclient.Label{{Name: "Tools"}, {Name: "Power"}},
+			Manufacturer:     "Makita",
+			ModelNumber:      "DDF485",
+			SerialNumber:     "SN-1",
+			PurchasePrice:    129.5,
+			PurchaseFrom:     "Hardware Store",
+			PurchaseTime:     "2023-05-04T00:00:00Z",
+			LifetimeWarranty: false,
+			WarrantyExpires:  "2026-05-04T00:00:00Z",
+			SoldTime:         "0001-01-01T00:00:00Z",
+			Fields: []homeboxclient.ItemField{
+				{Name: "Voltage", Type: "number", NumberValue: 18},
+				{Name: "Color", Type: "text", TextValue: "Teal"},
+			},
+		},
+		{
+			ID:       "item-2",
+			Name:     "Ladder",
+			AssetID:  "000-000",
+			Quantity: 2,
+			Fields: []homeboxclient.ItemField{
+				{Name: "Cordless", Type: "boolean", BooleanValue: false},
+			},
+		},
+	}
+	paths := LocationPaths{"shelf": "Home / Garage / Shelf"}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, items, paths); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want header and 2 items", len(records))
+	}
+
+	header := records[0]
+	wantFields := []string{"HB.field.Color", "HB.field.Cordless", "HB.field.Voltage"}
+	if got := header[len(columns):]; len(got) != len(wantFields) {
+		t.Fatalf("custom field columns = %v, want %v", got, wantFields)
+	}
+	for i, name := range wantFields {
+		if header[len(columns)+i] != name {
+			t.Errorf("column %d = %s, want %s", len(columns)+i, header[len(columns)+i], name)
+		}
+	}
+
+	get := func(record []string, column string) string {
+		for i, h := range header {
+			if h == column {
+				return record[i]
+			}
+		}
+		t.Fatalf("column %s missing", column)
+		return ""
+	}
+
+	drill := records[1]
+	for column, want := range map[string]string{
+		"HB.import_ref":        "item-1",
+		"HB.location":          "Home / Garage / Shelf",
+		"HB.labels":            "Tools;Power",
+		"HB.asset_id":          "000-012",
+		"HB.name":              "Drill, cordless",
+		"HB.description":       "18V \"compact\"",
+		"HB.insured":           "true",
+		"HB.purchase_price":    "129.50",
+		"HB.purchase_time":     "2023-05-04",
+		"HB.manufacturer":      "Makita",
+		"HB.serial_number":     "SN-1",
+		"HB.lifetime_warranty": "false",
+		"HB.warranty_expires":  "2026-05-04",
+		"HB.sold_time":         "",
+		"HB.field.Voltage":     "18",
+		"HB.field.Color":       "Teal",
+		"HB.field.Cordless":    "",
+	} {
+		if got := get(drill, column); got != want {
+			t.Errorf("drill %s = %q, want %q", column, got, want)
+		}
+	}
+
+	ladder := records[2]
+	for column, want := range map[string]string{
+		"HB.asset_id":       "",
+		"HB.location":       "",
+		"HB.quantity":       "2",
+		"HB.field.Cordless": "false",
+	} {
+		if got := get(ladder, column); got != want {
+			t.Errorf("ladder %s = %q, want %q", column, got, want)
+		}
+	}
+}
@@ -1,28 +1,59 @@
 package downloader
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"log"
+	"io"
+	"log/slog"
 	"os"
-	"path/filepath"
+	"path"
+	"strings"
+	"time"
 
 	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/catalog"
 	"github.com/kusold/homebox-export/internal/config"
+	"github.com/kusold/homebox-export/internal/csvexport"
+	"github.com/kusold/homebox-export/internal/encryption"
 	"github.com/kusold/homebox-export/internal/filemanager"
+	"github.com/kusold/homebox-export/internal/logger"
+	"github.com/kusold/homebox-export/internal/markdown"
+	"github.com/kusold/homebox-export/internal/progress"
+	"github.com/kusold/homebox-export/internal/report"
+	"github.com/kusold/homebox-export/internal/storage"
 )
 
+// progressInterval is how often progress is reported while exporting.
+const progressInterval = time.Second
+
 type Downloader struct {
 	client      HomeboxClienter
 	config      config.Config
 	itemService ItemServicer
+	locations   LocationServicer
+	maintenance MaintenanceServicer
+	sink        storage.Sink
 	fileManager *filemanager.FileManager
+	logger      *slog.Logger
+	tracker     *progress.Tracker
+	progressOut io.Writer
+	reporter    *progress.Reporter
+	items       []homeboxclient.Item // collected for files written once all items are fetched
+	photoNames  map[string]string    // primary photo filename to the ID of the item it was given to
 }
 type Option func(*Downloader)
 type ItemServicer interface {
 	List(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error)
 	Get(id string) (*homeboxclient.Item, error)
-	DownloadAttachment(itemID, attachmentID, destPath string) error
+	OpenAttachment(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error)
+}
+type LocationServicer interface {
+	GetTree(withItems bool) ([]homeboxclient.Location, error)
+}
+type MaintenanceServicer interface {
+	List(status homeboxclient.MaintenanceFilterStatus) ([]homeboxclient.MaintenanceEntryWithDetails, error)
 }
 type HomeboxClienter interface {
 	Login(username, password string) (*homeboxclient.TokenResponse, error)
@@ -33,20 +64,51 @@ func New(config config.Config, options ...Option) (*Downloader, error) {
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
-	if err := os.MkdirAll(config.DownloadPath, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create download directory: %w", err)
-	}
-
 	d := &Downloader{
 		config:      config,
 		fileManager: filemanager.NewFileManager(config.DownloadPath),
+		tracker:     progress.NewTracker(),
+		progressOut: os.Stderr,
 	}
 	for _, opt := range options {
 		opt(d)
 	}
 
+	if d.sink == nil {
+		sink, err := storage.Open(context.Background(), config.DownloadPath, config.Storage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open output: %w", err)
+		}
+		d.sink = sink
+	}
+	if len(config.Recipients) > 0 || config.Passphrase != "" {
+		recipients, err := encryption.ParseRecipients(config.Recipients, config.Passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("failed to setup encryption: %w", err)
+		}
+		sink, err := encryption.NewSink(d.sink, recipients...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to setup encryption: %w", err)
+		}
+		d.sink = sink
+	}
+
+	reporter, err := progress.NewReporter(d.progressOut, config.Progress, progressInterval, d.tracker)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup progress reporting: %w", err)
+	}
+	d.reporter = reporter
+
+	if d.logger == nil {
+		l, err := logger.NewSlog(d.reporter.Writer(os.Stderr), config.LogLevel, config.LogFormat)
+		if err != nil {
+			return nil, fmt.Errorf("failed to setup logger: %w", err)
+		}
+		d.logger = l
+	}
+
 	if d.client == nil {
-		client, err := setupClient(config)
+		client, err := setupClient(config, d.logger)
 		if err != nil {
 			return nil, fmt.Errorf("failed to setup client: %w", err)
 		}
@@ -56,6 +118,12 @@ func New(config config.Config, options ...Option) (*Downloader, error) {
 		if d.itemService == nil {
 			d.itemService = homeboxclient.NewItemsService(client)
 		}
+		if d.locations == nil {
+			d.locations = homeboxclient.NewLocationsService(client)
+		}
+		if d.maintenance == nil {
+			d.maintenance = homeboxclient.NewMaintenanceService(client)
+		}
 	}
 
 	return d, nil
@@ -71,36 +139,123 @@ func WithItemService(is ItemServicer) Option {
 		d.itemService = is
 	}
 }
+func WithLocationService(ls LocationServicer) Option {
+	return func(d *Downloader) {
+		d.locations = ls
+	}
+}
+func WithMaintenanceService(ms MaintenanceServicer) Option {
+	return func(d *Downloader) {
+		d.maintenance = ms
+	}
+}
+
+// WithSink writes the export to sink instead of the output configured by
+// config.DownloadPath.
+func WithSink(sink storage.Sink) Option {
+	return func(d *Downloader) {
+		d.sink = sink
+	}
+}
+func WithLogger(l *slog.Logger) Option {
+	return func(d *Downloader) {
+		d.logger = l
+	}
+}
+func WithProgressOutput(w io.Writer) Option {
+	return func(d *Downloader) {
+		d.progressOut = w
+	}
+}
+
+// Close releases the connection held by the output, if any.
+func (d *Downloader) Close() error {
+	if c, ok := d.sink.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// Stats returns the counters of the current or most recent export.
+func (d *Downloader) Stats() progress.Stats {
+	return d.tracker.Stats()
+}
 
 func (d *Downloader) DownloadAll() error {
+	return d.DownloadAllContext(context.Background())
+}
+
+// DownloadAllContext is like DownloadAll but stops between items once ctx is
+// cancelled, leaving the attachment being downloaded complete.
+func (d *Downloader) DownloadAllContext(ctx context.Context) (err error) {
 	page := 1
 
+	d.items = nil
+	d.tracker.Reset()
+	d.reporter.Start()
+	defer func() { d.reporter.Stop(err) }()
+
+	d.logger.Info("starting export", "server", d.config.ServerURL, "output", d.sink.String())
+
 	for {
 		items, err := d.itemService.List(page, d.config.PageSize)
 		if err != nil {
+			d.tracker.Error()
 			return fmt.Errorf("failed to list items: %w", err)
 		}
 
+		if page == 1 {
+			d.tracker.SetTotal(items.Total)
+		}
+
 		if len(items.Items) == 0 {
 			break
 		}
 
-		if err := d.processItems(items.Items); err != nil {
+		if err := d.processItems(ctx, items.Items); err != nil {
+			d.tracker.Error()
 			return err
 		}
 
 		page++
 	}
 
+	switch d.config.Format {
+	case config.FormatCSV:
+		err = d.writeCSV(ctx)
+	case config.FormatMarkdown:
+		err = d.writeNotes(ctx)
+	}
+	if err != nil {
+		d.tracker.Error()
+		return err
+	}
+	if d.config.HTML {
+		if err := d.writeCatalog(ctx); err != nil {
+			d.tracker.Error()
+			return err
+		}
+	}
+
+	stats := d.tracker.Stats()
+	d.logger.Info("export complete",
+		"items", stats.ItemsDone,
+		"attachments", stats.Attachments,
+		"skipped", stats.Skipped,
+		"bytes", stats.Bytes,
+		"duration", stats.Elapsed,
+	)
 	return nil
 }
 
-func setupClient(config config.Config) (*homeboxclient.Client, error) {
+func setupClient(config config.Config, log *slog.Logger) (*homeboxclient.Client, error) {
 	client, err := homeboxclient.NewClient(config.ServerURL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
 
+	checkServerVersion(client, log)
+
 	// Authenticate
 	if _, err := client.Login(config.Username, config.Password); err != nil {
 		return nil, fmt.Errorf("failed to login: %w", err)
@@ -108,45 +263,403 @@ func setupClient(config config.Config) (*homeboxclient.Client, error) {
 	return client, nil
 }
 
-func (d *Downloader) processItems(items []homeboxclient.Item) error {
+// checkServerVersion warns when the server runs a different Homebox release
+// than the one the client is written against, as its API may have changed,
+// and more strongly when it is older than the oldest supported release. The
+// export goes ahead either way.
+func checkServerVersion(client *homeboxclient.Client, log *slog.Logger) {
+	status, err := client.Status()
+	if err != nil {
+		log.Warn("failed to check the server version", "error", err)
+		return
+	}
+
+	min, _ := homeboxclient.ParseVersion(homeboxclient.MinVersion)
+	target, _ := homeboxclient.ParseVersion(homeboxclient.TargetVersion)
+	version, ok := homeboxclient.ParseVersion(status.Build.Version)
+	switch {
+	case ok && version.Compare(min) < 0:
+		log.Warn("server version is older than the oldest supported version, run doctor to check compatibility",
+			"server_version", status.Build.Version,
+			"min_version", homeboxclient.MinVersion,
+		)
+	case !ok || !version.SameMinor(target):
+		log.Warn("server version differs from the version this export was written for, run doctor to check compatibility",
+			"server_version", status.Build.Version,
+			"target_version", homeboxclient.TargetVersion,
+		)
+	}
+}
+
+func (d *Downloader) processItems(ctx context.Context, items []homeboxclient.Item) error {
 	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("export interrupted: %w", err)
+		}
 		fullItem, err := d.itemService.Get(item.ID)
 		if err != nil {
 			return err
 		}
-		if err := d.processItem(*fullItem); err != nil {
+		if err := d.processItem(ctx, *fullItem); err != nil {
 			return fmt.Errorf("Error processing item %s (%s): %v", item.Name, item.ID, err)
 		}
+		d.tracker.ItemDone()
 	}
 	return nil
 }
 
-func (d *Downloader) processItem(item homeboxclient.Item) error {
-	log.Printf("Processing item: %s (%s)", item.Name, item.ID)
+func (d *Downloader) processItem(ctx context.Context, item homeboxclient.Item) error {
+	log := d.logger.With("item_id", item.ID, "item_name", item.Name)
+	log.Info("processing item", "attachments", len(item.Attachments))
 
-	itemBytes, err := json.Marshal(item)
-	if err != nil {
-		return fmt.Errorf("failed to marshal item %s: %w", item.ID, err)
-	} else {
-		log.Printf("Item JSON: %s", string(itemBytes))
+	if log.Enabled(ctx, slog.LevelDebug) {
+		itemBytes, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("failed to marshal item %s: %w", item.ID, err)
+		}
+		log.Debug("item details", "item", string(itemBytes))
+	}
+
+	if d.config.Format != config.FormatFiles || d.config.HTML {
+		d.items = append(d.items, item)
+	}
+	if d.config.Format == config.FormatCSV {
+		return nil
+	}
+
+	if d.config.PrimaryOnly {
+		return d.processPrimaryPhoto(ctx, log, item)
 	}
 
 	for _, attachment := range item.Attachments {
-		log.Println("Processing attachment:", attachment.ID)
-		subdirectory := d.fileManager.GenerateDirectory(item)
-		if err := os.MkdirAll(filepath.Join(d.config.DownloadPath, subdirectory), 0755); err != nil {
-			return fmt.Errorf("failed to create subdirectory: %w", err)
+		name, ok := d.attachmentName(item, attachment)
+		if !ok {
+			log.Debug("skipping attachment", "attachment_id", attachment.ID, "type", attachment.Type)
+			continue
+		}
+		if err := d.downloadAttachment(ctx, log, item, attachment, name); err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
+
+// downloadAttachment writes a single attachment to name in the sink and logs
+// its size and how long it took.
+func (d *Downloader) downloadAttachment(ctx context.Context, log *slog.Logger, item homeboxclient.Item, attachment homeboxclient.Attachment, name string) error {
+	if d.config.Incremental && d.isUpToDate(ctx, name, attachment) {
+		log.Debug("skipping unchanged attachment", "attachment_id", attachment.ID, "path", name)
+		d.tracker.AttachmentSkipped()
+		return nil
+	}
+	if d.linkPrevious(log, attachment, name) {
+		d.tracker.AttachmentSkipped()
+		return nil
+	}
+
+	start := time.Now()
+	content, err := d.itemService.OpenAttachment(item.ID, attachment.ID)
+	if err != nil {
+		log.Error("failed to download attachment", "attachment_id", attachment.ID, "error", err)
+		return fmt.Errorf("failed to download attachment %s: %w", attachment.ID, err)
+	}
+	defer content.Close()
+
+	// The attachment's modification time is recorded so incremental runs can
+	// tell whether it changed on the server.
+	size, err := d.sink.Put(ctx, name, content, content.Size, attachment.UpdatedAt)
+	if err != nil {
+		log.Error("failed to download attachment", "attachment_id", attachment.ID, "error", err)
+		return fmt.Errorf("failed to download attachment %s: %w", attachment.ID, err)
+	}
+
+	d.tracker.AttachmentDone(size)
+	log.Info("downloaded attachment",
+		"attachment_id", attachment.ID,
+		"type", attachment.Type,
+		"path", name,
+		"bytes", size,
+		"duration", time.Since(start),
+	)
+	return nil
+}
+
+// FetchItems returns the full details of every item, in the order the server
+// lists them.
+func (d *Downloader) FetchItems(ctx context.Context) ([]homeboxclient.Item, error) {
+	return FetchItems(ctx, d.itemService, d.config.PageSize)
+}
 
-		filename := d.fileManager.GenerateFilename(item, attachment)
-		filepath := filepath.Join(d.config.DownloadPath, subdirectory, filename)
+// ListItems returns the summaries of every item, listing pageSize at a time.
+func ListItems(items ItemServicer, pageSize int) ([]homeboxclient.Item, error) {
+	var all []homeboxclient.Item
+	for page := 1; ; page++ {
+		result, err := items.List(page, pageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list items: %w", err)
+		}
+		all = append(all, result.Items...)
+		if len(result.Items) == 0 || (result.Total > 0 && len(all) >= result.Total) {
+			return all, nil
+		}
+	}
+}
 
-		if err := d.itemService.DownloadAttachment(item.ID, attachment.ID, filepath); err != nil {
-			return fmt.Errorf("failed to download attachment %s: %w", attachment.ID, err)
+// FetchItems is like ListItems, but returns the full details of every item,
+// which only Get returns. It stops between items once ctx is cancelled.
+func FetchItems(ctx context.Context, items ItemServicer, pageSize int) ([]homeboxclient.Item, error) {
+	summaries, err := ListItems(items, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	full := make([]homeboxclient.Item, 0, len(summaries))
+	for _, summary := range summaries {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("interrupted: %w", err)
+		}
+		item, err := items.Get(summary.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get item %s: %w", summary.ID, err)
 		}
+		full = append(full, *item)
+	}
+	return full, nil
+}
 
-		log.Printf("Downloaded: %s", filename)
+// PrimaryPhoto downloads the primary photo of item. It returns nil if the
+// item has no primary photo.
+func (d *Downloader) PrimaryPhoto(item homeboxclient.Item) ([]byte, error) {
+	attachment, ok := primaryPhoto(item)
+	if !ok {
+		return nil, nil
 	}
+	content, err := d.itemService.OpenAttachment(item.ID, attachment.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download attachment %s: %w", attachment.ID, err)
+	}
+	defer content.Close()
+	return io.ReadAll(content)
+}
+
+// Locations returns the full path of every location by its ID.
+func (d *Downloader) Locations() (csvexport.LocationPaths, error) {
+	tree, err := d.locations.GetTree(false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list locations: %w", err)
+	}
+	return csvexport.NewLocationPaths(tree), nil
+}
+
+// Maintenance returns the maintenance entries of all items with status.
+func (d *Downloader) Maintenance(status homeboxclient.MaintenanceFilterStatus) ([]homeboxclient.MaintenanceEntryWithDetails, error) {
+	entries, err := d.maintenance.List(status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance: %w", err)
+	}
+	return entries, nil
+}
 
+// WriteFile writes data to name in the output, next to the exported
+// attachments. Like attachments it is encrypted if encryption is configured.
+func (d *Downloader) WriteFile(ctx context.Context, name string, data []byte) error {
+	if _, err := d.sink.Put(ctx, name, bytes.NewReader(data), int64(len(data)), time.Now()); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
 	return nil
 }
+
+// csvFilename is the file a CSV export is written to.
+const csvFilename = "items.csv"
+
+// writeCSV writes the collected items to the sink in Homebox's import format,
+// resolving each item's location to its full path.
+func (d *Downloader) writeCSV(ctx context.Context) error {
+	locations, err := d.Locations()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := csvexport.Write(&buf, d.items, locations); err != nil {
+		return err
+	}
+
+	if err := d.WriteFile(ctx, csvFilename, buf.Bytes()); err != nil {
+		return err
+	}
+	d.logger.Info("wrote CSV", "path", csvFilename, "items", len(d.items), "bytes", buf.Len())
+	return nil
+}
+
+// writeCatalog writes the HTML catalog of the collected items next to the
+// exported attachments.
+func (d *Downloader) writeCatalog(ctx context.Context) error {
+	locations, err := d.Locations()
+	if err != nil {
+		return err
+	}
+
+	files, err := catalog.Render(report.New(d.items, locations, d.AttachmentPath))
+	if err != nil {
+		return err
+	}
+	if err := d.writeFiles(ctx, files); err != nil {
+		return err
+	}
+	d.logger.Info("wrote HTML catalog", "path", catalog.IndexFile, "items", len(d.items))
+	return nil
+}
+
+// writeNotes writes a Markdown note per collected item and per location and
+// label, linking to the exported attachments.
+func (d *Downloader) writeNotes(ctx context.Context) error {
+	locations, err := d.Locations()
+	if err != nil {
+		return err
+	}
+
+	files := markdown.Render(report.New(d.items, locations, d.AttachmentPath), locations)
+	if err := d.writeFiles(ctx, files); err != nil {
+		return err
+	}
+	d.logger.Info("wrote Markdown notes", "notes", len(files), "items", len(d.items))
+	return nil
+}
+
+func (d *Downloader) writeFiles(ctx context.Context, files []report.File) error {
+	for _, f := range files {
+		if err := d.WriteFile(ctx, f.Name, f.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attachmentName returns the slash separated name, relative to the root of the
+// sink, that an attachment is exported as. It reports false for attachments
+// the configuration leaves out.
+func (d *Downloader) attachmentName(item homeboxclient.Item, attachment homeboxclient.Attachment) (string, bool) {
+	if d.config.PrimaryOnly {
+		primary, ok := primaryPhoto(item)
+		if !ok || primary.ID != attachment.ID {
+			return "", false
+		}
+		return d.primaryPhotoName(item, attachment), true
+	}
+	if !d.config.IncludesAttachmentType(attachment.Type) {
+		return "", false
+	}
+	return path.Join(d.attachmentDirectory(item, attachment), d.fileManager.GenerateFilename(item, attachment)), true
+}
+
+// AttachmentPath returns the slash separated path, relative to the output,
+// of the file an export writes attachment to, including the suffix added by
+// encryption. It reports false for attachments the export leaves out.
+func (d *Downloader) AttachmentPath(item homeboxclient.Item, attachment homeboxclient.Attachment) (string, bool) {
+	name, ok := d.attachmentName(item, attachment)
+	if !ok {
+		return "", false
+	}
+	if _, encrypted := d.sink.(*encryption.Sink); encrypted {
+		name += encryption.Suffix
+	}
+	return name, true
+}
+
+// attachmentDirectory returns the slash separated directory, relative to the
+// root of the sink, that an attachment is written to.
+func (d *Downloader) attachmentDirectory(item homeboxclient.Item, attachment homeboxclient.Attachment) string {
+	subdirectory := d.fileManager.GenerateDirectory(item)
+	if !d.config.TypeFolders {
+		return subdirectory
+	}
+	return path.Join(subdirectory, d.fileManager.GenerateTypeDirectory(attachment))
+}
+
+// processPrimaryPhoto downloads only the item's primary photo into the root of
+// the sink.
+func (d *Downloader) processPrimaryPhoto(ctx context.Context, log *slog.Logger, item homeboxclient.Item) error {
+	attachment, ok := primaryPhoto(item)
+	if !ok {
+		log.Info("item has no primary photo")
+		return nil
+	}
+
+	filename, _ := d.attachmentName(item, attachment)
+	return d.downloadAttachment(ctx, log, item, attachment, filename)
+}
+
+// primaryPhotoName returns the filename of item's primary photo. Two items
+// can map to the same name, e.g. by sharing an asset ID; the first item keeps
+// it and the others have their full ID appended, so that no photo overwrites
+// another in the flat output.
+func (d *Downloader) primaryPhotoName(item homeboxclient.Item, attachment homeboxclient.Attachment) string {
+	name := d.fileManager.GeneratePrimaryPhotoFilename(item, attachment)
+	if d.photoNames == nil {
+		d.photoNames = make(map[string]string)
+	}
+	if owner, ok := d.photoNames[name]; ok && owner != item.ID {
+		ext := path.Ext(name)
+		name = fmt.Sprintf("%s_%s%s", strings.TrimSuffix(name, ext), item.ID, ext)
+	}
+	d.photoNames[name] = item.ID
+	return name
+}
+
+// primaryPhoto returns the attachment referenced by the item's ImageID, falling
+// back to the first photo flagged as primary.
+func primaryPhoto(item homeboxclient.Item) (homeboxclient.Attachment, bool) {
+	if item.ImageID != "" {
+		for _, a := range item.Attachments {
+			if a.ID == item.ImageID {
+				return a, true
+			}
+		}
+	}
+	for _, a := range item.Attachments {
+		if a.Primary && a.Type == homeboxclient.AttachmentTypePhoto {
+			return a, true
+		}
+	}
+	return homeboxclient.Attachment{}, false
+}
+
+// linkPrevious hardlinks name to the same file in the previous export when
+// that copy is still current, so unchanged attachments take no extra space. It
+// reports whether the link was made; on failure, e.g. across filesystems, the
+// attachment is downloaded instead. Linking needs a local output.
+func (d *Downloader) linkPrevious(log *slog.Logger, attachment homeboxclient.Attachment, name string) bool {
+	linker, ok := d.sink.(storage.Linker)
+	if d.config.LinkFrom == "" || !ok {
+		return false
+	}
+	prev := linker.PathIn(d.config.LinkFrom, name)
+	info, err := os.Stat(prev)
+	if err != nil || !isCurrent(storage.Object{Size: info.Size(), ModTime: info.ModTime()}, attachment) {
+		return false
+	}
+
+	if err := linker.Link(prev, name); err != nil {
+		log.Warn("failed to link unchanged attachment, downloading it instead", "attachment_id", attachment.ID, "error", err)
+		return false
+	}
+	log.Debug("linked unchanged attachment", "attachment_id", attachment.ID, "path", name, "from", prev)
+	return true
+}
+
+// isUpToDate reports whether name already holds the current version of
+// attachment.
+func (d *Downloader) isUpToDate(ctx context.Context, name string, attachment homeboxclient.Attachment) bool {
+	obj, err := d.sink.Stat(ctx, name)
+	return err == nil && isCurrent(obj, attachment)
+}
+
+// isCurrent reports whether obj is the current version of attachment, based
+// on the modification time stamped on the previous download.
+func isCurrent(obj storage.Object, attachment homeboxclient.Attachment) bool {
+	if attachment.UpdatedAt.IsZero() || obj.Size == 0 {
+		return false
+	}
+	return !obj.ModTime.Before(attachment.UpdatedAt.Truncate(time.Second))
+}
@@ -1,14 +1,28 @@
 package downloader
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"filippo.io/age"
+
 	homeboxclient "github.com/kusold/homebox-export/homebox_client"
 	"github.com/kusold/homebox-export/internal/config"
+	"github.com/kusold/homebox-export/internal/encryption"
+	"github.com/kusold/homebox-export/internal/logger"
+	"github.com/kusold/homebox-export/internal/progress"
+	"github.com/kusold/homebox-export/internal/storage"
 )
 
 // Test helpers and common structures
@@ -24,9 +38,9 @@ func (m *mockClient) Login(username, password string) (*homeboxclient.TokenRespo
 }
 
 type mockItemsService struct {
-	listFunc               func(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error)
-	getFunc                func(id string) (*homeboxclient.Item, error)
-	downloadAttachmentFunc func(itemID, attachmentID, destPath string) error
+	listFunc           func(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error)
+	getFunc            func(id string) (*homeboxclient.Item, error)
+	openAttachmentFunc func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error)
 }
 
 func (m *mockItemsService) List(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error) {
@@ -43,14 +57,29 @@ func (m *mockItemsService) Get(id string) (*homeboxclient.Item, error) {
 	return nil, nil
 }
 
-func (m *mockItemsService) DownloadAttachment(itemID, attachmentID, destPath string) error {
-	if m.downloadAttachmentFunc != nil {
-		return m.downloadAttachmentFunc(itemID, attachmentID, destPath)
+func (m *mockItemsService) OpenAttachment(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+	if m.openAttachmentFunc != nil {
+		return m.openAttachmentFunc(itemID, attachmentID)
 	}
-	return nil
+	return attachmentContent(""), nil
+}
+
+type mockLocationsService struct {
+	tree []homeboxclient.Location
+}
+
+func (m *mockLocationsService) GetTree(withItems bool) ([]homeboxclient.Location, error) {
+	return m.tree, nil
 }
 
 // Helper functions
+func attachmentContent(data string) *homeboxclient.AttachmentContent {
+	return &homeboxclient.AttachmentContent{
+		ReadCloser: io.NopCloser(strings.NewReader(data)),
+		Size:       int64(len(data)),
+	}
+}
+
 func createTestItem() homeboxclient.Item {
 	return homeboxclient.Item{
 		ID:   "test123",
@@ -164,8 +193,8 @@ func TestDownloader_DownloadAll(t *testing.T) {
 					}
 					return nil, errors.New("item not found")
 				},
-				downloadAttachmentFunc: func(itemID, attachmentID, destPath string) error {
-					return os.WriteFile(destPath, []byte("test content"), 0644)
+				openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+					return attachmentContent("test content"), nil
 				},
 			},
 			wantErr:   false,
@@ -196,8 +225,8 @@ func TestDownloader_DownloadAll(t *testing.T) {
 				getFunc: func(id string) (*homeboxclient.Item, error) {
 					return &testItem, nil
 				},
-				downloadAttachmentFunc: func(itemID, attachmentID, destPath string) error {
-					return errors.New("download failed")
+				openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+					return nil, errors.New("download failed")
 				},
 			},
 			wantErr: true,
@@ -261,8 +290,8 @@ func TestDownloader_processItems(t *testing.T) {
 				getFunc: func(id string) (*homeboxclient.Item, error) {
 					return &testItem, nil
 				},
-				downloadAttachmentFunc: func(itemID, attachmentID, destPath string) error {
-					return os.WriteFile(destPath, []byte("test content"), 0644)
+				openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+					return attachmentContent("test content"), nil
 				},
 			},
 			wantErr: false,
@@ -287,10 +316,890 @@ func TestDownloader_processItems(t *testing.T) {
 				t.Fatalf("Failed to create downloader: %v", err)
 			}
 
-			err = d.processItems(tt.items)
+			err = d.processItems(context.Background(), tt.items)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("processItems() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
+
+func TestDownloader_processItem_AttachmentTypes(t *testing.T) {
+	item := homeboxclient.Item{
+		ID:   "item123-abc",
+		Name: "Typed Item",
+		Attachments: []homeboxclient.Attachment{
+			{ID: "att1", Type: "photo", Document: homeboxclient.DocumentOut{Title: "front.jpg"}},
+			{ID: "att2", Type: "receipt", Document: homeboxclient.DocumentOut{Title: "receipt.pdf"}},
+			{ID: "att3", Type: "manual", Document: homeboxclient.DocumentOut{Title: "manual.pdf"}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		types       []string
+		typeFolders bool
+		wantFiles   []string
+		wantMissing []string
+	}{
+		{
+			name:      "no filter downloads everything",
+			wantFiles: []string{"front.jpg", "receipt.pdf", "manual.pdf"},
+		},
+		{
+			name:        "filter by type",
+			types:       []string{"photo", "receipt"},
+			wantFiles:   []string{"front.jpg", "receipt.pdf"},
+			wantMissing: []string{"manual.pdf"},
+		},
+		{
+			name:        "type folders",
+			types:       []string{"manual"},
+			typeFolders: true,
+			wantFiles:   []string{filepath.Join("manual", "manual.pdf")},
+			wantMissing: []string{"front.jpg", filepath.Join("photo", "front.jpg")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createTestConfig(t.TempDir())
+			cfg.AttachmentTypes = tt.types
+			cfg.TypeFolders = tt.typeFolders
+
+			mock := &mockItemsService{
+				openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+					return attachmentContent("test content"), nil
+				},
+			}
+			d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock))
+			if err != nil {
+				t.Fatalf("Failed to create downloader: %v", err)
+			}
+
+			if err := d.processItem(context.Background(), item); err != nil {
+				t.Fatalf("processItem() error = %v", err)
+			}
+
+			itemDir := filepath.Join(cfg.DownloadPath, "Typed Item_item123")
+			for _, f := range tt.wantFiles {
+				if _, err := os.Stat(filepath.Join(itemDir, f)); err != nil {
+					t.Errorf("Expected file %s: %v", f, err)
+				}
+			}
+			for _, f := range tt.wantMissing {
+				if _, err := os.Stat(filepath.Join(itemDir, f)); err == nil {
+					t.Errorf("File %s should not have been downloaded", f)
+				}
+			}
+		})
+	}
+}
+
+func TestDownloader_processItem_PrimaryOnly(t *testing.T) {
+	tests := []struct {
+		name        string
+		item        homeboxclient.Item
+		wantFile    string
+		wantAttachs []string
+	}{
+		{
+			name: "image id",
+			item: homeboxclient.Item{
+				ID:      "item123-abc",
+				AssetID: "000-007",
+				ImageID: "att2",
+				Attachments: []homeboxclient.Attachment{
+					{ID: "att1", Type: "photo", Document: homeboxclient.DocumentOut{Title: "back.jpg"}},
+					{ID: "att2", Type: "photo", Document: homeboxclient.DocumentOut{Title: "front.jpg"}},
+				},
+			},
+			wantFile:    "000-007.jpg",
+			wantAttachs: []string{"att2"},
+		},
+		{
+			name: "primary flag",
+			item: homeboxclient.Item{
+				ID: "item456-abc",
+				Attachments: []homeboxclient.Attachment{
+					{ID: "att1", Type: "receipt", Primary: true, Document: homeboxclient.DocumentOut{Title: "receipt.pdf"}},
+					{ID: "att2", Type: "photo", Primary: true, Document: homeboxclient.DocumentOut{Title: "front.png"}},
+				},
+			},
+			wantFile:    "item456.png",
+			wantAttachs: []string{"att2"},
+		},
+		{
+			name: "no primary photo",
+			item: homeboxclient.Item{
+				ID: "item789-abc",
+				Attachments: []homeboxclient.Attachment{
+					{ID: "att1", Type: "photo", Document: homeboxclient.DocumentOut{Title: "front.png"}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createTestConfig(t.TempDir())
+			cfg.PrimaryOnly = true
+
+			var downloaded []string
+			mock := &mockItemsService{
+				openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+					downloaded = append(downloaded, attachmentID)
+					return attachmentContent("test content"), nil
+				},
+			}
+			d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock))
+			if err != nil {
+				t.Fatalf("Failed to create downloader: %v", err)
+			}
+
+			if err := d.processItem(context.Background(), tt.item); err != nil {
+				t.Fatalf("processItem() error = %v", err)
+			}
+
+			if len(downloaded) != len(tt.wantAttachs) {
+				t.Fatalf("downloaded %v, want %v", downloaded, tt.wantAttachs)
+			}
+			for i := range downloaded {
+				if downloaded[i] != tt.wantAttachs[i] {
+					t.Errorf("downloaded %v, want %v", downloaded, tt.wantAttachs)
+				}
+			}
+			if tt.wantFile != "" {
+				if _, err := os.Stat(filepath.Join(cfg.DownloadPath, tt.wantFile)); err != nil {
+					t.Errorf("Expected file %s in flat output: %v", tt.wantFile, err)
+				}
+			}
+		})
+	}
+}
+
+func TestDownloader_PrimaryOnly_SameName(t *testing.T) {
+	cfg := createTestConfig(t.TempDir())
+	cfg.PrimaryOnly = true
+	mock := &mockItemsService{
+		openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+			return attachmentContent(itemID), nil
+		},
+	}
+	d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+
+	// Both items share an asset ID, so the second one can't be 000-007.jpg.
+	photo := []homeboxclient.Attachment{{ID: "att1", Type: "photo", Primary: true, Document: homeboxclient.DocumentOut{Title: "front.jpg"}}}
+	items := []homeboxclient.Item{
+		{ID: "item1-abc", AssetID: "000-007", Attachments: photo},
+		{ID: "item2-abc", AssetID: "000-007", Attachments: photo},
+	}
+	for _, item := range items {
+		if err := d.processItem(context.Background(), item); err != nil {
+			t.Fatalf("processItem() error = %v", err)
+		}
+	}
+
+	for name, want := range map[string]string{"000-007.jpg": "item1-abc", "000-007_item2-abc.jpg": "item2-abc"} {
+		data, err := os.ReadFile(filepath.Join(cfg.DownloadPath, name))
+		if err != nil || string(data) != want {
+			t.Errorf("%s = %q, %v, want the photo of %s", name, data, err, want)
+		}
+	}
+	if got, _ := d.AttachmentPath(items[1], photo[0]); got != "000-007_item2-abc.jpg" {
+		t.Errorf("AttachmentPath() of the second item = %q", got)
+	}
+}
+
+func TestDownloader_StructuredLogging(t *testing.T) {
+	var buf bytes.Buffer
+	l, err := logger.NewSlog(&buf, "info", "json")
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	testItem := createTestItem()
+	mock := &mockItemsService{
+		openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+			return attachmentContent("test content"), nil
+		},
+	}
+	d, err := New(createTestConfig(t.TempDir()), WithHomeboxClient(&mockClient{}), WithItemService(mock), WithLogger(l))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+
+	if err := d.processItem(context.Background(), testItem); err != nil {
+		t.Fatalf("processItem() error = %v", err)
+	}
+
+	var found bool
+	for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+		var entry map[string]any
+		if err := json.Unmarshal(line, &entry); err != nil {
+			t.Fatalf("invalid JSON log line %q: %v", line, err)
+		}
+		if entry["msg"] == "item details" {
+			t.Errorf("item JSON logged at info level")
+		}
+		if entry["msg"] != "downloaded attachment" {
+			continue
+		}
+		found = true
+		if entry["item_id"] != testItem.ID {
+			t.Errorf("item_id = %v, want %v", entry["item_id"], testItem.ID)
+		}
+		if entry["attachment_id"] != "att123" {
+			t.Errorf("attachment_id = %v, want att123", entry["attachment_id"])
+		}
+		if entry["bytes"] != float64(len("test content")) {
+			t.Errorf("bytes = %v, want %d", entry["bytes"], len("test content"))
+		}
+		if _, ok := entry["duration"]; !ok {
+			t.Errorf("duration missing from log entry")
+		}
+	}
+	if !found {
+		t.Errorf("no downloaded attachment log entry in %s", buf.String())
+	}
+}
+
+func TestNew_InvalidLogFormat(t *testing.T) {
+	cfg := createTestConfig(t.TempDir())
+	cfg.LogFormat = "xml"
+	if _, err := New(cfg, WithHomeboxClient(&mockClient{})); err == nil {
+		t.Error("New() expected error for invalid log format")
+	}
+}
+
+func TestDownloader_Progress(t *testing.T) {
+	testItem := createTestItem()
+	mock := &mockItemsService{
+		listFunc: func(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error) {
+			if page == 1 {
+				return &homeboxclient.PaginationResult[homeboxclient.Item]{
+					Items: []homeboxclient.Item{testItem},
+					Total: 1,
+				}, nil
+			}
+			return &homeboxclient.PaginationResult[homeboxclient.Item]{Total: 1}, nil
+		},
+		getFunc: func(id string) (*homeboxclient.Item, error) {
+			return &testItem, nil
+		},
+		openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+			return attachmentContent("test content"), nil
+		},
+	}
+
+	var buf bytes.Buffer
+	cfg := createTestConfig(t.TempDir())
+	cfg.Progress = "json"
+	d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock), WithProgressOutput(&buf))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+
+	if err := d.DownloadAll(); err != nil {
+		t.Fatalf("DownloadAll() error = %v", err)
+	}
+
+	stats := d.Stats()
+	if stats.ItemsDone != 1 || stats.ItemsTotal != 1 || stats.Attachments != 1 || stats.Bytes != int64(len("test content")) {
+		t.Errorf("Stats() = %+v", stats)
+	}
+
+	var event progress.Event
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if err := json.Unmarshal(lines[len(lines)-1], &event); err != nil {
+		t.Fatalf("invalid progress event %q: %v", buf.String(), err)
+	}
+	if event.Event != "complete" || event.ItemsDone != 1 {
+		t.Errorf("final progress event = %+v", event)
+	}
+}
+
+func TestDownloader_ProgressFailed(t *testing.T) {
+	mock := &mockItemsService{
+		listFunc: func(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error) {
+			return nil, errors.New("connection refused")
+		},
+	}
+
+	var buf bytes.Buffer
+	cfg := createTestConfig(t.TempDir())
+	cfg.Progress = "json"
+	d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock), WithProgressOutput(&buf))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+	if err := d.DownloadAll(); err == nil {
+		t.Fatal("DownloadAll() expected error")
+	}
+
+	var event progress.Event
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if err := json.Unmarshal(lines[len(lines)-1], &event); err != nil {
+		t.Fatalf("invalid progress event %q: %v", buf.String(), err)
+	}
+	if event.Event != "failed" {
+		t.Errorf("final progress event = %+v, want failed", event)
+	}
+}
+
+func TestNew_InvalidProgressMode(t *testing.T) {
+	cfg := createTestConfig(t.TempDir())
+	cfg.Progress = "bars"
+	if _, err := New(cfg, WithHomeboxClient(&mockClient{})); err == nil {
+		t.Error("New() expected error for invalid progress mode")
+	}
+}
+
+func TestDownloader_Incremental(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	item := homeboxclient.Item{
+		ID:   "item123-abc",
+		Name: "Incremental Item",
+		Attachments: []homeboxclient.Attachment{
+			{ID: "att1", Type: "photo", UpdatedAt: updatedAt, Document: homeboxclient.DocumentOut{Title: "front.jpg"}},
+		},
+	}
+
+	cfg := createTestConfig(t.TempDir())
+	cfg.Incremental = true
+
+	downloads := 0
+	mock := &mockItemsService{
+		openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+			downloads++
+			return attachmentContent("test content"), nil
+		},
+	}
+	d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+
+	// First run downloads, second run skips the unchanged attachment.
+	for i := 0; i < 2; i++ {
+		if err := d.processItem(context.Background(), item); err != nil {
+			t.Fatalf("processItem() error = %v", err)
+		}
+	}
+	if downloads != 1 {
+		t.Errorf("downloads = %d, want 1", downloads)
+	}
+	if skipped := d.Stats().Skipped; skipped != 1 {
+		t.Errorf("Stats().Skipped = %d, want 1", skipped)
+	}
+
+	// An attachment updated on the server is downloaded again.
+	item.Attachments[0].UpdatedAt = updatedAt.Add(time.Hour)
+	if err := d.processItem(context.Background(), item); err != nil {
+		t.Fatalf("processItem() error = %v", err)
+	}
+	if downloads != 2 {
+		t.Errorf("downloads = %d, want 2 after attachment changed", downloads)
+	}
+}
+
+func TestDownloader_LinkFrom(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	item := homeboxclient.Item{
+		ID:   "item123-abc",
+		Name: "Linked Item",
+		Attachments: []homeboxclient.Attachment{
+			{ID: "att1", Type: "photo", UpdatedAt: updatedAt, Document: homeboxclient.DocumentOut{Title: "front.jpg"}},
+		},
+	}
+
+	downloads := 0
+	mock := &mockItemsService{
+		openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+			downloads++
+			return attachmentContent("test content"), nil
+		},
+	}
+
+	// The first snapshot downloads the attachment.
+	previous := createTestConfig(t.TempDir())
+	d, err := New(previous, WithHomeboxClient(&mockClient{}), WithItemService(mock))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+	if err := d.processItem(context.Background(), item); err != nil {
+		t.Fatalf("processItem() error = %v", err)
+	}
+
+	// The next snapshot links the unchanged attachment instead.
+	cfg := createTestConfig(t.TempDir())
+	cfg.LinkFrom = previous.DownloadPath
+	d, err = New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+	if err := d.processItem(context.Background(), item); err != nil {
+		t.Fatalf("processItem() error = %v", err)
+	}
+	if downloads != 1 {
+		t.Errorf("downloads = %d, want 1", downloads)
+	}
+	if skipped := d.Stats().Skipped; skipped != 1 {
+		t.Errorf("Stats().Skipped = %d, want 1", skipped)
+	}
+
+	rel := filepath.Join(d.attachmentDirectory(item, item.Attachments[0]), d.fileManager.GenerateFilename(item, item.Attachments[0]))
+	prevInfo, err := os.Stat(filepath.Join(previous.DownloadPath, rel))
+	if err != nil {
+		t.Fatalf("previous attachment missing: %v", err)
+	}
+	info, err := os.Stat(filepath.Join(cfg.DownloadPath, rel))
+	if err != nil {
+		t.Fatalf("linked attachment missing: %v", err)
+	}
+	if !os.SameFile(prevInfo, info) {
+		t.Error("attachment was not hardlinked to the previous snapshot")
+	}
+}
+
+// memorySink is a storage.Sink keeping objects in memory.
+type memorySink struct {
+	objects map[string]storage.Object
+}
+
+func (m *memorySink) Put(ctx context.Context, name string, r io.Reader, size int64, modTime time.Time) (int64, error) {
+	n, err := io.Copy(io.Discard, r)
+	if err != nil {
+		return n, err
+	}
+	m.objects[name] = storage.Object{Name: name, Size: n, ModTime: modTime}
+	return n, nil
+}
+
+func (m *memorySink) Stat(ctx context.Context, name string) (storage.Object, error) {
+	obj, ok := m.objects[name]
+	if !ok {
+		return storage.Object{}, fs.ErrNotExist
+	}
+	return obj, nil
+}
+
+func (m *memorySink) String() string {
+	return "memory"
+}
+
+func TestDownloader_WithSink(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	item := homeboxclient.Item{
+		ID:   "item123-abc",
+		Name: "Sink Item",
+		Attachments: []homeboxclient.Attachment{
+			{ID: "att1", Type: "photo", UpdatedAt: updatedAt, Document: homeboxclient.DocumentOut{Title: "front.jpg"}},
+		},
+	}
+
+	cfg := createTestConfig(t.TempDir())
+	cfg.Incremental = true
+	cfg.TypeFolders = true
+
+	downloads := 0
+	mock := &mockItemsService{
+		openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+			downloads++
+			return attachmentContent("test content"), nil
+		},
+	}
+	sink := &memorySink{objects: make(map[string]storage.Object)}
+	d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock), WithSink(sink))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := d.processItem(context.Background(), item); err != nil {
+			t.Fatalf("processItem() error = %v", err)
+		}
+	}
+
+	obj, ok := sink.objects["Sink Item_item123/photo/front.jpg"]
+	if !ok {
+		t.Fatalf("attachment not written to sink, have %v", sink.objects)
+	}
+	if obj.Size != 12 || !obj.ModTime.Equal(updatedAt) {
+		t.Errorf("object = %+v, want size 12 and mod time %v", obj, updatedAt)
+	}
+	if downloads != 1 {
+		t.Errorf("downloads = %d, want 1 with the unchanged attachment skipped", downloads)
+	}
+	if entries, _ := os.ReadDir(cfg.DownloadPath); len(entries) != 0 {
+		t.Errorf("files written to the download path: %v", entries)
+	}
+}
+
+func TestDownloader_Encryption(t *testing.T) {
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	item := createTestItem()
+
+	cfg := createTestConfig(t.TempDir())
+	cfg.Recipients = []string{id.Recipient().String()}
+	mock := &mockItemsService{
+		openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+			return attachmentContent("test content"), nil
+		},
+	}
+	d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+	if err := d.processItem(context.Background(), item); err != nil {
+		t.Fatalf("processItem() error = %v", err)
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(cfg.DownloadPath, "*", "test.txt"+encryption.Suffix))
+	if len(matches) != 1 {
+		t.Fatalf("encrypted attachment not found")
+	}
+	f, err := os.Open(matches[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var plaintext bytes.Buffer
+	if _, err := encryption.Decrypt(&plaintext, f, id); err != nil {
+		t.Fatalf("Decrypt() error = %v", err)
+	}
+	if plaintext.String() != "test content" {
+		t.Errorf("decrypted content = %q", plaintext.String())
+	}
+
+	cfg.Recipients = []string{"not-a-key"}
+	if _, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock)); err == nil {
+		t.Error("New() expected error for invalid recipient")
+	}
+}
+
+func TestDownloader_DownloadAllContextCancelled(t *testing.T) {
+	testItem := createTestItem()
+	mock := &mockItemsService{
+		listFunc: func(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error) {
+			return &homeboxclient.PaginationResult[homeboxclient.Item]{Items: []homeboxclient.Item{testItem}}, nil
+		},
+		getFunc: func(id string) (*homeboxclient.Item, error) {
+			return &testItem, nil
+		},
+	}
+	d, err := New(createTestConfig(t.TempDir()), WithHomeboxClient(&mockClient{}), WithItemService(mock))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := d.DownloadAllContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("DownloadAllContext() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestDownloader_CSV(t *testing.T) {
+	item := createTestItem()
+	item.Location = &homeboxclient.Location{ID: "shelf", Name: "Shelf"}
+	item.Fields = []homeboxclient.ItemField{{Name: "Color", Type: "text", TextValue: "Red"}}
+
+	cfg := createTestConfig(t.TempDir())
+	cfg.Format = config.FormatCSV
+
+	mock := &mockItemsService{
+		listFunc: func(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error) {
+			if page > 1 {
+				return &homeboxclient.PaginationResult[homeboxclient.Item]{}, nil
+			}
+			return &homeboxclient.PaginationResult[homeboxclient.Item]{Items: []homeboxclient.Item{item}, Total: 1}, nil
+		},
+		getFunc: func(id string) (*homeboxclient.Item, error) {
+			return &item, nil
+		},
+		openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+			t.Error("attachments must not be downloaded for a CSV export")
+			return attachmentContent(""), nil
+		},
+	}
+	locations := &mockLocationsService{tree: []homeboxclient.Location{
+		{ID: "garage", Name: "Garage", Children: []homeboxclient.Location{{ID: "shelf", Name: "Shelf"}}},
+	}}
+	d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock), WithLocationService(locations))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+
+	if err := d.DownloadAll(); err != nil {
+		t.Fatalf("DownloadAll() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(cfg.DownloadPath, "items.csv"))
+	if err != nil {
+		t.Fatalf("failed to read CSV: %v", err)
+	}
+	csv := string(data)
+	for _, want := range []string{"HB.import_ref", "HB.field.Color", "test123", "Garage / Shelf", "Red"} {
+		if !strings.Contains(csv, want) {
+			t.Errorf("CSV missing %q:\n%s", want, csv)
+		}
+	}
+}
+
+func TestDownloader_FetchItems(t *testing.T) {
+	mock := &mockItemsService{
+		listFunc: func(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error) {
+			if page > 2 {
+				return &homeboxclient.PaginationResult[homeboxclient.Item]{}, nil
+			}
+			id := string(rune('0' + page))
+			return &homeboxclient.PaginationResult[homeboxclient.Item]{Items: []homeboxclient.Item{{ID: id}}}, nil
+		},
+		getFunc: func(id string) (*homeboxclient.Item, error) {
+			return &homeboxclient.Item{ID: id, Name: "Item " + id}, nil
+		},
+	}
+	d, err := New(createTestConfig(t.TempDir()), WithHomeboxClient(&mockClient{}), WithItemService(mock))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+
+	items, err := d.FetchItems(context.Background())
+	if err != nil {
+		t.Fatalf("FetchItems() error = %v", err)
+	}
+	if len(items) != 2 || items[0].Name != "Item 1" || items[1].Name != "Item 2" {
+		t.Errorf("FetchItems() = %+v, want the full details of both items", items)
+	}
+}
+
+func TestDownloader_AttachmentPath(t *testing.T) {
+	item := homeboxclient.Item{
+		ID:      "item123-abc",
+		Name:    "Drill",
+		AssetID: "000-042",
+		ImageID: "photo1",
+		Attachments: []homeboxclient.Attachment{
+			{ID: "photo1", Type: "photo", Document: homeboxclient.DocumentOut{Title: "front.jpg"}},
+			{ID: "receipt1", Type: "receipt", Document: homeboxclient.DocumentOut{Title: "receipt.pdf"}},
+		},
+	}
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*config.Config)
+		want   map[string]string // attachment ID to path, missing if left out
+	}{
+		{
+			name:   "default",
+			modify: func(c *config.Config) {},
+			want:   map[string]string{"photo1": "Drill_item123/front.jpg", "receipt1": "Drill_item123/receipt.pdf"},
+		},
+		{
+			name:   "type folders and filter",
+			modify: func(c *config.Config) { c.TypeFolders = true; c.AttachmentTypes = []string{"receipt"} },
+			want:   map[string]string{"receipt1": "Drill_item123/receipt/receipt.pdf"},
+		},
+		{
+			name:   "primary only",
+			modify: func(c *config.Config) { c.PrimaryOnly = true },
+			want:   map[string]string{"photo1": "000-042.jpg"},
+		},
+		{
+			name:   "encrypted",
+			modify: func(c *config.Config) { c.Recipients = []string{identity.Recipient().String()} },
+			want:   map[string]string{"photo1": "Drill_item123/front.jpg.age", "receipt1": "Drill_item123/receipt.pdf.age"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := createTestConfig(t.TempDir())
+			tt.modify(&cfg)
+			d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(&mockItemsService{}))
+			if err != nil {
+				t.Fatalf("Failed to create downloader: %v", err)
+			}
+			for _, a := range item.Attachments {
+				got, ok := d.AttachmentPath(item, a)
+				want, wantOK := tt.want[a.ID]
+				if ok != wantOK || got != want {
+					t.Errorf("AttachmentPath(%s) = %q, %v, want %q, %v", a.ID, got, ok, want, wantOK)
+				}
+			}
+		})
+	}
+}
+
+func TestDownloader_HTML(t *testing.T) {
+	item := createTestItem()
+	item.Attachments[0].Type = "photo"
+
+	cfg := createTestConfig(t.TempDir())
+	cfg.HTML = true
+
+	mock := &mockItemsService{
+		listFunc: func(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error) {
+			if page > 1 {
+				return &homeboxclient.PaginationResult[homeboxclient.Item]{}, nil
+			}
+			return &homeboxclient.PaginationResult[homeboxclient.Item]{Items: []homeboxclient.Item{item}, Total: 1}, nil
+		},
+		getFunc: func(id string) (*homeboxclient.Item, error) {
+			return &item, nil
+		},
+		openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+			return attachmentContent("photo"), nil
+		},
+	}
+	d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock), WithLocationService(&mockLocationsService{}))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+	if err := d.DownloadAll(); err != nil {
+		t.Fatalf("DownloadAll() error = %v", err)
+	}
+
+	for _, name := range []string{"index.html", "search-index.js", "items/test123.html", "Test Item_test123/test.txt"} {
+		if _, err := os.Stat(filepath.Join(cfg.DownloadPath, filepath.FromSlash(name))); err != nil {
+			t.Errorf("missing %s: %v", name, err)
+		}
+	}
+	page, err := os.ReadFile(filepath.Join(cfg.DownloadPath, "items", "test123.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(page), `src="../Test%20Item_test123/test.txt"`) {
+		t.Errorf("item page does not show the exported photo:\n%s", page)
+	}
+}
+
+func TestDownloader_PrimaryPhoto(t *testing.T) {
+	mock := &mockItemsService{
+		openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+			return attachmentContent("photo of " + attachmentID), nil
+		},
+	}
+	d, err := New(createTestConfig(t.TempDir()), WithHomeboxClient(&mockClient{}), WithItemService(mock))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+
+	item := homeboxclient.Item{
+		ID: "item",
+		Attachments: []homeboxclient.Attachment{
+			{ID: "receipt", Type: "receipt"},
+			{ID: "front", Type: "photo", Primary: true},
+		},
+	}
+	data, err := d.PrimaryPhoto(item)
+	if err != nil || string(data) != "photo of front" {
+		t.Errorf("PrimaryPhoto() = %q, %v, want the primary photo", data, err)
+	}
+
+	item.Attachments = item.Attachments[:1]
+	if data, err := d.PrimaryPhoto(item); err != nil || data != nil {
+		t.Errorf("PrimaryPhoto() = %q, %v, want nil for an item without photo", data, err)
+	}
+}
+
+func TestDownloader_Markdown(t *testing.T) {
+	item := createTestItem()
+	item.Location = &homeboxclient.Location{ID: "garage", Name: "Garage"}
+
+	cfg := createTestConfig(t.TempDir())
+	cfg.Format = config.FormatMarkdown
+
+	mock := &mockItemsService{
+		listFunc: func(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error) {
+			if page > 1 {
+				return &homeboxclient.PaginationResult[homeboxclient.Item]{}, nil
+			}
+			return &homeboxclient.PaginationResult[homeboxclient.Item]{Items: []homeboxclient.Item{item}, Total: 1}, nil
+		},
+		getFunc: func(id string) (*homeboxclient.Item, error) {
+			return &item, nil
+		},
+		openAttachmentFunc: func(itemID, attachmentID string) (*homeboxclient.AttachmentContent, error) {
+			return attachmentContent("content"), nil
+		},
+	}
+	locations := &mockLocationsService{tree: []homeboxclient.Location{{ID: "garage", Name: "Garage"}}}
+	d, err := New(cfg, WithHomeboxClient(&mockClient{}), WithItemService(mock), WithLocationService(locations))
+	if err != nil {
+		t.Fatalf("Failed to create downloader: %v", err)
+	}
+	if err := d.DownloadAll(); err != nil {
+		t.Fatalf("DownloadAll() error = %v", err)
+	}
+
+	for _, name := range []string{"Items/Test Item.md", "Locations/Garage.md", "Test Item_test123/test.txt"} {
+		if _, err := os.Stat(filepath.Join(cfg.DownloadPath, filepath.FromSlash(name))); err != nil {
+			t.Errorf("missing %s: %v", name, err)
+		}
+	}
+	note, err := os.ReadFile(filepath.Join(cfg.DownloadPath, "Items", "Test Item.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(note), "[test.txt](../Test%20Item_test123/test.txt)") {
+		t.Errorf("note does not link the attachment:\n%s", note)
+	}
+}
+
+func TestCheckServerVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		version  string
+		wantWarn string
+	}{
+		{name: "target version", status: http.StatusOK, version: homeboxclient.TargetVersion},
+		{name: "patch release", status: http.StatusOK, version: "v0.16.4"},
+		{name: "newer release", status: http.StatusOK, version: "v0.21.0", wantWarn: "server version differs"},
+		{name: "older supported release", status: http.StatusOK, version: homeboxclient.MinVersion, wantWarn: "server version differs"},
+		{name: "unsupported release", status: http.StatusOK, version: "v0.9.3", wantWarn: "older than the oldest supported version"},
+		{name: "nightly", status: http.StatusOK, version: "nightly", wantWarn: "server version differs"},
+		{name: "status unavailable", status: http.StatusInternalServerError, wantWarn: "failed to check the server version"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				json.NewEncoder(w).Encode(map[string]any{"health": true, "build": map[string]string{"version": tt.version}})
+			}))
+			defer srv.Close()
+
+			client, err := homeboxclient.NewClient(srv.URL)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+			var buf bytes.Buffer
+			l, err := logger.NewSlog(&buf, "info", "text")
+			if err != nil {
+				t.Fatalf("Failed to create logger: %v", err)
+			}
+
+			checkServerVersion(client, l)
+
+			if tt.wantWarn == "" {
+				if buf.Len() != 0 {
+					t.Errorf("unexpected log output: %s", buf.String())
+				}
+			} else if !strings.Contains(buf.String(), tt.wantWarn) {
+				t.Errorf("log output = %q, want %q", buf.String(), tt.wantWarn)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package encryption
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"filippo.io/age"
+)
+
+// DecryptDir decrypts every encrypted file below src into the same relative
+// path below dst, keeping modification times. Other files are ignored. It
+// returns the number of files decrypted.
+func DecryptDir(src, dst string, identities ...age.Identity) (int, error) {
+	count := 0
+	err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), Suffix) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if err := decryptFile(filepath.Join(dst, strings.TrimSuffix(rel, Suffix)), path, identities); err != nil {
+			return fmt.Errorf("failed to decrypt %s: %w", rel, err)
+		}
+		count++
+		return nil
+	})
+	return count, err
+}
+
+func decryptFile(dest, src string, identities []age.Identity) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(dest), "."+filepath.Base(dest)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := Decrypt(tmp, in, identities...); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	if err := os.Chtimes(tmp.Name(), info.ModTime(), info.ModTime()); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dest)
+}
@@ -0,0 +1,70 @@
+package encryption
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+
+	"github.com/kusold/homebox-export/internal/storage"
+)
+
+func TestDecryptDir(t *testing.T) {
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := t.TempDir()
+	local, err := storage.NewLocal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink, _ := NewSink(local, id.Recipient())
+
+	modTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	files := map[string]string{
+		"Item_abc/front.jpg":         "photo",
+		"Item_abc/receipt/bill.pdf":  "receipt",
+		"Other_def/manual/guide.pdf": "manual",
+	}
+	for name, content := range files {
+		if _, err := sink.Put(context.Background(), name, strings.NewReader(content), -1, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Files that aren't encrypted are left alone.
+	if err := os.WriteFile(filepath.Join(src, "metrics.prom"), []byte("x 1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := t.TempDir()
+	n, err := DecryptDir(src, dst, id)
+	if err != nil {
+		t.Fatalf("DecryptDir() error = %v", err)
+	}
+	if n != len(files) {
+		t.Errorf("DecryptDir() = %d files, want %d", n, len(files))
+	}
+	for name, want := range files {
+		path := filepath.Join(dst, filepath.FromSlash(name))
+		got, err := os.ReadFile(path)
+		if err != nil || string(got) != want {
+			t.Errorf("%s = %q, %v; want %q", name, got, err, want)
+		}
+		if info, err := os.Stat(path); err == nil && !info.ModTime().Equal(modTime) {
+			t.Errorf("%s mod time = %v, want %v", name, info.ModTime(), modTime)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dst, "metrics.prom")); !os.IsNotExist(err) {
+		t.Error("unencrypted file copied")
+	}
+
+	other, _ := age.GenerateX25519Identity()
+	if _, err := DecryptDir(src, t.TempDir(), other); err == nil {
+		t.Error("DecryptDir() expected error with the wrong identity")
+	}
+}
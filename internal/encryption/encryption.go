@@ -0,0 +1,209 @@
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+
+	"github.com/kusold/homebox-export/internal/storage"
+)
+
+// Suffix is appended to the name of every encrypted file.
+const Suffix = ".age"
+
+// ParseRecipients returns the age recipients to encrypt to. Each entry in
+// recipients is either an age public key (age1...) or the path of a recipients
+// file with one key per line. A passphrase can be used instead of recipients.
+func ParseRecipients(recipients []string, passphrase string) ([]age.Recipient, error) {
+	if passphrase != "" {
+		if len(recipients) > 0 {
+			return nil, errors.New("a passphrase can't be combined with recipients")
+		}
+		r, err := age.NewScryptRecipient(passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("invalid passphrase: %w", err)
+		}
+		return []age.Recipient{r}, nil
+	}
+
+	var parsed []age.Recipient
+	for _, r := range recipients {
+		if strings.HasPrefix(r, "age1") {
+			recipient, err := age.ParseX25519Recipient(r)
+			if err != nil {
+				return nil, fmt.Errorf("invalid recipient %q: %w", r, err)
+			}
+			parsed = append(parsed, recipient)
+			continue
+		}
+
+		f, err := os.Open(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open recipients file: %w", err)
+		}
+		rs, err := age.ParseRecipients(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse recipients file %s: %w", r, err)
+		}
+		parsed = append(parsed, rs...)
+	}
+	return parsed, nil
+}
+
+// ParseIdentities returns the age identities to decrypt with, read from
+// identity files, or derived from a passphrase.
+func ParseIdentities(files []string, passphrase string) ([]age.Identity, error) {
+	var identities []age.Identity
+	if passphrase != "" {
+		id, err := age.NewScryptIdentity(passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("invalid passphrase: %w", err)
+		}
+		identities = append(identities, id)
+	}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open identity file: %w", err)
+		}
+		ids, err := age.ParseIdentities(bufio.NewReader(f))
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse identity file %s: %w", file, err)
+		}
+		identities = append(identities, ids...)
+	}
+	if len(identities) == 0 {
+		return nil, errors.New("an identity file or passphrase is required")
+	}
+	return identities, nil
+}
+
+// Sink encrypts everything written through it to a set of age recipients
+// before passing it on, adding Suffix to every name.
+type Sink struct {
+	next       storage.Sink
+	recipients []age.Recipient
+}
+
+func NewSink(next storage.Sink, recipients ...age.Recipient) (*Sink, error) {
+	if len(recipients) == 0 {
+		return nil, errors.New("at least one recipient is required")
+	}
+	return &Sink{next: next, recipients: recipients}, nil
+}
+
+// Put encrypts r while streaming it to the underlying sink and returns the
+// number of plaintext bytes written. A known size is passed on as the size of
+// the encrypted file.
+func (s *Sink) Put(ctx context.Context, name string, r io.Reader, size int64, modTime time.Time) (int64, error) {
+	// age writes its header as soon as encryption starts; keep it back to
+	// learn its length before the upload starts.
+	var header bytes.Buffer
+	out := &switchWriter{w: &header}
+	w, err := age.Encrypt(out, s.recipients...)
+	if err != nil {
+		return 0, err
+	}
+	encryptedSize := int64(-1)
+	if size >= 0 {
+		encryptedSize = int64(header.Len()) + payloadSize(size)
+	}
+
+	pr, pw := io.Pipe()
+	out.w = pw
+	counter := &storage.CountingReader{R: r}
+
+	go func() {
+		if _, err := pw.Write(header.Bytes()); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		if _, err := io.Copy(w, counter); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		pw.CloseWithError(w.Close())
+	}()
+
+	_, err = s.next.Put(ctx, name+Suffix, pr, encryptedSize, modTime)
+	// Unblock the encrypting goroutine if the sink stopped reading early.
+	pr.CloseWithError(io.ErrClosedPipe)
+	return counter.N, err
+}
+
+func (s *Sink) Stat(ctx context.Context, name string) (storage.Object, error) {
+	obj, err := s.next.Stat(ctx, name+Suffix)
+	obj.Name = name
+	return obj, err
+}
+
+// PathIn implements storage.Linker when the underlying sink does.
+func (s *Sink) PathIn(root, name string) string {
+	if l, ok := s.next.(storage.Linker); ok {
+		return l.PathIn(root, name+Suffix)
+	}
+	return ""
+}
+
+// Link implements storage.Linker when the underlying sink does.
+func (s *Sink) Link(src, name string) error {
+	l, ok := s.next.(storage.Linker)
+	if !ok {
+		return errors.New("output doesn't support links")
+	}
+	return l.Link(src, name+Suffix)
+}
+
+// Close closes the underlying sink if it holds a connection.
+func (s *Sink) Close() error {
+	if c, ok := s.next.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+func (s *Sink) String() string {
+	return s.next.String() + " (encrypted)"
+}
+
+// Decrypt decrypts src, written by Sink, to dst.
+func Decrypt(dst io.Writer, src io.Reader, identities ...age.Identity) (int64, error) {
+	r, err := age.Decrypt(src, identities...)
+	if err != nil {
+		return 0, err
+	}
+	return io.Copy(dst, r)
+}
+
+// chunkSize is the size of the chunks age encrypts the payload in, each of
+// which gets a tag of tagSize bytes.
+const (
+	chunkSize = 64 * 1024
+	tagSize   = 16
+)
+
+// payloadSize returns the length of the encrypted payload of size bytes,
+// following the header. Empty plaintext is encrypted as one empty chunk.
+func payloadSize(size int64) int64 {
+	chunks := max(1, (size+chunkSize-1)/chunkSize)
+	return size + chunks*tagSize
+}
+
+// switchWriter writes to w, which can be replaced between writes.
+type switchWriter struct {
+	w io.Writer
+}
+
+func (s *switchWriter) Write(p []byte) (int, error) {
+	return s.w.Write(p)
+}
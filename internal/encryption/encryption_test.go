@@ -0,0 +1,224 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+
+	"github.com/kusold/homebox-export/internal/storage"
+)
+
+func TestParseRecipients(t *testing.T) {
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "recipients.txt")
+	content := "# backup key\n" + id.Recipient().String() + "\n"
+	if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		recipients []string
+		passphrase string
+		want       int
+		wantErr    bool
+	}{
+		{name: "public key", recipients: []string{id.Recipient().String()}, want: 1},
+		{name: "recipients file", recipients: []string{file}, want: 1},
+		{name: "key and file", recipients: []string{id.Recipient().String(), file}, want: 2},
+		{name: "passphrase", passphrase: "correct horse battery staple", want: 1},
+		{name: "invalid key", recipients: []string{"age1invalid"}, wantErr: true},
+		{name: "missing file", recipients: []string{filepath.Join(t.TempDir(), "missing")}, wantErr: true},
+		{name: "passphrase and recipients", recipients: []string{file}, passphrase: "secret", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseRecipients(tt.recipients, tt.passphrase)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRecipients() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.want {
+				t.Errorf("ParseRecipients() returned %d recipients, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseIdentities(t *testing.T) {
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "key.txt")
+	if err := os.WriteFile(file, []byte(id.String()+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if ids, err := ParseIdentities([]string{file}, ""); err != nil || len(ids) != 1 {
+		t.Errorf("ParseIdentities() = %d identities, %v", len(ids), err)
+	}
+	if _, err := ParseIdentities(nil, ""); err == nil {
+		t.Error("ParseIdentities() expected error without identities")
+	}
+}
+
+func TestSink(t *testing.T) {
+	ctx := context.Background()
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink, err := NewSink(local, id.Recipient())
+	if err != nil {
+		t.Fatalf("NewSink() error = %v", err)
+	}
+
+	modTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	n, err := sink.Put(ctx, "item/receipt.pdf", strings.NewReader("123 Main Street"), 15, modTime)
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if n != 15 {
+		t.Errorf("Put() = %d bytes, want 15 plaintext bytes", n)
+	}
+
+	ciphertext, err := os.ReadFile(local.Path("item/receipt.pdf.age"))
+	if err != nil {
+		t.Fatalf("encrypted file missing: %v", err)
+	}
+	if bytes.Contains(ciphertext, []byte("Main Street")) {
+		t.Error("file is not encrypted")
+	}
+
+	var plaintext bytes.Buffer
+	if _, err := Decrypt(&plaintext, bytes.NewReader(ciphertext), id); err != nil {
+		t.Fatalf("Decrypt() error = %v", err)
+	}
+	if plaintext.String() != "123 Main Street" {
+		t.Errorf("Decrypt() = %q", plaintext.String())
+	}
+
+	obj, err := sink.Stat(ctx, "item/receipt.pdf")
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if obj.Name != "item/receipt.pdf" || !obj.ModTime.Equal(modTime) {
+		t.Errorf("Stat() = %+v", obj)
+	}
+	if _, err := sink.Stat(ctx, "item/missing.pdf"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat() of missing file error = %v, want fs.ErrNotExist", err)
+	}
+
+	if got, want := sink.PathIn("/previous", "item/receipt.pdf"), filepath.Join("/previous", "item", "receipt.pdf.age"); got != want {
+		t.Errorf("PathIn() = %s, want %s", got, want)
+	}
+}
+
+// sizeSink records the size passed to Put and the bytes actually written.
+type sizeSink struct {
+	storage.Sink
+	size, written int64
+}
+
+func (s *sizeSink) Put(ctx context.Context, name string, r io.Reader, size int64, modTime time.Time) (int64, error) {
+	s.size = size
+	n, err := io.Copy(io.Discard, r)
+	s.written = n
+	return n, err
+}
+
+func TestSink_Size(t *testing.T) {
+	id, _ := age.GenerateX25519Identity()
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 7} {
+		next := &sizeSink{}
+		sink, _ := NewSink(next, id.Recipient())
+		plaintext := bytes.Repeat([]byte("x"), size)
+
+		if _, err := sink.Put(context.Background(), "file", bytes.NewReader(plaintext), int64(size), time.Time{}); err != nil {
+			t.Fatalf("Put() of %d bytes error = %v", size, err)
+		}
+		if next.size != next.written {
+			t.Errorf("Put() of %d bytes passed size %d, wrote %d", size, next.size, next.written)
+		}
+	}
+
+	next := &sizeSink{}
+	sink, _ := NewSink(next, id.Recipient())
+	if _, err := sink.Put(context.Background(), "file", strings.NewReader("data"), -1, time.Time{}); err != nil || next.size != -1 {
+		t.Errorf("Put() of unknown size passed size %d, %v", next.size, err)
+	}
+}
+
+func TestSink_Passphrase(t *testing.T) {
+	recipients, err := ParseRecipients(nil, "correct horse battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink, err := NewSink(local, recipients...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sink.Put(context.Background(), "note.txt", strings.NewReader("serial 1234"), -1, time.Time{}); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	ids, err := ParseIdentities(nil, "correct horse battery staple")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(local.Path("note.txt.age"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var plaintext bytes.Buffer
+	if _, err := Decrypt(&plaintext, f, ids...); err != nil {
+		t.Fatalf("Decrypt() error = %v", err)
+	}
+	if plaintext.String() != "serial 1234" {
+		t.Errorf("Decrypt() = %q", plaintext.String())
+	}
+}
+
+func TestSink_PutError(t *testing.T) {
+	id, _ := age.GenerateX25519Identity()
+	local, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink, _ := NewSink(local, id.Recipient())
+
+	if _, err := sink.Put(context.Background(), "file.txt", &failingReader{}, -1, time.Time{}); err == nil {
+		t.Fatal("Put() expected error")
+	}
+	if _, err := os.Stat(local.Path("file.txt.age")); !os.IsNotExist(err) {
+		t.Errorf("partial file written: %v", err)
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
@@ -0,0 +1,232 @@
+// Package fakehomebox is an in-memory Homebox server for tests and offline
+// demos. It serves the API the client uses from seedable Fixtures, keeps what
+// clients create and change, and can inject faults: latency, error statuses
+// and truncated bodies.
+package fakehomebox
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+)
+
+// Server is a fake Homebox. It is an http.Handler serving the API below /api.
+type Server struct {
+	mu     sync.Mutex
+	data   Fixtures
+	tokens map[string]User // logged in users by token
+	refs   map[string]string // import refs by item ID
+	faults []*Fault
+	next   int
+	mux    *http.ServeMux
+}
+
+// New returns a server holding a copy of fixtures.
+func New(fixtures Fixtures) *Server {
+	s := &Server{
+		data:   clone(fixtures),
+		tokens: make(map[string]User),
+		refs:   make(map[string]string),
+		mux:    http.NewServeMux(),
+	}
+	if s.data.Version == "" {
+		s.data.Version = homeboxclient.TargetVersion
+	}
+	if s.data.Group.ID == "" {
+		s.data.Group.ID = s.id()
+	}
+	if s.data.Group.Name == "" {
+		s.data.Group.Name = "Home"
+	}
+	for i := range s.data.Labels {
+		s.data.Labels[i].ID = s.idOr(s.data.Labels[i].ID)
+	}
+	for i := range s.data.Locations {
+		s.data.Locations[i].ID = s.idOr(s.data.Locations[i].ID)
+	}
+	for i := range s.data.Notifiers {
+		s.data.Notifiers[i].ID = s.idOr(s.data.Notifiers[i].ID)
+	}
+	now := time.Now().UTC()
+	for i := range s.data.Items {
+		item := &s.data.Items[i]
+		item.ID = s.idOr(item.ID)
+		if item.CreatedAt.IsZero() {
+			item.CreatedAt = now
+		}
+		if item.UpdatedAt.IsZero() {
+			item.UpdatedAt = item.CreatedAt
+		}
+		for j := range item.Attachments {
+			item.Attachments[j].ID = s.idOr(item.Attachments[j].ID)
+			if item.Attachments[j].Type == "" {
+				item.Attachments[j].Type = homeboxclient.AttachmentTypeAttachment
+			}
+			if item.Attachments[j].CreatedAt.IsZero() {
+				item.Attachments[j].CreatedAt = item.CreatedAt
+			}
+		}
+		for j := range item.Fields {
+			item.Fields[j].ID = s.idOr(item.Fields[j].ID)
+		}
+		for j := range item.Maintenance {
+			item.Maintenance[j].ID = s.idOr(item.Maintenance[j].ID)
+		}
+	}
+	s.routes()
+	return s
+}
+
+// Start serves s on a local port, like httptest.NewServer. Close the returned
+// server when done.
+func (s *Server) Start() *httptest.Server {
+	return httptest.NewServer(s)
+}
+
+// Fixtures returns a copy of the server's current inventory, including what
+// clients changed.
+func (s *Server) Fixtures() Fixtures {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return clone(s.data)
+}
+
+// clone deep copies fixtures, so that neither the caller nor the server sees
+// the other's changes.
+func clone(f Fixtures) Fixtures {
+	data, err := json.Marshal(f)
+	if err != nil {
+		panic(fmt.Sprintf("fakehomebox: failed to copy fixtures: %v", err))
+	}
+	var c Fixtures
+	if err := json.Unmarshal(data, &c); err != nil {
+		panic(fmt.Sprintf("fakehomebox: failed to copy fixtures: %v", err))
+	}
+	return c
+}
+
+// id returns a new ID in the format of Homebox's UUIDs.
+func (s *Server) id() string {
+	s.next++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.next)
+}
+
+func (s *Server) idOr(id string) string {
+	if id != "" {
+		return id
+	}
+	return s.id()
+}
+
+// Fault changes the responses to the requests it matches.
+type Fault struct {
+	Method   string        // optional, matches any method if empty
+	Path     string        // optional, path.Match pattern such as "/api/v1/items/*"; matches any path if empty
+	Latency  time.Duration // delay before responding
+	Status   int           // optional, respond with this status instead
+	Truncate bool          // send only half of the body, then close the connection
+	Rate     float64       // optional, the fraction of matching requests affected; 0 affects all
+	Times    int           // optional, the number of requests affected before the fault is removed; 0 is unlimited
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	if f.Method != "" && f.Method != r.Method {
+		return false
+	}
+	if f.Path != "" {
+		if ok, _ := path.Match(f.Path, r.URL.Path); !ok {
+			return false
+		}
+	}
+	return f.Rate <= 0 || rand.Float64() < f.Rate
+}
+
+// AddFault injects a fault into the requests that follow.
+func (s *Server) AddFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// fault combines the faults matching a request into one.
+func (s *Server) fault(r *http.Request) Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var combined Fault
+	active := s.faults[:0]
+	for _, f := range s.faults {
+		if f.matches(r) {
+			combined.Latency += f.Latency
+			if combined.Status == 0 {
+				combined.Status = f.Status
+			}
+			combined.Truncate = combined.Truncate || f.Truncate
+			if f.Times > 0 {
+				if f.Times--; f.Times == 0 {
+					continue
+				}
+			}
+		}
+		active = append(active, f)
+	}
+	s.faults = active
+	return combined
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f := s.fault(r)
+	if f.Latency > 0 {
+		select {
+		case <-time.After(f.Latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if f.Status != 0 {
+		writeError(w, f.Status, http.StatusText(f.Status))
+		return
+	}
+	if !f.Truncate {
+		s.mux.ServeHTTP(w, r)
+		return
+	}
+
+	// Promise the whole body but send half of it; the server then drops the
+	// connection, as a proxy or network failure would.
+	rec := httptest.NewRecorder()
+	s.mux.ServeHTTP(rec, r)
+	body := rec.Body.Bytes()
+	for k, v := range rec.Header() {
+		w.Header()[k] = v
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(rec.Code)
+	w.Write(body[:len(body)/2])
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError responds like Homebox does to a failed request.
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
@@ -0,0 +1,412 @@
+package fakehomebox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"image"
+	_ "image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+)
+
+func login(t *testing.T, s *Server, user, pass string) *homeboxclient.Client {
+	t.Helper()
+	srv := s.Start()
+	t.Cleanup(srv.Close)
+	c, err := homeboxclient.NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Login(user, pass); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	return c
+}
+
+func TestDemo(t *testing.T) {
+	c := login(t, New(Demo()), "demo@example.com", "demo")
+
+	status, err := c.Status()
+	if err != nil || status.Build.Version != homeboxclient.TargetVersion {
+		t.Errorf("Status() = %+v, %v", status, err)
+	}
+
+	stats, err := homeboxclient.NewGroupsService(c).Statistics()
+	if err != nil {
+		t.Fatalf("Statistics() error = %v", err)
+	}
+	if stats.TotalItems != 4 || stats.TotalLocations != 4 || stats.TotalLabels != 3 || stats.TotalItemPrice != 2002.49 {
+		t.Errorf("Statistics() = %+v", stats)
+	}
+
+	tree, err := homeboxclient.NewLocationsService(c).GetTree(false)
+	if err != nil {
+		t.Fatalf("GetTree() error = %v", err)
+	}
+	if len(tree) != 1 || tree[0].Name != "Home" || len(tree[0].Children) != 3 {
+		t.Errorf("GetTree() = %+v", tree)
+	}
+
+	item, err := homeboxclient.NewItemsService(c).Get("item-bits")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if item.Parent == nil || item.Parent.Name != "Cordless Drill" || item.Location.Name != "Garage" || len(item.Labels) != 1 || item.Labels[0].Name != "Tools" {
+		t.Errorf("Get() = %+v", item)
+	}
+
+	csv, err := homeboxclient.NewItemsService(c).Export()
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	data, _ := io.ReadAll(csv)
+	csv.Close()
+	if !strings.Contains(string(data), "Home / Garage") || strings.Count(string(data), "\n") != 5 {
+		t.Errorf("Export() = %s", data)
+	}
+}
+
+func TestServer_Login(t *testing.T) {
+	s := New(Fixtures{Users: []User{{Email: "me@example.com", Password: "secret"}}})
+	srv := s.Start()
+	defer srv.Close()
+	c, err := homeboxclient.NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Login("someone@example.com", "secret"); err == nil {
+		t.Error("Login() of an unknown user succeeded")
+	}
+	if _, err := c.Login("ME@example.com", "secret"); err != nil {
+		t.Errorf("Login() error = %v", err)
+	}
+	self, err := homeboxclient.NewUsersService(c).GetSelf()
+	if err != nil || self.Email != "me@example.com" {
+		t.Errorf("GetSelf() = %+v, %v", self, err)
+	}
+
+	// Without users, anyone can log in.
+	login(t, New(Fixtures{}), "anyone", "anything")
+}
+
+func TestServer_Changes(t *testing.T) {
+	s := New(Fixtures{})
+	c := login(t, s, "me", "secret")
+
+	label, err := homeboxclient.NewLabelsService(c).Create(&homeboxclient.LabelCreate{Name: "Tools"})
+	if err != nil {
+		t.Fatalf("Create() label error = %v", err)
+	}
+	locations := homeboxclient.NewLocationsService(c)
+	home, err := locations.Create(&homeboxclient.LocationCreate{Name: "Home"})
+	if err != nil {
+		t.Fatalf("Create() location error = %v", err)
+	}
+	if _, err := locations.Create(&homeboxclient.LocationCreate{Name: "Shed", ParentID: "missing"}); err == nil {
+		t.Error("Create() below a missing location succeeded")
+	}
+
+	items := homeboxclient.NewItemsService(c)
+	item, err := items.Create(&homeboxclient.ItemCreate{Name: "Drill", LocationID: home.ID, LabelIDs: []string{label.ID}})
+	if err != nil {
+		t.Fatalf("Create() item error = %v", err)
+	}
+	if item.AssetID != "000-001" {
+		t.Errorf("asset ID = %q, want the next one", item.AssetID)
+	}
+	warranty := time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+	if _, err := items.Update(item.ID, &homeboxclient.ItemUpdate{Name: "Drill", Quantity: 2, WarrantyExpires: warranty, LocationID: home.ID}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	maintenance := homeboxclient.NewMaintenanceService(c)
+	for _, e := range []homeboxclient.MaintenanceEntry{{Name: "Oil", ScheduledDate: "2030-01-01"}, {Name: "Clean", CompletedDate: "2024-01-01"}} {
+		if _, err := maintenance.Create(item.ID, &e); err != nil {
+			t.Fatalf("Create() maintenance error = %v", err)
+		}
+	}
+	scheduled, err := maintenance.List(homeboxclient.MaintenanceFilterStatusScheduled)
+	if err != nil || len(scheduled) != 1 || scheduled[0].Name != "Oil" || scheduled[0].ItemName != "Drill" {
+		t.Errorf("List(scheduled) = %+v, %v", scheduled, err)
+	}
+
+	got := s.Fixtures()
+	if len(got.Items) != 1 {
+		t.Fatalf("items = %+v", got.Items)
+	}
+	drill := got.Items[0]
+	if drill.Quantity != 2 || drill.WarrantyExpires != "2030-01-02" || drill.LocationID != home.ID || len(drill.LabelIDs) != 0 || len(drill.Maintenance) != 2 {
+		t.Errorf("drill = %+v", drill)
+	}
+
+	// Changing the copy doesn't change the server.
+	got.Items[0].Name = "Changed"
+	if s.Fixtures().Items[0].Name != "Drill" {
+		t.Error("Fixtures() returned the server's own data")
+	}
+}
+
+// call sends a request with a JSON body, if any, as a client without a
+// method for it would, and decodes the JSON response into v, if any.
+func call(t *testing.T, method, url, token string, body, v any) int {
+	t.Helper()
+	var r io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, url, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if v != nil && resp.StatusCode == http.StatusOK {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatalf("%s %s: %v", method, url, err)
+		}
+	}
+	return resp.StatusCode
+}
+
+func TestServer_Users(t *testing.T) {
+	srv := New(Fixtures{}).Start()
+	defer srv.Close()
+	api := srv.URL + "/api/v1"
+
+	if status := call(t, "POST", api+"/users/register", "", map[string]string{"email": "me@example.com", "name": "Me", "password": "secret"}, nil); status != http.StatusNoContent {
+		t.Fatalf("register status = %d", status)
+	}
+	if status := call(t, "POST", api+"/users/register", "", map[string]string{"email": "ME@example.com", "password": "other"}, nil); status != http.StatusConflict {
+		t.Errorf("registering twice status = %d, want 409", status)
+	}
+
+	c, err := homeboxclient.NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Login("someone@example.com", "any"); err == nil {
+		t.Error("Login() of an unregistered user succeeded after a registration")
+	}
+	token, err := c.Login("me@example.com", "secret")
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	var refreshed homeboxclient.TokenResponse
+	if status := call(t, "GET", api+"/users/refresh", token.Token, nil, &refreshed); status != http.StatusOK || refreshed.Token == "" || refreshed.Token == token.Token {
+		t.Errorf("refresh = %d, %+v, want a new token", status, refreshed)
+	}
+	if status := call(t, "GET", api+"/users/self", token.Token, nil, nil); status != http.StatusUnauthorized {
+		t.Errorf("self with the refreshed token status = %d, want 401", status)
+	}
+
+	users := homeboxclient.NewUsersService(c)
+	c.Login("me@example.com", "secret")
+	if err := users.ChangePassword("wrong", "new"); err == nil {
+		t.Error("ChangePassword() with the wrong password succeeded")
+	}
+	if err := users.ChangePassword("secret", "new"); err != nil {
+		t.Fatalf("ChangePassword() error = %v", err)
+	}
+	if _, err := c.Login("me@example.com", "new"); err != nil {
+		t.Errorf("Login() with the new password error = %v", err)
+	}
+
+	if updated, err := users.UpdateSelf(homeboxclient.UserUpdate{Name: "Renamed"}); err != nil || updated.Name != "Renamed" || updated.Email != "me@example.com" {
+		t.Errorf("UpdateSelf() = %+v, %v", updated, err)
+	}
+	if self, err := users.GetSelf(); err != nil || self.Name != "Renamed" {
+		t.Errorf("GetSelf() = %+v, %v", self, err)
+	}
+	if err := users.DeleteSelf(); err != nil {
+		t.Fatalf("DeleteSelf() error = %v", err)
+	}
+	if _, err := c.Login("me@example.com", "new"); err != nil {
+		t.Errorf("Login() without users left error = %v, want any credentials to log in", err)
+	}
+}
+
+func TestServer_ImportExport(t *testing.T) {
+	items := homeboxclient.NewItemsService(login(t, New(Demo()), "demo@example.com", "demo"))
+	csv, err := items.Export()
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	exported, _ := io.ReadAll(csv)
+	csv.Close()
+
+	// Importing into an empty server creates the items, their locations and
+	// labels; importing again updates them.
+	s := New(Fixtures{})
+	target := homeboxclient.NewItemsService(login(t, s, "me", "secret"))
+	for range 2 {
+		if err := target.Import(bytes.NewReader(exported)); err != nil {
+			t.Fatalf("Import() error = %v", err)
+		}
+	}
+	got := s.Fixtures()
+	if len(got.Items) != 4 || len(got.Locations) != 4 || len(got.Labels) != 3 {
+		t.Fatalf("imported %d items, %d locations, %d labels, want 4, 4, 3", len(got.Items), len(got.Locations), len(got.Labels))
+	}
+
+	csv, err = target.Export()
+	if err != nil {
+		t.Fatalf("Export() of the import error = %v", err)
+	}
+	reexported, _ := io.ReadAll(csv)
+	csv.Close()
+	// Only the IDs, written as the import refs, differ.
+	for _, want := range []string{"Home / Garage", "Kitchen;Electronics", "Cordless Drill", "129.99", "18V"} {
+		if !strings.Contains(string(reexported), want) {
+			t.Errorf("export of the import is missing %q:\n%s", want, reexported)
+		}
+	}
+
+	if err := target.Import(strings.NewReader("HB.name,HB.quantity\nSaw,many\n")); err == nil || !strings.Contains(err.Error(), `row 1: invalid HB.quantity \"many\"`) {
+		t.Errorf("Import() of an invalid quantity error = %v", err)
+	}
+}
+
+func TestServer_Extras(t *testing.T) {
+	s := New(Demo())
+	srv := s.Start()
+	defer srv.Close()
+	c, err := homeboxclient.NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := c.Login("demo@example.com", "demo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := srv.URL + "/api/v1"
+
+	var currency map[string]string
+	if call(t, "GET", api+"/currency", "", nil, &currency); currency["code"] != "USD" || currency["symbol"] != "$" {
+		t.Errorf("currency = %v", currency)
+	}
+
+	invitation, err := homeboxclient.NewGroupsService(c).CreateInvitation(&homeboxclient.GroupInvitationCreate{Uses: 2})
+	if err != nil || invitation.Token == "" || invitation.Uses != 2 || invitation.ExpiresAt == "" {
+		t.Errorf("CreateInvitation() = %+v, %v", invitation, err)
+	}
+	if _, err := homeboxclient.NewGroupsService(c).CreateInvitation(&homeboxclient.GroupInvitationCreate{}); err == nil {
+		t.Error("CreateInvitation() without uses succeeded")
+	}
+
+	var names, values []string
+	call(t, "GET", api+"/items/fields", token.Token, nil, &names)
+	call(t, "GET", api+"/items/fields/values?field=Voltage", token.Token, nil, &values)
+	if fmt.Sprint(names, values) != "[Voltage] [18V]" {
+		t.Errorf("fields = %q, values = %q", names, values)
+	}
+
+	var path []map[string]string
+	call(t, "GET", api+"/items/item-bits/path", token.Token, nil, &path)
+	var steps []string
+	for _, p := range path {
+		steps = append(steps, p["type"]+":"+p["name"])
+	}
+	if got := strings.Join(steps, " > "); got != "location:Home > location:Garage > item:Drill Bit Set" {
+		t.Errorf("path = %s", got)
+	}
+
+	qr, err := c.QRCode("https://homebox.example.com/item/item-drill")
+	if err != nil {
+		t.Fatalf("QRCode() error = %v", err)
+	}
+	if img, _, err := image.Decode(bytes.NewReader(qr)); err != nil || img.Bounds().Dx() != img.Bounds().Dy() {
+		t.Errorf("QRCode() is no square image: %v", err)
+	}
+	if other, _ := c.QRCode("other"); bytes.Equal(qr, other) {
+		t.Error("QRCode() is the same for other data")
+	}
+
+	bom, err := homeboxclient.NewReportingService(c).BillOfMaterials()
+	if err != nil {
+		t.Fatalf("BillOfMaterials() error = %v", err)
+	}
+	data, _ := io.ReadAll(bom)
+	bom.Close()
+	if lines := strings.Split(strings.TrimSpace(string(data)), "\n"); len(lines) != 5 || !strings.HasPrefix(lines[0], "Purchase Date,Name,") || !strings.Contains(lines[1], "Cordless Drill") {
+		t.Errorf("BillOfMaterials() = %s", data)
+	}
+}
+
+func TestServer_Faults(t *testing.T) {
+	s := New(Fixtures{Labels: []homeboxclient.Label{{Name: "Tools"}}})
+	labels := homeboxclient.NewLabelsService(login(t, s, "me", "secret"))
+
+	s.AddFault(Fault{Method: "GET", Path: "/api/v1/labels", Status: 503, Times: 2})
+	s.AddFault(Fault{Method: "POST", Status: 500})
+	for i := 0; i < 2; i++ {
+		if _, err := labels.List(); err == nil || !strings.Contains(err.Error(), "status 503") {
+			t.Errorf("List() %d error = %v, want 503", i+1, err)
+		}
+	}
+	if list, err := labels.List(); err != nil || len(list) != 1 {
+		t.Errorf("List() after the fault's times = %+v, %v", list, err)
+	}
+	if _, err := labels.Create(&homeboxclient.LabelCreate{Name: "Kitchen"}); err == nil || !strings.Contains(err.Error(), "status 500") {
+		t.Errorf("Create() error = %v, want 500", err)
+	}
+
+	s.ClearFaults()
+	if _, err := labels.Create(&homeboxclient.LabelCreate{Name: "Kitchen"}); err != nil {
+		t.Errorf("Create() after ClearFaults() error = %v", err)
+	}
+}
+
+func TestLoadFixtures(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "fixtures.yaml")
+	os.WriteFile(path, []byte(`
+users:
+  - email: me@example.com
+    password: secret
+locations:
+  - id: garage
+    name: Garage
+items:
+  - name: Drill
+    assetId: "000-007"
+    locationId: garage
+    attachments:
+      - title: manual.txt
+        content: Charge first.
+`), 0o644)
+
+	f, err := LoadFixtures(path)
+	if err != nil {
+		t.Fatalf("LoadFixtures() error = %v", err)
+	}
+	got := New(f).Fixtures()
+	if len(got.Items) != 1 || got.Items[0].ID == "" || got.Items[0].AssetID != "000-007" || got.Items[0].LocationID != "garage" {
+		t.Errorf("items = %+v", got.Items)
+	}
+	if a := got.Items[0].Attachments; len(a) != 1 || a[0].Type != homeboxclient.AttachmentTypeAttachment || a[0].Content != "Charge first." {
+		t.Errorf("attachments = %+v", a)
+	}
+
+	bad := filepath.Join(dir, "bad.yaml")
+	os.WriteFile(bad, []byte("itemz: []\n"), 0o644)
+	if _, err := LoadFixtures(bad); err == nil || !strings.Contains(err.Error(), `unknown field "itemz"`) {
+		t.Errorf("LoadFixtures() error = %v, want unknown field", err)
+	}
+}
@@ -0,0 +1,147 @@
+package fakehomebox
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+)
+
+// Fixtures is the inventory a Server starts with. Objects refer to each other
+// by ID; objects without an ID get one.
+type Fixtures struct {
+	Version   string                   `json:"version"` // optional, defaults to homeboxclient.TargetVersion
+	Group     homeboxclient.Group      `json:"group"`
+	Users     []User                   `json:"users"` // optional, without users any credentials log in
+	Labels    []homeboxclient.Label    `json:"labels"`
+	Locations []Location               `json:"locations"`
+	Items     []Item                   `json:"items"`
+	Notifiers []homeboxclient.Notifier `json:"notifiers"`
+}
+
+type User struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+type Location struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ParentID    string `json:"parentId"`
+}
+
+// Item is an item with its attachments and maintenance. The location, parent
+// and labels of the embedded item are ignored in favor of the IDs.
+type Item struct {
+	homeboxclient.Item
+	LocationID  string                           `json:"locationId"`
+	ParentID    string                           `json:"parentId"`
+	LabelIDs    []string                         `json:"labelIds"`
+	Attachments []Attachment                     `json:"attachments"`
+	Maintenance []homeboxclient.MaintenanceEntry `json:"maintenance"`
+}
+
+type Attachment struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Type        string    `json:"type"` // defaults to homeboxclient.AttachmentTypeAttachment
+	Primary     bool      `json:"primary"`
+	ContentType string    `json:"contentType"` // optional, detected from the content
+	Content     string    `json:"content"`
+	CreatedAt   time.Time `json:"createdAt"` // optional, defaults to the item's
+}
+
+// LoadFixtures reads fixtures from a YAML or JSON file.
+func LoadFixtures(path string) (Fixtures, error) {
+	var f Fixtures
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return f, fmt.Errorf("failed to read fixtures: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(data, &f); err != nil {
+		return f, fmt.Errorf("failed to parse fixtures %s: %w", path, err)
+	}
+	return f, nil
+}
+
+// Demo returns a small household inventory to try the commands against, with
+// demo@example.com as the user and "demo" as the password.
+func Demo() Fixtures {
+	return Fixtures{
+		Group: homeboxclient.Group{Name: "Demo Household", Currency: "USD"},
+		Users: []User{{Email: "demo@example.com", Name: "Demo", Password: "demo"}},
+		Labels: []homeboxclient.Label{
+			{ID: "label-electronics", Name: "Electronics", Color: "#3b82f6"},
+			{ID: "label-tools", Name: "Tools", Color: "#f59e0b"},
+			{ID: "label-kitchen", Name: "Kitchen", Color: "#10b981"},
+		},
+		Locations: []Location{
+			{ID: "location-home", Name: "Home", Description: "The house"},
+			{ID: "location-garage", Name: "Garage", ParentID: "location-home"},
+			{ID: "location-kitchen", Name: "Kitchen", ParentID: "location-home"},
+			{ID: "location-office", Name: "Office", ParentID: "location-home"},
+		},
+		Items: []Item{
+			{
+				Item: homeboxclient.Item{
+					ID: "item-drill", Name: "Cordless Drill", AssetID: "000-001", Quantity: 1,
+					Manufacturer: "Makita", ModelNumber: "XFD131", SerialNumber: "MK-2291",
+					PurchasePrice: 129.99, PurchaseFrom: "Hardware Store", PurchaseTime: "2023-04-15",
+					WarrantyExpires: "2026-04-15",
+					Fields:          []homeboxclient.ItemField{{Name: "Voltage", Type: "text", TextValue: "18V"}},
+				},
+				LocationID: "location-garage",
+				LabelIDs:   []string{"label-tools"},
+				Attachments: []Attachment{
+					{Title: "drill.svg", Type: homeboxclient.AttachmentTypePhoto, Primary: true, Content: demoPhoto("#f59e0b", "Drill")},
+					{Title: "manual.txt", Type: homeboxclient.AttachmentTypeManual, Content: "Charge the battery fully before first use.\n"},
+				},
+				Maintenance: []homeboxclient.MaintenanceEntry{
+					{Name: "Replace carbon brushes", Cost: "12", ScheduledDate: "2026-11-01"},
+					{Name: "Clean chuck", CompletedDate: "2025-03-02"},
+				},
+			},
+			{
+				Item:       homeboxclient.Item{ID: "item-bits", Name: "Drill Bit Set", AssetID: "000-002", Quantity: 1, PurchasePrice: 24.5, PurchaseTime: "2023-04-15"},
+				LocationID: "location-garage",
+				ParentID:   "item-drill",
+				LabelIDs:   []string{"label-tools"},
+			},
+			{
+				Item: homeboxclient.Item{
+					ID: "item-laptop", Name: "Laptop", AssetID: "000-003", Quantity: 1, Insured: true,
+					Manufacturer: "Lenovo", ModelNumber: "ThinkPad X1", SerialNumber: "PF-3X9QZ",
+					PurchasePrice: 1499, PurchaseFrom: "Online Shop", PurchaseTime: "2024-09-01",
+					WarrantyExpires: "2027-09-01", Notes: "Work laptop",
+				},
+				LocationID: "location-office",
+				LabelIDs:   []string{"label-electronics"},
+				Attachments: []Attachment{
+					{Title: "laptop.svg", Type: homeboxclient.AttachmentTypePhoto, Primary: true, Content: demoPhoto("#3b82f6", "Laptop")},
+					{Title: "receipt.txt", Type: homeboxclient.AttachmentTypeReceipt, Content: "Laptop 1499.00 USD\n"},
+				},
+			},
+			{
+				Item: homeboxclient.Item{
+					ID: "item-mixer", Name: "Stand Mixer", AssetID: "000-004", Quantity: 1,
+					Manufacturer: "KitchenAid", PurchasePrice: 349, PurchaseTime: "2022-12-20",
+					LifetimeWarranty: true,
+				},
+				LocationID:  "location-kitchen",
+				LabelIDs:    []string{"label-kitchen", "label-electronics"},
+				Maintenance: []homeboxclient.MaintenanceEntry{{Name: "Grease gearbox", ScheduledDate: "2027-01-15"}},
+			},
+		},
+	}
+}
+
+// demoPhoto is a placeholder photo, so that the demo has pictures without
+// shipping any.
+func demoPhoto(color, text string) string {
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="320" height="240"><rect width="320" height="240" fill="%s"/><text x="160" y="130" font-size="32" text-anchor="middle" fill="#fff">%s</text></svg>`, color, text)
+}
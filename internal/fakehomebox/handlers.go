@@ -0,0 +1,1473 @@
+package fakehomebox
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+	"maps"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/csvexport"
+)
+
+// apiError is an error with the status to respond with.
+type apiError struct {
+	status int
+	msg    string
+}
+
+func (e *apiError) Error() string { return e.msg }
+
+func errorf(status int, format string, args ...any) error {
+	return &apiError{status: status, msg: fmt.Sprintf(format, args...)}
+}
+
+func notFound(what, id string) error {
+	return errorf(http.StatusNotFound, "%s %s not found", what, id)
+}
+
+// file is a response that isn't JSON.
+type file struct {
+	contentType string
+	content     []byte
+}
+
+// handle registers a handler that runs with the server locked. Unless public,
+// it requires the token of a login. A nil response is sent as 204 No Content.
+func (s *Server) handle(pattern string, public bool, h func(r *http.Request) (any, error)) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.tokens[r.Header.Get("Authorization")]; !ok && !public {
+			writeError(w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		resp, err := h(r)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if e, ok := err.(*apiError); ok {
+				status = e.status
+			}
+			writeError(w, status, err.Error())
+			return
+		}
+		switch resp := resp.(type) {
+		case nil:
+			w.WriteHeader(http.StatusNoContent)
+		case file:
+			w.Header().Set("Content-Type", resp.contentType)
+			w.Write(resp.content)
+		default:
+			writeJSON(w, http.StatusOK, resp)
+		}
+	})
+}
+
+func decodeBody(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return errorf(http.StatusBadRequest, "invalid request body: %v", err)
+	}
+	return nil
+}
+
+func (s *Server) routes() {
+	s.handle("GET /api/v1/status", true, s.status)
+	s.handle("GET /api/v1/currency", true, s.currency)
+	s.handle("POST /api/v1/users/register", true, s.register)
+	s.handle("POST /api/v1/users/login", true, s.login)
+	s.handle("POST /api/v1/users/logout", false, s.logout)
+	s.handle("GET /api/v1/users/refresh", false, s.refresh)
+	s.handle("GET /api/v1/users/self", false, s.self)
+	s.handle("PUT /api/v1/users/self", false, s.updateSelf)
+	s.handle("DELETE /api/v1/users/self", false, s.deleteSelf)
+	s.handle("PUT /api/v1/users/change-password", false, s.changePassword)
+
+	s.handle("GET /api/v1/groups", false, s.getGroup)
+	s.handle("PUT /api/v1/groups", false, s.updateGroup)
+	s.handle("POST /api/v1/groups/invitations", false, s.createInvitation)
+	s.handle("GET /api/v1/groups/statistics", false, s.statistics)
+	s.handle("GET /api/v1/groups/statistics/labels", false, s.labelStatistics)
+	s.handle("GET /api/v1/groups/statistics/locations", false, s.locationStatistics)
+	s.handle("GET /api/v1/groups/statistics/purchase-price", false, s.purchasePriceStatistics)
+
+	s.handle("GET /api/v1/labels", false, s.listLabels)
+	s.handle("POST /api/v1/labels", false, s.createLabel)
+	s.handle("GET /api/v1/labels/{id}", false, s.getLabel)
+	s.handle("PUT /api/v1/labels/{id}", false, s.updateLabel)
+	s.handle("DELETE /api/v1/labels/{id}", false, s.deleteLabel)
+
+	s.handle("GET /api/v1/locations", false, s.listLocations)
+	s.handle("GET /api/v1/locations/tree", false, s.locationTree)
+	s.handle("POST /api/v1/locations", false, s.createLocation)
+	s.handle("GET /api/v1/locations/{id}", false, s.getLocation)
+	s.handle("PUT /api/v1/locations/{id}", false, s.updateLocation)
+	s.handle("DELETE /api/v1/locations/{id}", false, s.deleteLocation)
+
+	s.handle("GET /api/v1/items", false, s.listItems)
+	s.handle("POST /api/v1/items", false, s.createItem)
+	s.handle("GET /api/v1/items/export", false, s.exportItems)
+	s.handle("POST /api/v1/items/import", false, s.importItems)
+	s.handle("GET /api/v1/items/fields", false, s.fieldNames)
+	s.handle("GET /api/v1/items/fields/values", false, s.fieldValues)
+	s.handle("GET /api/v1/items/{id}", false, s.getItem)
+	s.handle("GET /api/v1/items/{id}/path", false, s.itemPath)
+	s.handle("PUT /api/v1/items/{id}", false, s.updateItem)
+	s.handle("DELETE /api/v1/items/{id}", false, s.deleteItem)
+	s.handle("GET /api/v1/assets/{id}", false, s.itemsByAssetID)
+	s.handle("GET /api/v1/qrcode", false, s.qrCode)
+	s.handle("GET /api/v1/reporting/bill-of-materials", false, s.billOfMaterials)
+
+	s.handle("POST /api/v1/items/{id}/attachments", false, s.uploadAttachment)
+	s.handle("GET /api/v1/items/{id}/attachments/{attachment}", false, s.downloadAttachment)
+	s.handle("PUT /api/v1/items/{id}/attachments/{attachment}", false, s.updateAttachment)
+	s.handle("DELETE /api/v1/items/{id}/attachments/{attachment}", false, s.deleteAttachment)
+
+	s.handle("GET /api/v1/items/{id}/maintenance", false, s.itemMaintenance)
+	s.handle("POST /api/v1/items/{id}/maintenance", false, s.createMaintenance)
+	s.handle("GET /api/v1/maintenance", false, s.listMaintenance)
+	s.handle("PUT /api/v1/maintenance/{id}", false, s.updateMaintenance)
+	s.handle("DELETE /api/v1/maintenance/{id}", false, s.deleteMaintenance)
+
+	s.handle("GET /api/v1/notifiers", false, s.listNotifiers)
+	s.handle("POST /api/v1/notifiers", false, s.createNotifier)
+	s.handle("PUT /api/v1/notifiers/{id}", false, s.updateNotifier)
+	s.handle("DELETE /api/v1/notifiers/{id}", false, s.deleteNotifier)
+	s.handle("POST /api/v1/notifiers/test", false, func(r *http.Request) (any, error) { return nil, nil })
+
+	s.handle("POST /api/v1/actions/ensure-asset-ids", false, s.ensureAssetIDs)
+	s.handle("POST /api/v1/actions/ensure-import-refs", false, s.ensureImportRefs)
+	s.handle("POST /api/v1/actions/set-primary-photos", false, s.setPrimaryPhotos)
+	s.handle("POST /api/v1/actions/zero-item-time-fields", false, s.zeroItemTimeFields)
+
+	s.handle("/", true, func(r *http.Request) (any, error) {
+		return nil, errorf(http.StatusNotFound, "%s %s is not implemented by the fake server", r.Method, r.URL.Path)
+	})
+}
+
+// Users and groups
+
+func (s *Server) status(r *http.Request) (any, error) {
+	return homeboxclient.APISummary{
+		Title:    "Homebox",
+		Message:  "Track, Manage, and Organize your Things.",
+		Health:   true,
+		Demo:     true,
+		Versions: []string{"v1"},
+		Build:    homeboxclient.Build{Version: s.data.Version, Commit: "fakehomebox"},
+	}, nil
+}
+
+func (s *Server) login(r *http.Request) (any, error) {
+	var form homeboxclient.LoginForm
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		if err := decodeBody(r, &form); err != nil {
+			return nil, err
+		}
+	} else {
+		form.Username, form.Password = r.FormValue("username"), r.FormValue("password")
+	}
+
+	user := User{Email: form.Username, Name: form.Username}
+	if len(s.data.Users) > 0 {
+		i := slices.IndexFunc(s.data.Users, func(u User) bool {
+			return strings.EqualFold(u.Email, form.Username) && u.Password == form.Password
+		})
+		if i < 0 {
+			return nil, errorf(http.StatusUnauthorized, "authentication failed")
+		}
+		user = s.data.Users[i]
+	}
+	return s.newToken(user), nil
+}
+
+// newToken logs user in with a new token.
+func (s *Server) newToken(user User) homeboxclient.TokenResponse {
+	token := "Bearer " + s.id()
+	s.tokens[token] = user
+	return homeboxclient.TokenResponse{
+		Token:           token,
+		AttachmentToken: strings.TrimPrefix(token, "Bearer "),
+		ExpiresAt:       time.Now().Add(7 * 24 * time.Hour).UTC().Format(time.RFC3339),
+	}
+}
+
+func (s *Server) logout(r *http.Request) (any, error) {
+	delete(s.tokens, r.Header.Get("Authorization"))
+	return nil, nil
+}
+
+// refresh replaces the token of a login with a new one.
+func (s *Server) refresh(r *http.Request) (any, error) {
+	old := r.Header.Get("Authorization")
+	user := s.tokens[old]
+	delete(s.tokens, old)
+	return s.newToken(user), nil
+}
+
+// registration is the body of a registration, which the client doesn't send.
+type registration struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
+
+// register adds a user. Once there is a user, only users can log in.
+func (s *Server) register(r *http.Request) (any, error) {
+	var reg registration
+	if err := decodeBody(r, &reg); err != nil {
+		return nil, err
+	}
+	if reg.Email == "" || reg.Password == "" {
+		return nil, errorf(http.StatusUnprocessableEntity, "email and password are required")
+	}
+	if s.user(reg.Email) != nil {
+		return nil, errorf(http.StatusConflict, "user %s already exists", reg.Email)
+	}
+	s.data.Users = append(s.data.Users, User{Email: reg.Email, Name: reg.Name, Password: reg.Password})
+	return nil, nil
+}
+
+// user returns the registered user with an email address.
+func (s *Server) user(email string) *User {
+	i := slices.IndexFunc(s.data.Users, func(u User) bool { return strings.EqualFold(u.Email, email) })
+	if i < 0 {
+		return nil
+	}
+	return &s.data.Users[i]
+}
+
+func (s *Server) self(r *http.Request) (any, error) {
+	user := s.tokens[r.Header.Get("Authorization")]
+	return map[string]homeboxclient.UserOut{"item": {
+		ID:        user.Email,
+		Email:     user.Email,
+		Name:      user.Name,
+		GroupID:   s.data.Group.ID,
+		GroupName: s.data.Group.Name,
+		IsOwner:   true,
+	}}, nil
+}
+
+// updateSelf changes the name and email address of the logged in user.
+func (s *Server) updateSelf(r *http.Request) (any, error) {
+	var update homeboxclient.UserUpdate
+	if err := decodeBody(r, &update); err != nil {
+		return nil, err
+	}
+	token := r.Header.Get("Authorization")
+	user := s.tokens[token]
+	if u := s.user(user.Email); u != nil {
+		if update.Name != "" {
+			u.Name = update.Name
+		}
+		if update.Email != "" {
+			u.Email = update.Email
+		}
+		user = *u
+	} else {
+		if update.Name != "" {
+			user.Name = update.Name
+		}
+		if update.Email != "" {
+			user.Email = update.Email
+		}
+	}
+	s.tokens[token] = user
+	return map[string]homeboxclient.UserUpdate{"item": {Name: user.Name, Email: user.Email}}, nil
+}
+
+// deleteSelf removes the logged in user and ends all of their logins.
+func (s *Server) deleteSelf(r *http.Request) (any, error) {
+	email := s.tokens[r.Header.Get("Authorization")].Email
+	s.data.Users = slices.DeleteFunc(s.data.Users, func(u User) bool { return strings.EqualFold(u.Email, email) })
+	for token, user := range s.tokens {
+		if strings.EqualFold(user.Email, email) {
+			delete(s.tokens, token)
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) changePassword(r *http.Request) (any, error) {
+	var change homeboxclient.ChangePassword
+	if err := decodeBody(r, &change); err != nil {
+		return nil, err
+	}
+	if change.New == "" {
+		return nil, errorf(http.StatusUnprocessableEntity, "new password is required")
+	}
+	// Without registered users, any password logs in, so there is nothing
+	// to change.
+	u := s.user(s.tokens[r.Header.Get("Authorization")].Email)
+	if u == nil {
+		return nil, nil
+	}
+	if u.Password != change.Current {
+		return nil, errorf(http.StatusForbidden, "current password is wrong")
+	}
+	u.Password = change.New
+	return nil, nil
+}
+
+// currency is the server's currency, which the client doesn't ask for.
+type currency struct {
+	Code   string `json:"code"`
+	Local  string `json:"local"`
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+}
+
+// currencies are the details of some common currencies; others are returned
+// with only their code.
+var currencies = map[string]currency{
+	"USD": {Code: "USD", Local: "United States", Name: "United States Dollar", Symbol: "$"},
+	"EUR": {Code: "EUR", Local: "Eurozone", Name: "Euro", Symbol: "€"},
+	"GBP": {Code: "GBP", Local: "United Kingdom", Name: "British Pound", Symbol: "£"},
+}
+
+// currency returns the group's currency, like a server configured with it.
+func (s *Server) currency(r *http.Request) (any, error) {
+	code := strings.ToUpper(s.data.Group.Currency)
+	if code == "" {
+		code = "USD"
+	}
+	if c, ok := currencies[code]; ok {
+		return c, nil
+	}
+	return currency{Code: code, Name: code, Symbol: code}, nil
+}
+
+func (s *Server) getGroup(r *http.Request) (any, error) {
+	return s.data.Group, nil
+}
+
+func (s *Server) updateGroup(r *http.Request) (any, error) {
+	var update homeboxclient.GroupUpdate
+	if err := decodeBody(r, &update); err != nil {
+		return nil, err
+	}
+	if update.Name != "" {
+		s.data.Group.Name = update.Name
+	}
+	if update.Currency != "" {
+		s.data.Group.Currency = update.Currency
+	}
+	return s.data.Group, nil
+}
+
+func (s *Server) createInvitation(r *http.Request) (any, error) {
+	var create homeboxclient.GroupInvitationCreate
+	if err := decodeBody(r, &create); err != nil {
+		return nil, err
+	}
+	if create.Uses < 1 || create.Uses > 100 {
+		return nil, errorf(http.StatusUnprocessableEntity, "uses must be between 1 and 100")
+	}
+	expires := create.ExpiresAt
+	if expires == "" {
+		expires = time.Now().Add(7 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	}
+	return homeboxclient.GroupInvitation{Token: s.id(), ExpiresAt: expires, Uses: create.Uses}, nil
+}
+
+func (s *Server) statistics(r *http.Request) (any, error) {
+	stats := homeboxclient.GroupStatistics{
+		TotalLabels:    len(s.data.Labels),
+		TotalLocations: len(s.data.Locations),
+		TotalUsers:     max(len(s.data.Users), 1),
+	}
+	today := time.Now().Format(time.DateOnly)
+	for _, item := range s.data.Items {
+		if item.Archived {
+			continue
+		}
+		stats.TotalItems++
+		stats.TotalItemPrice += item.PurchasePrice
+		if item.LifetimeWarranty || csvexport.FormatDate(item.WarrantyExpires) > today {
+			stats.TotalWithWarranty++
+		}
+	}
+	return stats, nil
+}
+
+func (s *Server) labelStatistics(r *http.Request) (any, error) {
+	totals := []homeboxclient.TotalsByOrganizer{}
+	for _, l := range s.data.Labels {
+		t := homeboxclient.TotalsByOrganizer{ID: l.ID, Name: l.Name}
+		for _, item := range s.data.Items {
+			if slices.Contains(item.LabelIDs, l.ID) {
+				t.Total += item.PurchasePrice
+			}
+		}
+		totals = append(totals, t)
+	}
+	return totals, nil
+}
+
+func (s *Server) locationStatistics(r *http.Request) (any, error) {
+	totals := []homeboxclient.TotalsByOrganizer{}
+	for _, l := range s.data.Locations {
+		totals = append(totals, homeboxclient.TotalsByOrganizer{ID: l.ID, Name: l.Name, Total: s.locationTotal(l.ID)})
+	}
+	return totals, nil
+}
+
+func (s *Server) purchasePriceStatistics(r *http.Request) (any, error) {
+	end := time.Now().UTC().Truncate(24 * time.Hour)
+	start := end.AddDate(-1, 0, 0)
+	for param, t := range map[string]*time.Time{"start": &start, "end": &end} {
+		if v := r.URL.Query().Get(param); v != "" {
+			parsed, err := time.Parse(time.DateOnly, v)
+			if err != nil {
+				return nil, errorf(http.StatusBadRequest, "invalid %s %q", param, v)
+			}
+			*t = parsed
+		}
+	}
+
+	value := homeboxclient.ValueOverTime{
+		Start:   start.Format(time.RFC3339),
+		End:     end.Format(time.RFC3339),
+		Entries: []homeboxclient.ValueOverTimeEntry{},
+	}
+	from, to := start.Format(time.DateOnly), end.Format(time.DateOnly)
+	for _, item := range s.data.Items {
+		bought := csvexport.FormatDate(item.PurchaseTime)
+		switch {
+		case bought == "" || bought > to:
+			continue
+		case bought <= from:
+			value.ValueAtStart += item.PurchasePrice
+		default:
+			value.Entries = append(value.Entries, homeboxclient.ValueOverTimeEntry{Date: bought, Name: item.Name, Value: item.PurchasePrice})
+		}
+		value.ValueAtEnd += item.PurchasePrice
+	}
+	slices.SortFunc(value.Entries, func(a, b homeboxclient.ValueOverTimeEntry) int { return strings.Compare(a.Date, b.Date) })
+	return value, nil
+}
+
+// Labels
+
+func (s *Server) label(id string) *homeboxclient.Label {
+	i := slices.IndexFunc(s.data.Labels, func(l homeboxclient.Label) bool { return l.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return &s.data.Labels[i]
+}
+
+func (s *Server) listLabels(r *http.Request) (any, error) {
+	return append([]homeboxclient.Label{}, s.data.Labels...), nil
+}
+
+func (s *Server) createLabel(r *http.Request) (any, error) {
+	var create homeboxclient.LabelCreate
+	if err := decodeBody(r, &create); err != nil {
+		return nil, err
+	}
+	if create.Name == "" {
+		return nil, errorf(http.StatusUnprocessableEntity, "name is required")
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	label := homeboxclient.Label{ID: s.id(), Name: create.Name, Description: create.Description, Color: create.Color, CreatedAt: now, UpdatedAt: now}
+	s.data.Labels = append(s.data.Labels, label)
+	return label, nil
+}
+
+func (s *Server) getLabel(r *http.Request) (any, error) {
+	label := s.label(r.PathValue("id"))
+	if label == nil {
+		return nil, notFound("label", r.PathValue("id"))
+	}
+	return label, nil
+}
+
+func (s *Server) updateLabel(r *http.Request) (any, error) {
+	label := s.label(r.PathValue("id"))
+	if label == nil {
+		return nil, notFound("label", r.PathValue("id"))
+	}
+	var update homeboxclient.Label
+	if err := decodeBody(r, &update); err != nil {
+		return nil, err
+	}
+	label.Name, label.Description, label.Color = update.Name, update.Description, update.Color
+	label.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	return label, nil
+}
+
+func (s *Server) deleteLabel(r *http.Request) (any, error) {
+	id := r.PathValue("id")
+	if s.label(id) == nil {
+		return nil, notFound("label", id)
+	}
+	s.data.Labels = slices.DeleteFunc(s.data.Labels, func(l homeboxclient.Label) bool { return l.ID == id })
+	for i := range s.data.Items {
+		s.data.Items[i].LabelIDs = slices.DeleteFunc(s.data.Items[i].LabelIDs, func(l string) bool { return l == id })
+	}
+	return nil, nil
+}
+
+// Locations
+
+func (s *Server) location(id string) *Location {
+	i := slices.IndexFunc(s.data.Locations, func(l Location) bool { return l.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return &s.data.Locations[i]
+}
+
+func (s *Server) locationOut(id string) *homeboxclient.Location {
+	l := s.location(id)
+	if l == nil {
+		return nil
+	}
+	return &homeboxclient.Location{ID: l.ID, Name: l.Name, Description: l.Description}
+}
+
+// locationTotal is the purchase price of the items directly in a location.
+func (s *Server) locationTotal(id string) float64 {
+	var total float64
+	for _, item := range s.data.Items {
+		if item.LocationID == id {
+			total += item.PurchasePrice
+		}
+	}
+	return total
+}
+
+// tree returns the locations below parentID with their children.
+func (s *Server) tree(parentID string) []homeboxclient.Location {
+	nodes := []homeboxclient.Location{}
+	for _, l := range s.data.Locations {
+		if l.ParentID == parentID {
+			nodes = append(nodes, homeboxclient.Location{ID: l.ID, Name: l.Name, Children: s.tree(l.ID)})
+		}
+	}
+	return nodes
+}
+
+// isBelow reports whether location id is ancestorID or below it.
+func (s *Server) isBelow(id, ancestorID string) bool {
+	for l := s.location(id); l != nil; l = s.location(l.ParentID) {
+		if l.ID == ancestorID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) listLocations(r *http.Request) (any, error) {
+	roots := r.URL.Query().Get("filterChildren") == "true"
+	list := []homeboxclient.Location{}
+	for _, l := range s.data.Locations {
+		if !roots || l.ParentID == "" {
+			list = append(list, *s.locationOut(l.ID))
+		}
+	}
+	return list, nil
+}
+
+func (s *Server) locationTree(r *http.Request) (any, error) {
+	return s.tree(""), nil
+}
+
+func (s *Server) createLocation(r *http.Request) (any, error) {
+	var create homeboxclient.LocationCreate
+	if err := decodeBody(r, &create); err != nil {
+		return nil, err
+	}
+	if create.Name == "" {
+		return nil, errorf(http.StatusUnprocessableEntity, "name is required")
+	}
+	if create.ParentID != "" && s.location(create.ParentID) == nil {
+		return nil, notFound("location", create.ParentID)
+	}
+	l := Location{ID: s.id(), Name: create.Name, Description: create.Description, ParentID: create.ParentID}
+	s.data.Locations = append(s.data.Locations, l)
+	return s.locationOut(l.ID), nil
+}
+
+func (s *Server) getLocation(r *http.Request) (any, error) {
+	l := s.location(r.PathValue("id"))
+	if l == nil {
+		return nil, notFound("location", r.PathValue("id"))
+	}
+	out := s.locationOut(l.ID)
+	out.Parent = s.locationOut(l.ParentID)
+	out.TotalPrice = s.locationTotal(l.ID)
+	for _, child := range s.data.Locations {
+		if child.ParentID == l.ID {
+			out.Children = append(out.Children, *s.locationOut(child.ID))
+		}
+	}
+	return out, nil
+}
+
+func (s *Server) updateLocation(r *http.Request) (any, error) {
+	l := s.location(r.PathValue("id"))
+	if l == nil {
+		return nil, notFound("location", r.PathValue("id"))
+	}
+	var update homeboxclient.LocationUpdate
+	if err := decodeBody(r, &update); err != nil {
+		return nil, err
+	}
+	if update.ParentID != "" {
+		if s.location(update.ParentID) == nil {
+			return nil, notFound("location", update.ParentID)
+		}
+		if s.isBelow(update.ParentID, l.ID) {
+			return nil, errorf(http.StatusBadRequest, "location can't be moved below itself")
+		}
+		l.ParentID = update.ParentID
+	}
+	if update.Name != "" {
+		l.Name = update.Name
+	}
+	if update.Description != "" {
+		l.Description = update.Description
+	}
+	return s.locationOut(l.ID), nil
+}
+
+// deleteLocation deletes a location, moving the locations below it up and
+// leaving its items without a location.
+func (s *Server) deleteLocation(r *http.Request) (any, error) {
+	l := s.location(r.PathValue("id"))
+	if l == nil {
+		return nil, notFound("location", r.PathValue("id"))
+	}
+	id, parentID := l.ID, l.ParentID
+	s.data.Locations = slices.DeleteFunc(s.data.Locations, func(l Location) bool { return l.ID == id })
+	for i := range s.data.Locations {
+		if s.data.Locations[i].ParentID == id {
+			s.data.Locations[i].ParentID = parentID
+		}
+	}
+	for i := range s.data.Items {
+		if s.data.Items[i].LocationID == id {
+			s.data.Items[i].LocationID = ""
+		}
+	}
+	return nil, nil
+}
+
+// Items
+
+func (s *Server) item(id string) *Item {
+	i := slices.IndexFunc(s.data.Items, func(item Item) bool { return item.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return &s.data.Items[i]
+}
+
+// itemOut returns an item as the API does: the details and attachments only
+// if full, and a summary otherwise.
+func (s *Server) itemOut(item *Item, full bool) homeboxclient.Item {
+	out := item.Item
+	out.Location = s.locationOut(item.LocationID)
+	out.Parent, out.Attachments, out.ImageID = nil, nil, ""
+	out.Labels = []homeboxclient.Label{}
+	for _, id := range item.LabelIDs {
+		if l := s.label(id); l != nil {
+			out.Labels = append(out.Labels, *l)
+		}
+	}
+	for _, a := range item.Attachments {
+		if a.Primary && a.Type == homeboxclient.AttachmentTypePhoto {
+			out.ImageID = a.ID
+		}
+	}
+	if !full {
+		out.Fields = nil
+		return out
+	}
+
+	if parent := s.item(item.ParentID); parent != nil {
+		out.Parent = &homeboxclient.Item{ID: parent.ID, Name: parent.Name}
+	}
+	out.Attachments = []homeboxclient.Attachment{}
+	for _, a := range item.Attachments {
+		out.Attachments = append(out.Attachments, homeboxclient.Attachment{
+			ID:        a.ID,
+			Type:      a.Type,
+			Primary:   a.Primary,
+			CreatedAt: a.CreatedAt,
+			UpdatedAt: a.CreatedAt,
+			Document:  homeboxclient.DocumentOut{ID: a.ID, Title: a.Title, Path: a.Title},
+		})
+	}
+	if out.Fields == nil {
+		out.Fields = []homeboxclient.ItemField{}
+	}
+	return out
+}
+
+// assetNumber returns the number of an asset ID such as "000-042", or 0 for
+// none.
+func assetNumber(id string) int {
+	n, _ := strconv.Atoi(strings.ReplaceAll(id, "-", ""))
+	return n
+}
+
+func formatAssetID(n int) string {
+	return fmt.Sprintf("%03d-%03d", n/1000, n%1000)
+}
+
+func (s *Server) nextAssetID() string {
+	highest := 0
+	for _, item := range s.data.Items {
+		highest = max(highest, assetNumber(item.AssetID))
+	}
+	return formatAssetID(highest + 1)
+}
+
+// listItems pages through the items. Like Homebox, it leaves out archived
+// items unless asked for them, and returns all items without a page size.
+func (s *Server) listItems(r *http.Request) (any, error) {
+	query := r.URL.Query()
+	page, _ := strconv.Atoi(query.Get("page"))
+	pageSize, _ := strconv.Atoi(query.Get("pageSize"))
+	search := strings.ToLower(query.Get("q"))
+
+	matches := []homeboxclient.Item{}
+	for i := range s.data.Items {
+		item := &s.data.Items[i]
+		switch {
+		case item.Archived && query.Get("includeArchived") != "true":
+		case search != "" && !strings.Contains(strings.ToLower(item.Name+" "+item.Description), search):
+		case query.Has("labels") && !slices.ContainsFunc(query["labels"], func(id string) bool { return slices.Contains(item.LabelIDs, id) }):
+		case query.Has("locations") && !slices.Contains(query["locations"], item.LocationID):
+		case query.Has("parentIds") && !slices.Contains(query["parentIds"], item.ParentID):
+		default:
+			matches = append(matches, s.itemOut(item, false))
+		}
+	}
+
+	result := homeboxclient.PaginationResult[homeboxclient.Item]{Items: matches, Page: page, PageSize: pageSize, Total: len(matches)}
+	if page > 0 && pageSize > 0 {
+		start := min((page-1)*pageSize, len(matches))
+		result.Items = matches[start:min(start+pageSize, len(matches))]
+	}
+	return result, nil
+}
+
+func (s *Server) createItem(r *http.Request) (any, error) {
+	var create homeboxclient.ItemCreate
+	if err := decodeBody(r, &create); err != nil {
+		return nil, err
+	}
+	if create.Name == "" {
+		return nil, errorf(http.StatusUnprocessableEntity, "name is required")
+	}
+	if err := s.checkRefs(create.LocationID, create.ParentID, create.LabelIDs); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	item := Item{
+		Item: homeboxclient.Item{
+			ID:          s.id(),
+			Name:        create.Name,
+			Description: create.Description,
+			Quantity:    1,
+			AssetID:     s.nextAssetID(),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		LocationID: create.LocationID,
+		ParentID:   create.ParentID,
+		LabelIDs:   create.LabelIDs,
+	}
+	s.data.Items = append(s.data.Items, item)
+	return s.itemOut(&item, true), nil
+}
+
+// checkRefs checks that the location, parent item and labels of an item
+// exist.
+func (s *Server) checkRefs(locationID, parentID string, labelIDs []string) error {
+	if locationID != "" && s.location(locationID) == nil {
+		return notFound("location", locationID)
+	}
+	if parentID != "" && s.item(parentID) == nil {
+		return notFound("item", parentID)
+	}
+	for _, id := range labelIDs {
+		if s.label(id) == nil {
+			return notFound("label", id)
+		}
+	}
+	return nil
+}
+
+func (s *Server) getItem(r *http.Request) (any, error) {
+	item := s.item(r.PathValue("id"))
+	if item == nil {
+		return nil, notFound("item", r.PathValue("id"))
+	}
+	return s.itemOut(item, true), nil
+}
+
+// formatDate stores a date of an update the way fixtures hold dates.
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+func (s *Server) updateItem(r *http.Request) (any, error) {
+	item := s.item(r.PathValue("id"))
+	if item == nil {
+		return nil, notFound("item", r.PathValue("id"))
+	}
+	var u homeboxclient.ItemUpdate
+	if err := decodeBody(r, &u); err != nil {
+		return nil, err
+	}
+	if u.Name == "" {
+		return nil, errorf(http.StatusUnprocessableEntity, "name is required")
+	}
+	if err := s.checkRefs(u.LocationID, u.ParentID, u.LabelIDs); err != nil {
+		return nil, err
+	}
+	if u.ParentID == item.ID {
+		return nil, errorf(http.StatusBadRequest, "item can't be its own parent")
+	}
+
+	for i := range u.Fields {
+		u.Fields[i].ID = s.idOr(u.Fields[i].ID)
+	}
+	i := &item.Item
+	i.Name, i.Description, i.Quantity, i.Notes = u.Name, u.Description, u.Quantity, u.Notes
+	i.AssetID, i.Archived, i.Insured, i.Fields = u.AssetID, u.Archived, u.Insured, u.Fields
+	i.Manufacturer, i.ModelNumber, i.SerialNumber = u.Manufacturer, u.ModelNumber, u.SerialNumber
+	i.PurchaseFrom, i.PurchasePrice, i.PurchaseTime = u.PurchaseFrom, u.PurchasePrice, formatDate(u.PurchaseTime)
+	i.LifetimeWarranty, i.WarrantyDetails, i.WarrantyExpires = u.LifetimeWarranty, u.WarrantyDetails, formatDate(u.WarrantyExpires)
+	i.SoldTo, i.SoldPrice, i.SoldTime, i.SoldNotes = u.SoldTo, u.SoldPrice, formatDate(u.SoldTime), u.SoldNotes
+	i.UpdatedAt = time.Now().UTC()
+	item.LocationID, item.ParentID, item.LabelIDs = u.LocationID, u.ParentID, u.LabelIDs
+	return s.itemOut(item, true), nil
+}
+
+// deleteItem deletes an item, leaving the items belonging to it without a
+// parent.
+func (s *Server) deleteItem(r *http.Request) (any, error) {
+	id := r.PathValue("id")
+	if s.item(id) == nil {
+		return nil, notFound("item", id)
+	}
+	s.data.Items = slices.DeleteFunc(s.data.Items, func(item Item) bool { return item.ID == id })
+	for i := range s.data.Items {
+		if s.data.Items[i].ParentID == id {
+			s.data.Items[i].ParentID = ""
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) itemsByAssetID(r *http.Request) (any, error) {
+	n := assetNumber(r.PathValue("id"))
+	found := []homeboxclient.Item{}
+	for i := range s.data.Items {
+		if n > 0 && assetNumber(s.data.Items[i].AssetID) == n {
+			found = append(found, s.itemOut(&s.data.Items[i], false))
+		}
+	}
+	return homeboxclient.PaginationResult[homeboxclient.Item]{Items: found, Page: 1, PageSize: len(found), Total: len(found)}, nil
+}
+
+func (s *Server) exportItems(r *http.Request) (any, error) {
+	items := make([]homeboxclient.Item, 0, len(s.data.Items))
+	for i := range s.data.Items {
+		items = append(items, s.itemOut(&s.data.Items[i], true))
+	}
+	var buf bytes.Buffer
+	if err := csvexport.Write(&buf, items, csvexport.NewLocationPaths(s.tree(""))); err != nil {
+		return nil, err
+	}
+	return file{contentType: "text/csv", content: buf.Bytes()}, nil
+}
+
+// importItems creates and updates items from a CSV file in the format Export
+// writes. A row updates the item imported with the same HB.import_ref before,
+// or the item with it as its ID, as Export writes the ID as the import ref;
+// other rows create items. Locations, given as paths such as "Home / Garage",
+// and labels are created as needed.
+func (s *Server) importItems(r *http.Request) (any, error) {
+	f, _, err := r.FormFile("csv")
+	if err != nil {
+		return nil, errorf(http.StatusBadRequest, "csv is required: %v", err)
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, errorf(http.StatusBadRequest, "invalid CSV: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, errorf(http.StatusBadRequest, "the CSV has no header")
+	}
+
+	header := records[0]
+	for n, record := range records[1:] {
+		row := make(map[string]string, len(header))
+		for i, name := range header {
+			row[name] = record[i]
+		}
+		if err := s.importRow(row); err != nil {
+			return nil, errorf(http.StatusUnprocessableEntity, "row %d: %v", n+1, err)
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) importRow(row map[string]string) error {
+	if row["HB.name"] == "" {
+		return fmt.Errorf("name is required")
+	}
+	var err error
+	number := func(column string) float64 {
+		v, perr := strconv.ParseFloat(row[column], 64)
+		if perr != nil && row[column] != "" && err == nil {
+			err = fmt.Errorf("invalid %s %q", column, row[column])
+		}
+		return v
+	}
+	flag := func(column string) bool {
+		v, perr := strconv.ParseBool(row[column])
+		if perr != nil && row[column] != "" && err == nil {
+			err = fmt.Errorf("invalid %s %q", column, row[column])
+		}
+		return v
+	}
+	parsed := homeboxclient.Item{
+		Name: row["HB.name"], Description: row["HB.description"], Notes: row["HB.notes"],
+		AssetID: row["HB.asset_id"], Quantity: int(number("HB.quantity")),
+		Archived: flag("HB.archived"), Insured: flag("HB.insured"),
+		Manufacturer: row["HB.manufacturer"], ModelNumber: row["HB.model_number"], SerialNumber: row["HB.serial_number"],
+		PurchasePrice: number("HB.purchase_price"), PurchaseFrom: row["HB.purchase_from"], PurchaseTime: row["HB.purchase_time"],
+		LifetimeWarranty: flag("HB.lifetime_warranty"), WarrantyExpires: row["HB.warranty_expires"], WarrantyDetails: row["HB.warranty_details"],
+		SoldTo: row["HB.sold_to"], SoldPrice: number("HB.sold_price"), SoldTime: row["HB.sold_time"], SoldNotes: row["HB.sold_notes"],
+	}
+	if err != nil {
+		return err
+	}
+	if parsed.Quantity == 0 {
+		parsed.Quantity = 1
+	}
+
+	ref := row["HB.import_ref"]
+	item := s.item(ref)
+	if i := slices.IndexFunc(s.data.Items, func(item Item) bool { return ref != "" && s.refs[item.ID] == ref }); i >= 0 {
+		item = &s.data.Items[i]
+	}
+	if item == nil {
+		s.data.Items = append(s.data.Items, Item{Item: homeboxclient.Item{ID: s.id(), CreatedAt: time.Now().UTC()}})
+		item = &s.data.Items[len(s.data.Items)-1]
+		s.refs[item.ID] = ref
+	}
+	if parsed.AssetID == "" {
+		parsed.AssetID = item.AssetID
+	}
+	if parsed.AssetID == "" {
+		parsed.AssetID = s.nextAssetID()
+	}
+	parsed.ID, parsed.CreatedAt, parsed.UpdatedAt = item.ID, item.CreatedAt, time.Now().UTC()
+
+	names := slices.Sorted(maps.Keys(row))
+	for _, name := range names {
+		field, ok := strings.CutPrefix(name, csvexport.FieldPrefix)
+		if !ok || row[name] == "" {
+			continue
+		}
+		id := ""
+		if i := slices.IndexFunc(item.Fields, func(f homeboxclient.ItemField) bool { return f.Name == field }); i >= 0 {
+			id = item.Fields[i].ID
+		}
+		parsed.Fields = append(parsed.Fields, homeboxclient.ItemField{ID: s.idOr(id), Name: field, Type: "text", TextValue: row[name]})
+	}
+	item.Item = parsed
+
+	item.LocationID = s.locationByPath(row["HB.location"])
+	item.LabelIDs = nil
+	for _, name := range strings.Split(row["HB.labels"], ";") {
+		if name = strings.TrimSpace(name); name != "" {
+			item.LabelIDs = append(item.LabelIDs, s.labelByName(name))
+		}
+	}
+	return nil
+}
+
+// locationByPath returns the ID of the location at a path of names such as
+// "Home / Garage", creating the locations missing, or "" for an empty path.
+func (s *Server) locationByPath(locationPath string) string {
+	parentID := ""
+	for _, name := range strings.Split(locationPath, " / ") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		i := slices.IndexFunc(s.data.Locations, func(l Location) bool { return l.ParentID == parentID && strings.EqualFold(l.Name, name) })
+		if i < 0 {
+			s.data.Locations = append(s.data.Locations, Location{ID: s.id(), Name: name, ParentID: parentID})
+			i = len(s.data.Locations) - 1
+		}
+		parentID = s.data.Locations[i].ID
+	}
+	return parentID
+}
+
+// labelByName returns the ID of the label with a name, creating it if
+// missing.
+func (s *Server) labelByName(name string) string {
+	if i := slices.IndexFunc(s.data.Labels, func(l homeboxclient.Label) bool { return strings.EqualFold(l.Name, name) }); i >= 0 {
+		return s.data.Labels[i].ID
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	label := homeboxclient.Label{ID: s.id(), Name: name, CreatedAt: now, UpdatedAt: now}
+	s.data.Labels = append(s.data.Labels, label)
+	return label.ID
+}
+
+// fieldNames returns the names of the custom fields of all items.
+func (s *Server) fieldNames(r *http.Request) (any, error) {
+	names := []string{}
+	for _, item := range s.data.Items {
+		for _, f := range item.Fields {
+			if !slices.Contains(names, f.Name) {
+				names = append(names, f.Name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// fieldValues returns the values the custom field named by the field
+// parameter has in all items.
+func (s *Server) fieldValues(r *http.Request) (any, error) {
+	name := r.URL.Query().Get("field")
+	values := []string{}
+	for _, item := range s.data.Items {
+		for _, f := range item.Fields {
+			if v := csvexport.FieldValue(f); f.Name == name && !slices.Contains(values, v) {
+				values = append(values, v)
+			}
+		}
+	}
+	slices.Sort(values)
+	return values, nil
+}
+
+// itemPathEntry is a step of the path to an item, which the client doesn't
+// ask for.
+type itemPathEntry struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"` // "location" or "item"
+}
+
+// itemPath returns the locations leading to an item, outermost first, and
+// the item itself.
+func (s *Server) itemPath(r *http.Request) (any, error) {
+	item := s.item(r.PathValue("id"))
+	if item == nil {
+		return nil, notFound("item", r.PathValue("id"))
+	}
+	steps := []itemPathEntry{{ID: item.ID, Name: item.Name, Type: "item"}}
+	for l := s.location(item.LocationID); l != nil; l = s.location(l.ParentID) {
+		steps = append(steps, itemPathEntry{ID: l.ID, Name: l.Name, Type: "location"})
+	}
+	slices.Reverse(steps)
+	return steps, nil
+}
+
+// qrCode returns a placeholder for the QR code of the data parameter: a PNG
+// of modules derived from the data. It looks like a QR code and differs for
+// other data, but can't be scanned, as the fake has no QR encoder.
+func (s *Server) qrCode(r *http.Request) (any, error) {
+	data := r.URL.Query().Get("data")
+	if data == "" {
+		return nil, errorf(http.StatusUnprocessableEntity, "data is required")
+	}
+
+	const modules, quiet, scale = 21, 4, 4
+	sum := sha256.Sum256([]byte(data))
+	size := (modules + 2*quiet) * scale
+	img := image.NewGray(image.Rect(0, 0, size, size))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	for y := range modules {
+		for x := range modules {
+			n := y*modules + x
+			if sum[n/8%len(sum)]>>(n%8)&1 == 0 {
+				continue
+			}
+			module := image.Rect((x+quiet)*scale, (y+quiet)*scale, (x+quiet+1)*scale, (y+quiet+1)*scale)
+			draw.Draw(img, module, image.Black, image.Point{}, draw.Src)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return file{contentType: "image/png", content: buf.Bytes()}, nil
+}
+
+// Reporting
+
+// billOfMaterials lists the items with their prices as CSV, in the columns of
+// Homebox's bill of materials.
+func (s *Server) billOfMaterials(r *http.Request) (any, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"Purchase Date", "Name", "Description", "Manufacturer", "Serial Number", "Model Number", "Quantity", "Price", "Total Price"})
+	for _, item := range s.data.Items {
+		w.Write([]string{
+			csvexport.FormatDate(item.PurchaseTime), item.Name, item.Description,
+			item.Manufacturer, item.SerialNumber, item.ModelNumber,
+			strconv.Itoa(item.Quantity),
+			strconv.FormatFloat(item.PurchasePrice, 'f', 2, 64),
+			strconv.FormatFloat(item.PurchasePrice*float64(item.Quantity), 'f', 2, 64),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return file{contentType: "text/csv", content: buf.Bytes()}, nil
+}
+
+// Attachments
+
+func (s *Server) attachment(r *http.Request) (*Item, *Attachment, error) {
+	item := s.item(r.PathValue("id"))
+	if item == nil {
+		return nil, nil, notFound("item", r.PathValue("id"))
+	}
+	i := slices.IndexFunc(item.Attachments, func(a Attachment) bool { return a.ID == r.PathValue("attachment") })
+	if i < 0 {
+		return nil, nil, notFound("attachment", r.PathValue("attachment"))
+	}
+	return item, &item.Attachments[i], nil
+}
+
+// uploadAttachment adds an attachment. Like Homebox, it makes the first photo
+// of an item its primary photo.
+func (s *Server) uploadAttachment(r *http.Request) (any, error) {
+	item := s.item(r.PathValue("id"))
+	if item == nil {
+		return nil, notFound("item", r.PathValue("id"))
+	}
+	f, header, err := r.FormFile("file")
+	if err != nil {
+		return nil, errorf(http.StatusBadRequest, "file is required: %v", err)
+	}
+	defer f.Close()
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return nil, errorf(http.StatusBadRequest, "failed to read file: %v", err)
+	}
+
+	a := Attachment{
+		ID:        s.id(),
+		Title:     r.FormValue("name"),
+		Type:      r.FormValue("type"),
+		Primary:   r.FormValue("primary") == "true",
+		Content:   string(content),
+		CreatedAt: time.Now().UTC(),
+	}
+	if a.Title == "" {
+		a.Title = header.Filename
+	}
+	if a.Type == "" {
+		a.Type = homeboxclient.AttachmentTypeAttachment
+	}
+	if a.Type == homeboxclient.AttachmentTypePhoto && !slices.ContainsFunc(item.Attachments, func(a Attachment) bool { return a.Primary }) {
+		a.Primary = true
+	}
+	if a.Primary {
+		for i := range item.Attachments {
+			item.Attachments[i].Primary = false
+		}
+	}
+	item.Attachments = append(item.Attachments, a)
+	return s.itemOut(item, true), nil
+}
+
+func (s *Server) downloadAttachment(r *http.Request) (any, error) {
+	_, a, err := s.attachment(r)
+	if err != nil {
+		return nil, err
+	}
+	contentType := a.ContentType
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(a.Title))
+	}
+	if contentType == "" {
+		contentType = http.DetectContentType([]byte(a.Content))
+	}
+	return file{contentType: contentType, content: []byte(a.Content)}, nil
+}
+
+func (s *Server) updateAttachment(r *http.Request) (any, error) {
+	item, a, err := s.attachment(r)
+	if err != nil {
+		return nil, err
+	}
+	var update homeboxclient.AttachmentUpdate
+	if err := decodeBody(r, &update); err != nil {
+		return nil, err
+	}
+	if update.Primary {
+		for i := range item.Attachments {
+			item.Attachments[i].Primary = false
+		}
+	}
+	a.Primary = update.Primary
+	if update.Title != "" {
+		a.Title = update.Title
+	}
+	if update.Type != "" {
+		a.Type = update.Type
+	}
+	return s.itemOut(item, true), nil
+}
+
+func (s *Server) deleteAttachment(r *http.Request) (any, error) {
+	item, a, err := s.attachment(r)
+	if err != nil {
+		return nil, err
+	}
+	id := a.ID
+	item.Attachments = slices.DeleteFunc(item.Attachments, func(a Attachment) bool { return a.ID == id })
+	return nil, nil
+}
+
+// Maintenance
+
+// maintenanceMatches reports whether an entry has a status, which is
+// scheduled until it is completed.
+func maintenanceMatches(e homeboxclient.MaintenanceEntry, status string) bool {
+	completed := csvexport.FormatDate(e.CompletedDate) != ""
+	switch homeboxclient.MaintenanceFilterStatus(status) {
+	case homeboxclient.MaintenanceFilterStatusScheduled:
+		return !completed
+	case homeboxclient.MaintenanceFilterStatusCompleted:
+		return completed
+	}
+	return true
+}
+
+func (s *Server) maintenance(item *Item, status string) []homeboxclient.MaintenanceEntryWithDetails {
+	entries := []homeboxclient.MaintenanceEntryWithDetails{}
+	for _, e := range item.Maintenance {
+		if maintenanceMatches(e, status) {
+			entries = append(entries, homeboxclient.MaintenanceEntryWithDetails{MaintenanceEntry: e, ItemID: item.ID, ItemName: item.Name})
+		}
+	}
+	return entries
+}
+
+// maintenanceEntry finds an entry of any item.
+func (s *Server) maintenanceEntry(id string) *homeboxclient.MaintenanceEntry {
+	for i := range s.data.Items {
+		for j := range s.data.Items[i].Maintenance {
+			if s.data.Items[i].Maintenance[j].ID == id {
+				return &s.data.Items[i].Maintenance[j]
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) itemMaintenance(r *http.Request) (any, error) {
+	item := s.item(r.PathValue("id"))
+	if item == nil {
+		return nil, notFound("item", r.PathValue("id"))
+	}
+	return s.maintenance(item, r.URL.Query().Get("status")), nil
+}
+
+func (s *Server) createMaintenance(r *http.Request) (any, error) {
+	item := s.item(r.PathValue("id"))
+	if item == nil {
+		return nil, notFound("item", r.PathValue("id"))
+	}
+	var entry homeboxclient.MaintenanceEntry
+	if err := decodeBody(r, &entry); err != nil {
+		return nil, err
+	}
+	if entry.Name == "" {
+		return nil, errorf(http.StatusUnprocessableEntity, "name is required")
+	}
+	entry.ID = s.id()
+	item.Maintenance = append(item.Maintenance, entry)
+	return entry, nil
+}
+
+func (s *Server) listMaintenance(r *http.Request) (any, error) {
+	entries := []homeboxclient.MaintenanceEntryWithDetails{}
+	for i := range s.data.Items {
+		entries = append(entries, s.maintenance(&s.data.Items[i], r.URL.Query().Get("status"))...)
+	}
+	return entries, nil
+}
+
+func (s *Server) updateMaintenance(r *http.Request) (any, error) {
+	entry := s.maintenanceEntry(r.PathValue("id"))
+	if entry == nil {
+		return nil, notFound("maintenance entry", r.PathValue("id"))
+	}
+	var update homeboxclient.MaintenanceEntry
+	if err := decodeBody(r, &update); err != nil {
+		return nil, err
+	}
+	update.ID = entry.ID
+	*entry = update
+	return entry, nil
+}
+
+func (s *Server) deleteMaintenance(r *http.Request) (any, error) {
+	id := r.PathValue("id")
+	if s.maintenanceEntry(id) == nil {
+		return nil, notFound("maintenance entry", id)
+	}
+	for i := range s.data.Items {
+		s.data.Items[i].Maintenance = slices.DeleteFunc(s.data.Items[i].Maintenance, func(e homeboxclient.MaintenanceEntry) bool { return e.ID == id })
+	}
+	return nil, nil
+}
+
+// Notifiers
+
+func (s *Server) notifier(id string) *homeboxclient.Notifier {
+	i := slices.IndexFunc(s.data.Notifiers, func(n homeboxclient.Notifier) bool { return n.ID == id })
+	if i < 0 {
+		return nil
+	}
+	return &s.data.Notifiers[i]
+}
+
+func (s *Server) listNotifiers(r *http.Request) (any, error) {
+	return append([]homeboxclient.Notifier{}, s.data.Notifiers...), nil
+}
+
+func (s *Server) createNotifier(r *http.Request) (any, error) {
+	var create homeboxclient.NotifierCreate
+	if err := decodeBody(r, &create); err != nil {
+		return nil, err
+	}
+	if create.Name == "" || create.URL == "" {
+		return nil, errorf(http.StatusUnprocessableEntity, "name and url are required")
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	n := homeboxclient.Notifier{ID: s.id(), Name: create.Name, URL: create.URL, IsActive: create.IsActive, GroupID: s.data.Group.ID, CreatedAt: now, UpdatedAt: now}
+	s.data.Notifiers = append(s.data.Notifiers, n)
+	return n, nil
+}
+
+func (s *Server) updateNotifier(r *http.Request) (any, error) {
+	n := s.notifier(r.PathValue("id"))
+	if n == nil {
+		return nil, notFound("notifier", r.PathValue("id"))
+	}
+	var update homeboxclient.NotifierUpdate
+	if err := decodeBody(r, &update); err != nil {
+		return nil, err
+	}
+	n.Name, n.IsActive = update.Name, update.IsActive
+	if update.URL != "" {
+		n.URL = update.URL
+	}
+	n.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	return n, nil
+}
+
+func (s *Server) deleteNotifier(r *http.Request) (any, error) {
+	id := r.PathValue("id")
+	if s.notifier(id) == nil {
+		return nil, notFound("notifier", id)
+	}
+	s.data.Notifiers = slices.DeleteFunc(s.data.Notifiers, func(n homeboxclient.Notifier) bool { return n.ID == id })
+	return nil, nil
+}
+
+// Actions
+
+func completed(n int) homeboxclient.ActionAmountResult {
+	return homeboxclient.ActionAmountResult{Completed: n}
+}
+
+func (s *Server) ensureAssetIDs(r *http.Request) (any, error) {
+	n := 0
+	for i := range s.data.Items {
+		if assetNumber(s.data.Items[i].AssetID) == 0 {
+			s.data.Items[i].AssetID = s.nextAssetID()
+			n++
+		}
+	}
+	return completed(n), nil
+}
+
+func (s *Server) ensureImportRefs(r *http.Request) (any, error) {
+	n := 0
+	for _, item := range s.data.Items {
+		if s.refs[item.ID] == "" {
+			s.refs[item.ID] = item.ID
+			n++
+		}
+	}
+	return completed(n), nil
+}
+
+func (s *Server) setPrimaryPhotos(r *http.Request) (any, error) {
+	n := 0
+	for i := range s.data.Items {
+		attachments := s.data.Items[i].Attachments
+		if slices.ContainsFunc(attachments, func(a Attachment) bool { return a.Primary }) {
+			continue
+		}
+		if j := slices.IndexFunc(attachments, func(a Attachment) bool { return a.Type == homeboxclient.AttachmentTypePhoto }); j >= 0 {
+			attachments[j].Primary = true
+			n++
+		}
+	}
+	return completed(n), nil
+}
+
+func (s *Server) zeroItemTimeFields(r *http.Request) (any, error) {
+	n := 0
+	for i := range s.data.Items {
+		item := &s.data.Items[i].Item
+		changed := false
+		for _, date := range []*string{&item.PurchaseTime, &item.WarrantyExpires, &item.SoldTime} {
+			if len(*date) > len(time.DateOnly) {
+				*date = (*date)[:len(time.DateOnly)]
+				changed = true
+			}
+		}
+		if changed {
+			n++
+		}
+	}
+	return completed(n), nil
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/csvexport"
 )
 
 type FileManager struct {
@@ -29,6 +30,17 @@ func (fm *FileManager) GenerateDirectory(item homeboxclient.Item) string {
 		shortId)
 }
 
+// GenerateTypeDirectory returns the subfolder name used for an attachment
+// when attachments are grouped by type. Untyped attachments use the generic
+// "attachment" type.
+func (fm *FileManager) GenerateTypeDirectory(attachment homeboxclient.Attachment) string {
+	attachmentType := fm.sanitizeFilename(strings.ToLower(attachment.Type))
+	if attachmentType == "" || attachmentType == "." || attachmentType == ".." {
+		return homeboxclient.AttachmentTypeAttachment
+	}
+	return attachmentType
+}
+
 func (fm *FileManager) GenerateFilename(item homeboxclient.Item, attachment homeboxclient.Attachment) string {
 	ext := fm.getFileExtension(attachment.Document.Title)
 
@@ -45,6 +57,20 @@ func (fm *FileManager) GenerateFilename(item homeboxclient.Item, attachment home
 	return fmt.Sprintf("%s%s", attachment.ID, ext)
 }
 
+// GeneratePrimaryPhotoFilename returns a predictable filename for an item's
+// primary photo, based on its asset ID so it can be matched to printed labels.
+// Items without an asset ID, which Homebox reports as 000-000, fall back to
+// their short ID.
+func (fm *FileManager) GeneratePrimaryPhotoFilename(item homeboxclient.Item, attachment homeboxclient.Attachment) string {
+	ext := strings.ToLower(fm.getFileExtension(attachment.Document.Title))
+
+	name := fm.sanitizeFilename(csvexport.FormatAssetID(item.AssetID))
+	if name == "" {
+		name = strings.Split(item.ID, "-")[0]
+	}
+	return fmt.Sprintf("%s%s", name, ext)
+}
+
 func (fm *FileManager) sanitizeFilename(filename string) string {
 	invalid := []string{"/", "\\", ":", "*", "?", "\"", "<", ">", "|"}
 	result := filename
@@ -50,6 +50,31 @@ func TestGenerateDirectory(t *testing.T) {
 	}
 }
 
+func TestGenerateTypeDirectory(t *testing.T) {
+	tests := []struct {
+		name     string
+		attType  string
+		expected string
+	}{
+		{name: "photo", attType: "photo", expected: "photo"},
+		{name: "mixed case", attType: "Receipt", expected: "receipt"},
+		{name: "empty type", attType: "", expected: "attachment"},
+		{name: "path separators", attType: "../manual", expected: ".._manual"},
+		{name: "dot dot", attType: "..", expected: "attachment"},
+	}
+
+	fm := NewFileManager("/test")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := fm.GenerateTypeDirectory(homeboxclient.Attachment{Type: tt.attType})
+			if result != tt.expected {
+				t.Errorf("GenerateTypeDirectory() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestGenerateFilename(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -106,3 +131,48 @@ func TestGenerateFilename(t *testing.T) {
 		})
 	}
 }
+
+func TestGeneratePrimaryPhotoFilename(t *testing.T) {
+	tests := []struct {
+		name       string
+		item       homeboxclient.Item
+		attachment homeboxclient.Attachment
+		expected   string
+	}{
+		{
+			name:       "asset id",
+			item:       homeboxclient.Item{ID: "abc123-456def", AssetID: "000-042"},
+			attachment: homeboxclient.Attachment{Document: homeboxclient.DocumentOut{Title: "front.JPG"}},
+			expected:   "000-042.jpg",
+		},
+		{
+			name:       "missing asset id",
+			item:       homeboxclient.Item{ID: "abc123-456def"},
+			attachment: homeboxclient.Attachment{Document: homeboxclient.DocumentOut{Title: "front.png"}},
+			expected:   "abc123.png",
+		},
+		{
+			name:       "unset asset id",
+			item:       homeboxclient.Item{ID: "abc123-456def", AssetID: "000-000"},
+			attachment: homeboxclient.Attachment{Document: homeboxclient.DocumentOut{Title: "front.png"}},
+			expected:   "abc123.png",
+		},
+		{
+			name:       "missing extension",
+			item:       homeboxclient.Item{ID: "abc123-456def", AssetID: "000-001"},
+			attachment: homeboxclient.Attachment{Document: homeboxclient.DocumentOut{Title: "photo"}},
+			expected:   "000-001.bin",
+		},
+	}
+
+	fm := NewFileManager("/test")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := fm.GeneratePrimaryPhotoFilename(tt.item, tt.attachment)
+			if result != tt.expected {
+				t.Errorf("GeneratePrimaryPhotoFilename() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
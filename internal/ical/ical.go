@@ -0,0 +1,194 @@
+// Package ical writes maintenance and warranty dates as an RFC 5545
+// iCalendar file, which calendar apps can import or subscribe to.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/csvexport"
+)
+
+// maxLineOctets is the longest a content line may be before it has to be
+// folded, not counting the line break.
+const maxLineOctets = 75
+
+// Event is an all-day event.
+type Event struct {
+	// UID identifies the event across exports, so that subscribed calendars
+	// update it instead of adding a copy.
+	UID         string
+	Date        time.Time
+	Summary     string
+	Description string
+	Categories  []string
+}
+
+// Calendar is a named list of events.
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// AddMaintenance adds an event for every maintenance entry, on the day it was
+// completed or, if it is still due, the day it is scheduled for. Entries
+// without either date are left out.
+func (c *Calendar) AddMaintenance(entries []homeboxclient.MaintenanceEntryWithDetails) {
+	for _, entry := range entries {
+		scheduled := csvexport.FormatDate(entry.ScheduledDate)
+		completed := csvexport.FormatDate(entry.CompletedDate)
+		day := completed
+		if day == "" {
+			day = scheduled
+		}
+		date, err := time.Parse(time.DateOnly, day)
+		if err != nil {
+			continue
+		}
+
+		var description []string
+		if entry.Description != "" {
+			description = append(description, entry.Description)
+		}
+		if cost, err := strconv.ParseFloat(entry.Cost, 64); err == nil && cost != 0 {
+			description = append(description, "Cost: "+strconv.FormatFloat(cost, 'f', 2, 64))
+		}
+		if scheduled != "" {
+			description = append(description, "Scheduled: "+scheduled)
+		}
+		if completed != "" {
+			description = append(description, "Completed: "+completed)
+		}
+
+		summary := entry.ItemName + ": " + entry.Name
+		if completed != "" {
+			summary += " (done)"
+		}
+		c.Events = append(c.Events, Event{
+			UID:         "maintenance-" + entry.ID + "@homebox-export",
+			Date:        date,
+			Summary:     summary,
+			Description: strings.Join(description, "\n"),
+			Categories:  []string{"Maintenance"},
+		})
+	}
+}
+
+// AddWarranties adds an event on the day the warranty of each item expires.
+// Items with a lifetime warranty or without an expiry date are left out.
+func (c *Calendar) AddWarranties(items []homeboxclient.Item) {
+	for _, item := range items {
+		if item.LifetimeWarranty {
+			continue
+		}
+		date, err := time.Parse(time.DateOnly, csvexport.FormatDate(item.WarrantyExpires))
+		if err != nil {
+			continue
+		}
+
+		var description []string
+		if id := csvexport.FormatAssetID(item.AssetID); id != "" {
+			description = append(description, "Asset ID: "+id)
+		}
+		if item.WarrantyDetails != "" {
+			description = append(description, item.WarrantyDetails)
+		}
+		c.Events = append(c.Events, Event{
+			UID:         "warranty-" + item.ID + "@homebox-export",
+			Date:        date,
+			Summary:     "Warranty expires: " + item.Name,
+			Description: strings.Join(description, "\n"),
+			Categories:  []string{"Warranty"},
+		})
+	}
+}
+
+// Write writes the calendar with its events sorted by date. stamp is when the
+// calendar was created, which every event records.
+func (c *Calendar) Write(w io.Writer, stamp time.Time) error {
+	events := append([]Event(nil), c.Events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].Date.Equal(events[j].Date) {
+			return events[i].Date.Before(events[j].Date)
+		}
+		return events[i].UID < events[j].UID
+	})
+
+	bw := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeLine(bw, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//homebox-export//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		line("X-WR-CALNAME", escape(c.Name))
+	}
+	for _, e := range events {
+		line("BEGIN", "VEVENT")
+		line("UID", escape(e.UID))
+		line("DTSTAMP", stamp.UTC().Format("20060102T150405Z"))
+		line("DTSTART;VALUE=DATE", e.Date.Format("20060102"))
+		line("DTEND;VALUE=DATE", e.Date.AddDate(0, 0, 1).Format("20060102"))
+		line("SUMMARY", escape(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION", escape(e.Description))
+		}
+		if len(e.Categories) > 0 {
+			categories := make([]string, len(e.Categories))
+			for i, category := range e.Categories {
+				categories[i] = escape(category)
+			}
+			line("CATEGORIES", strings.Join(categories, ","))
+		}
+		line("TRANSP", "TRANSPARENT")
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("failed to write calendar: %w", err)
+	}
+	return nil
+}
+
+// escape escapes a TEXT value.
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(s)
+}
+
+// writeLine writes a content line, folding it into lines of at most
+// maxLineOctets octets without splitting a UTF-8 character.
+func writeLine(w *bufio.Writer, s string) {
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.WriteString(s[:cut])
+		w.WriteString("\r\n ")
+		s = s[cut:]
+		// Continuation lines start with a space, which counts.
+		limit = maxLineOctets - 1
+	}
+	w.WriteString(s)
+	w.WriteString("\r\n")
+}
@@ -0,0 +1,127 @@
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+)
+
+func TestWrite(t *testing.T) {
+	var c Calendar
+	c.Name = "Homebox"
+	c.AddMaintenance([]homeboxclient.MaintenanceEntryWithDetails{
+		{
+			MaintenanceEntry: homeboxclient.MaintenanceEntry{ID: "m1", Name: "Replace filter", Description: "Use the F-12, not the F-10", Cost: "24.5", ScheduledDate: "2025-03-01"},
+			ItemName:         "Furnace",
+		},
+		{
+			MaintenanceEntry: homeboxclient.MaintenanceEntry{ID: "m2", Name: "Oil change", Cost: "0", ScheduledDate: "2024-05-01", CompletedDate: "2024-05-03T00:00:00Z"},
+			ItemName:         "Car",
+		},
+		{
+			MaintenanceEntry: homeboxclient.MaintenanceEntry{ID: "m3", Name: "Someday", ScheduledDate: "0001-01-01"},
+			ItemName:         "Shed",
+		},
+	})
+	c.AddWarranties([]homeboxclient.Item{
+		{ID: "i1", Name: "TV", AssetID: "000-007", WarrantyExpires: "2026-01-15T00:00:00Z", WarrantyDetails: "Call the store; keep the receipt"},
+		{ID: "i2", Name: "Cast iron pan", LifetimeWarranty: true, WarrantyExpires: "2030-01-01"},
+		{ID: "i3", Name: "Chair", WarrantyExpires: "0001-01-01T00:00:00Z"},
+	})
+
+	var buf bytes.Buffer
+	if err := c.Write(&buf, time.Date(2025, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//homebox-export//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Homebox",
+		"BEGIN:VEVENT",
+		"UID:maintenance-m2@homebox-export",
+		"DTSTAMP:20250102T020405Z",
+		"DTSTART;VALUE=DATE:20240503",
+		"DTEND;VALUE=DATE:20240504",
+		"SUMMARY:Car: Oil change (done)",
+		`DESCRIPTION:Scheduled: 2024-05-01\nCompleted: 2024-05-03`,
+		"CATEGORIES:Maintenance",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:maintenance-m1@homebox-export",
+		"DTSTAMP:20250102T020405Z",
+		"DTSTART;VALUE=DATE:20250301",
+		"DTEND;VALUE=DATE:20250302",
+		"SUMMARY:Furnace: Replace filter",
+		`DESCRIPTION:Use the F-12\, not the F-10\nCost: 24.50\nScheduled: 2025-03-01`,
+		"CATEGORIES:Maintenance",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:warranty-i1@homebox-export",
+		"DTSTAMP:20250102T020405Z",
+		"DTSTART;VALUE=DATE:20260115",
+		"DTEND;VALUE=DATE:20260116",
+		"SUMMARY:Warranty expires: TV",
+		`DESCRIPTION:Asset ID: 000-007\nCall the store\; keep the receipt`,
+		"CATEGORIES:Warranty",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	if got != want {
+		t.Errorf("Write() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteLineFolds(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{
+			name: "short",
+			line: "SUMMARY:Drill",
+			want: "SUMMARY:Drill\r\n",
+		},
+		{
+			name: "long",
+			line: "DESCRIPTION:" + strings.Repeat("a", 100),
+			want: "DESCRIPTION:" + strings.Repeat("a", 63) + "\r\n " + strings.Repeat("a", 37) + "\r\n",
+		},
+		{
+			// The fold would split the ü after 75 octets.
+			name: "multibyte",
+			line: "DESCRIPTION:" + strings.Repeat("a", 62) + "über",
+			want: "DESCRIPTION:" + strings.Repeat("a", 62) + "\r\n über\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := bufio.NewWriter(&buf)
+			writeLine(w, tt.line)
+			w.Flush()
+			if buf.String() != tt.want {
+				t.Errorf("writeLine() = %q, want %q", buf.String(), tt.want)
+			}
+			for _, l := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+				if len(l) > maxLineOctets {
+					t.Errorf("line %q is %d octets long", l, len(l))
+				}
+			}
+		})
+	}
+}
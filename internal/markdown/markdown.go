@@ -0,0 +1,346 @@
+// Package markdown renders an export as Markdown notes that can be opened as
+// an Obsidian vault: a note per item with YAML front matter, and a note per
+// location and label linking to their items.
+package markdown
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kusold/homebox-export/internal/csvexport"
+	"github.com/kusold/homebox-export/internal/report"
+)
+
+// Folders holding the notes, relative to the export.
+const (
+	ItemsFolder     = "Items"
+	LocationsFolder = "Locations"
+	LabelsFolder    = "Labels"
+)
+
+// Render returns the notes of every item in r and of every location and label.
+// locations holds all locations, so that locations without items of their
+// own still get a note linking to the ones below them. Attachment paths in r
+// must be relative to the export root.
+func Render(r *report.Report, locations csvexport.LocationPaths) []report.File {
+	v := newVault(r, locations)
+
+	var files []report.File
+	for i, row := range r.Rows {
+		files = append(files, report.File{Name: v.items[i] + ".md", Data: []byte(v.itemNote(row))})
+	}
+	for _, p := range v.locationPaths {
+		files = append(files, report.File{Name: v.locations[p] + ".md", Data: []byte(v.locationNote(p))})
+	}
+	for _, l := range v.labelNames {
+		files = append(files, report.File{Name: v.labels[l] + ".md", Data: []byte(v.labelNote(l))})
+	}
+	return files
+}
+
+// vault knows the note of every item, location and label, by which notes
+// link to each other.
+type vault struct {
+	r             *report.Report
+	items         []string          // note of each row, without .md
+	locations     map[string]string // location path to note
+	locationPaths []string          // sorted
+	labels        map[string]string // label to note
+	labelNames    []string          // sorted
+}
+
+func newVault(r *report.Report, locations csvexport.LocationPaths) *vault {
+	v := &vault{r: r, locations: make(map[string]string), labels: make(map[string]string)}
+
+	// Item names need not be unique, note names must.
+	var names, ids []string
+	for _, row := range r.Rows {
+		names = append(names, noteName(row.Item.Name))
+		ids = append(ids, row.Item.ID)
+	}
+	for _, name := range disambiguate(names, ids) {
+		v.items = append(v.items, path.Join(ItemsFolder, name))
+	}
+
+	locationIDs := make(map[string]string) // location path to ID
+	for id, p := range locations {
+		locationIDs[p] = id
+	}
+	addLocation := func(p string) {
+		// Parents get a note as well, so every location links up to the root.
+		segments := strings.Split(p, " / ")
+		for i := range segments {
+			parent := strings.Join(segments[:i+1], " / ")
+			if _, ok := v.locations[parent]; !ok {
+				v.locations[parent] = ""
+				v.locationPaths = append(v.locationPaths, parent)
+			}
+		}
+	}
+	for _, p := range locations {
+		addLocation(p)
+	}
+	labelIDs := make(map[string]string) // label name to ID
+	for _, row := range r.Rows {
+		if row.Location != "" {
+			addLocation(row.Location)
+		}
+		for _, l := range row.Item.Labels {
+			labelIDs[l.Name] = l.ID
+		}
+		for _, l := range row.Labels {
+			if _, ok := v.labels[l]; !ok {
+				v.labels[l] = ""
+				v.labelNames = append(v.labelNames, l)
+			}
+		}
+	}
+	sort.Strings(v.locationPaths)
+	sort.Strings(v.labelNames)
+
+	// A location's note is in the folder of its parent, so only siblings
+	// can collide; parents are named first, as their children need the folder.
+	children := make(map[string][]string) // parent path, "" for the roots, to location paths
+	for _, p := range v.locationPaths {
+		parent, _ := splitLocation(p)
+		children[parent] = append(children[parent], p)
+	}
+	var nameChildren func(parent, folder string)
+	nameChildren = func(parent, folder string) {
+		var names, ids []string
+		for _, p := range children[parent] {
+			_, name := splitLocation(p)
+			names = append(names, noteName(name))
+			ids = append(ids, locationIDs[p])
+		}
+		for i, name := range disambiguate(names, ids) {
+			p := children[parent][i]
+			v.locations[p] = path.Join(folder, name)
+			nameChildren(p, v.locations[p])
+		}
+	}
+	nameChildren("", LocationsFolder)
+
+	names, ids = nil, nil
+	for _, l := range v.labelNames {
+		names = append(names, noteName(l))
+		ids = append(ids, labelIDs[l])
+	}
+	for i, name := range disambiguate(names, ids) {
+		v.labels[v.labelNames[i]] = path.Join(LabelsFolder, name)
+	}
+	return v
+}
+
+// disambiguate returns names with the short ID of what they are the note of
+// appended to those that are the same as another, ignoring case as some
+// filesystems do. Without an ID, the position in names is appended instead.
+func disambiguate(names, ids []string) []string {
+	count := make(map[string]int)
+	for _, name := range names {
+		count[strings.ToLower(name)]++
+	}
+	unique := make([]string, len(names))
+	for i, name := range names {
+		if count[strings.ToLower(name)] > 1 {
+			id := strings.Split(ids[i], "-")[0]
+			if id == "" {
+				id = strconv.Itoa(i + 1)
+			}
+			name += " (" + id + ")"
+		}
+		unique[i] = name
+	}
+	return unique
+}
+
+// splitLocation splits a location path into the path of its parent, empty for
+// top level locations, and its name.
+func splitLocation(p string) (parent, name string) {
+	if i := strings.LastIndex(p, " / "); i >= 0 {
+		return p[:i], p[i+len(" / "):]
+	}
+	return "", p
+}
+
+func (v *vault) itemNote(row report.Row) string {
+	item := row.Item
+	var b strings.Builder
+
+	b.WriteString("---\n")
+	property(&b, "id", quote(item.ID))
+	if id := csvexport.FormatAssetID(item.AssetID); id != "" {
+		property(&b, "asset_id", quote(id))
+	}
+	if row.Location != "" {
+		property(&b, "location", quote(link(v.locations[row.Location], row.Location)))
+	}
+	if len(row.Labels) > 0 {
+		b.WriteString("labels:\n")
+		for _, l := range row.Labels {
+			fmt.Fprintf(&b, "  - %s\n", quote(link(v.labels[l], l)))
+		}
+	}
+	property(&b, "quantity", strconv.Itoa(item.Quantity))
+	if item.PurchasePrice != 0 {
+		property(&b, "price", strconv.FormatFloat(item.PurchasePrice, 'f', -1, 64))
+	}
+	if d := csvexport.FormatDate(item.PurchaseTime); d != "" {
+		property(&b, "purchase_date", d)
+	}
+	if item.PurchaseFrom != "" {
+		property(&b, "purchased_from", quote(item.PurchaseFrom))
+	}
+	if item.Manufacturer != "" {
+		property(&b, "manufacturer", quote(item.Manufacturer))
+	}
+	if item.ModelNumber != "" {
+		property(&b, "model_number", quote(item.ModelNumber))
+	}
+	if item.SerialNumber != "" {
+		property(&b, "serial_number", quote(item.SerialNumber))
+	}
+	if item.LifetimeWarranty {
+		property(&b, "warranty", "lifetime")
+	} else if d := csvexport.FormatDate(item.WarrantyExpires); d != "" {
+		property(&b, "warranty", d)
+	}
+	if item.Insured {
+		property(&b, "insured", "true")
+	}
+	if item.Archived {
+		property(&b, "archived", "true")
+	}
+	b.WriteString("---\n\n")
+
+	fmt.Fprintf(&b, "# %s\n", item.Name)
+	if item.Description != "" {
+		fmt.Fprintf(&b, "\n%s\n", item.Description)
+	}
+
+	// Item notes are in ItemsFolder, attachments are relative to the root.
+	const root = "../"
+	if photos := row.Photos(); len(photos) > 0 {
+		b.WriteString("\n")
+		for _, p := range photos {
+			fmt.Fprintf(&b, "![%s](%s)\n", p.Title, root+report.URLPath(p.Path))
+		}
+	}
+
+	if len(item.Fields) > 0 {
+		b.WriteString("\n## Fields\n\n| Field | Value |\n| --- | --- |\n")
+		for _, f := range item.Fields {
+			fmt.Fprintf(&b, "| %s | %s |\n", cell(f.Name), cell(csvexport.FieldValue(f)))
+		}
+	}
+	if item.Notes != "" {
+		fmt.Fprintf(&b, "\n## Notes\n\n%s\n", strings.TrimSpace(item.Notes))
+	}
+	if len(row.Attachments) > 0 {
+		b.WriteString("\n## Attachments\n\n")
+		for _, a := range row.Attachments {
+			fmt.Fprintf(&b, "- [%s](%s) (%s)\n", a.Title, root+report.URLPath(a.Path), a.Type)
+		}
+	}
+	return b.String()
+}
+
+func (v *vault) locationNote(p string) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	property(&b, "location", quote(p))
+	b.WriteString("---\n\n")
+	fmt.Fprintf(&b, "# %s\n", p)
+
+	if parent, _ := splitLocation(p); parent != "" {
+		fmt.Fprintf(&b, "\nIn %s\n", link(v.locations[parent], parent))
+	}
+
+	var children []string
+	for _, c := range v.locationPaths {
+		if strings.HasPrefix(c, p+" / ") && !strings.Contains(c[len(p)+3:], " / ") {
+			children = append(children, c)
+		}
+	}
+	if len(children) > 0 {
+		b.WriteString("\n## Locations\n\n")
+		for _, c := range children {
+			fmt.Fprintf(&b, "- %s\n", link(v.locations[c], c[len(p)+3:]))
+		}
+	}
+
+	v.itemList(&b, func(row report.Row) bool { return row.Location == p })
+	return b.String()
+}
+
+func (v *vault) labelNote(l string) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	property(&b, "label", quote(l))
+	b.WriteString("---\n\n")
+	fmt.Fprintf(&b, "# %s\n", l)
+
+	v.itemList(&b, func(row report.Row) bool {
+		for _, rl := range row.Labels {
+			if rl == l {
+				return true
+			}
+		}
+		return false
+	})
+	return b.String()
+}
+
+// itemList writes links to the items matching include.
+func (v *vault) itemList(b *strings.Builder, include func(report.Row) bool) {
+	header := false
+	for i, row := range v.r.Rows {
+		if !include(row) {
+			continue
+		}
+		if !header {
+			b.WriteString("\n## Items\n\n")
+			header = true
+		}
+		fmt.Fprintf(b, "- %s\n", link(v.items[i], row.Item.Name))
+	}
+}
+
+// link returns a wiki link to note, shown as text.
+func link(note, text string) string {
+	return "[[" + note + "|" + strings.NewReplacer("|", "-", "]", ")", "[", "(").Replace(text) + "]]"
+}
+
+func property(b *strings.Builder, key, value string) {
+	fmt.Fprintf(b, "%s: %s\n", key, value)
+}
+
+// quote returns s as a double quoted YAML string. JSON strings are valid YAML.
+func quote(s string) string {
+	data, _ := json.Marshal(s)
+	return string(data)
+}
+
+// cell escapes text for a Markdown table cell.
+func cell(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "|", `\|`), "\n", " ")
+}
+
+// noteName replaces the characters Obsidian doesn't allow in note names.
+func noteName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`*"\/<>:|?#^[]`, r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(s))
+	s = strings.TrimLeft(s, ".")
+	if s == "" {
+		return "_"
+	}
+	return s
+}
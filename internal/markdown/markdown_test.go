@@ -0,0 +1,148 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/csvexport"
+	"github.com/kusold/homebox-export/internal/report"
+)
+
+func TestRender(t *testing.T) {
+	items := []homeboxclient.Item{
+		{
+			ID:              "drill-1",
+			Name:            "Drill",
+			AssetID:         "000-012",
+			Quantity:        1,
+			PurchasePrice:   129.5,
+			WarrantyExpires: "2026-05-04T00:00:00Z",
+			SerialNumber:    `SN "1"`,
+			Notes:           "Battery in the drawer",
+			Location:        &homeboxclient.Location{ID: "garage"},
+			Labels:          []homeboxclient.Label{{Name: "Tools"}},
+			Fields:          []homeboxclient.ItemField{{Name: "Voltage", Type: "number", NumberValue: 18}},
+			Attachments: []homeboxclient.Attachment{
+				{ID: "photo", Type: "photo", Document: homeboxclient.DocumentOut{Title: "front view.jpg"}},
+				{ID: "receipt", Type: "receipt", Document: homeboxclient.DocumentOut{Title: "receipt.pdf"}},
+			},
+		},
+		{ID: "4f1c2a9e-1", Name: "Ladder", Quantity: 1, Location: &homeboxclient.Location{ID: "garage"}},
+		{ID: "9b3d7e20-2", Name: "Ladder", Quantity: 1, LifetimeWarranty: true},
+	}
+	locations := csvexport.LocationPaths{"home": "Home", "garage": "Home / Garage", "attic": "Home / Attic"}
+	paths := func(item homeboxclient.Item, a homeboxclient.Attachment) (string, bool) {
+		return "Drill_drill/" + a.Document.Title, true
+	}
+
+	notes := make(map[string]string)
+	for _, f := range Render(report.New(items, locations, paths), locations) {
+		notes[f.Name] = string(f.Data)
+	}
+
+	for _, name := range []string{
+		"Items/Drill.md",
+		"Items/Ladder (4f1c2a9e).md",
+		"Locations/Home.md",
+		"Locations/Home/Garage.md",
+		"Locations/Home/Attic.md",
+		"Labels/Tools.md",
+	} {
+		if _, ok := notes[name]; !ok {
+			t.Errorf("missing note %s", name)
+		}
+	}
+	if len(notes) != 7 {
+		t.Errorf("got %d notes, want 7", len(notes))
+	}
+
+	drill := notes["Items/Drill.md"]
+	for _, want := range []string{
+		"---\nid: \"drill-1\"\n",
+		`asset_id: "000-012"`,
+		`location: "[[Locations/Home/Garage|Home / Garage]]"`,
+		"labels:\n  - \"[[Labels/Tools|Tools]]\"\n",
+		"price: 129.5\n",
+		"warranty: 2026-05-04\n",
+		`serial_number: "SN \"1\""`,
+		"# Drill\n",
+		"![front view.jpg](../Drill_drill/front%20view.jpg)",
+		"| Voltage | 18 |",
+		"## Notes\n\nBattery in the drawer\n",
+		"- [receipt.pdf](../Drill_drill/receipt.pdf) (receipt)",
+	} {
+		if !strings.Contains(drill, want) {
+			t.Errorf("item note missing %q:\n%s", want, drill)
+		}
+	}
+	if !strings.Contains(notes["Items/Ladder (9b3d7e20).md"], "warranty: lifetime") {
+		t.Error("lifetime warranty missing")
+	}
+
+	garage := notes["Locations/Home/Garage.md"]
+	for _, want := range []string{"In [[Locations/Home|Home]]", "- [[Items/Drill|Drill]]", "- [[Items/Ladder (4f1c2a9e)|Ladder]]"} {
+		if !strings.Contains(garage, want) {
+			t.Errorf("location note missing %q:\n%s", want, garage)
+		}
+	}
+	home := notes["Locations/Home.md"]
+	if !strings.Contains(home, "- [[Locations/Home/Attic|Attic]]\n- [[Locations/Home/Garage|Garage]]") {
+		t.Errorf("location note does not list the locations below it:\n%s", home)
+	}
+	if strings.Contains(home, "## Items") {
+		t.Error("location without items lists items")
+	}
+	if !strings.Contains(notes["Labels/Tools.md"], "- [[Items/Drill|Drill]]") {
+		t.Error("label note does not link its items")
+	}
+}
+
+func TestRender_SameNoteNames(t *testing.T) {
+	items := []homeboxclient.Item{
+		{ID: "saw-1", Name: "Saw", Location: &homeboxclient.Location{ID: "slash"}, Labels: []homeboxclient.Label{{ID: "a1b2-x", Name: "Tools"}}},
+		{ID: "hammer-1", Name: "Hammer", Location: &homeboxclient.Location{ID: "under"}, Labels: []homeboxclient.Label{{ID: "c3d4-y", Name: "tools"}}},
+	}
+	// "A/B" and "A_B" have the same note name, and so do "Shed" and "shed",
+	// on filesystems that ignore case.
+	locations := csvexport.LocationPaths{
+		"slash": "A/B", "under": "A_B",
+		"upper": "A/B / Shed", "lower": "A_B / shed",
+	}
+	files := Render(report.New(items, locations, nil), locations)
+
+	notes := make(map[string]string)
+	for _, f := range files {
+		if _, ok := notes[strings.ToLower(f.Name)]; ok {
+			t.Errorf("note %s overwrites another", f.Name)
+		}
+		notes[strings.ToLower(f.Name)] = string(f.Data)
+	}
+	for name, want := range map[string]string{
+		"Locations/A_B (slash).md":      `location: "A/B"`,
+		"Locations/A_B (under).md":      `location: "A_B"`,
+		"Locations/A_B (slash)/Shed.md": "In [[Locations/A_B (slash)|A/B]]",
+		"Locations/A_B (under)/shed.md": "In [[Locations/A_B (under)|A_B]]",
+		"Labels/Tools (a1b2).md":        "- [[Items/Saw|Saw]]",
+		"Labels/tools (c3d4).md":        "- [[Items/Hammer|Hammer]]",
+	} {
+		if note, ok := notes[strings.ToLower(name)]; !ok || !strings.Contains(note, want) {
+			t.Errorf("note %s = %q, want it to contain %q", name, note, want)
+		}
+	}
+}
+
+func TestNoteName(t *testing.T) {
+	tests := map[string]string{
+		"Drill":            "Drill",
+		"A/B: C?":          "A_B_ C_",
+		" .hidden ":        "hidden",
+		"[Box] #1 | ^cool": "_Box_ _1 _ _cool",
+		"":                 "_",
+	}
+	for in, want := range tests {
+		if got := noteName(in); got != want {
+			t.Errorf("noteName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
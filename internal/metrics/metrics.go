@@ -0,0 +1,174 @@
+package metrics
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kusold/homebox-export/internal/progress"
+)
+
+const namespace = "homebox_export"
+
+// Run describes a single export run.
+type Run struct {
+	Start time.Time
+	End   time.Time
+	Stats progress.Stats
+	Err   error
+}
+
+// Recorder keeps the metrics of the most recent export runs and renders them
+// in the Prometheus text exposition format, either to a node_exporter textfile
+// or over HTTP. It is safe for concurrent use.
+type Recorder struct {
+	mu          sync.Mutex
+	last        *Run
+	lastSuccess time.Time
+	runs        int
+	failures    int
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Record adds a finished run.
+func (r *Recorder) Record(run Run) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.last = &run
+	r.runs++
+	if run.Err != nil {
+		r.failures++
+	} else {
+		r.lastSuccess = run.End
+	}
+}
+
+// LastSuccess returns the end time of the last successful run.
+func (r *Recorder) LastSuccess() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lastSuccess
+}
+
+// Load restores the last success timestamp and run counters from a textfile
+// written by a previous run, so they survive between scheduled invocations.
+// A missing file is not an error.
+func (r *Recorder) Load(path string) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open metrics file: %w", err)
+	}
+	defer f.Close()
+
+	values := make(map[string]float64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseFloat(fields[1], 64); err == nil {
+			values[fields[0]] = v
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read metrics file: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if v, ok := values[namespace+"_last_success_timestamp_seconds"]; ok && v > 0 {
+		r.lastSuccess = time.Unix(int64(v), 0)
+	}
+	r.runs += int(values[namespace+"_runs_total"])
+	r.failures += int(values[namespace+"_failures_total"])
+	return nil
+}
+
+// WriteTo writes all metrics in the Prometheus text exposition format.
+func (r *Recorder) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var buf bytes.Buffer
+	write := func(name, typ, help string, value float64) {
+		fmt.Fprintf(&buf, "# HELP %s_%s %s\n", namespace, name, help)
+		fmt.Fprintf(&buf, "# TYPE %s_%s %s\n", namespace, name, typ)
+		fmt.Fprintf(&buf, "%s_%s %s\n", namespace, name, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+
+	write("runs_total", "counter", "Number of export runs.", float64(r.runs))
+	write("failures_total", "counter", "Number of failed export runs.", float64(r.failures))
+	if !r.lastSuccess.IsZero() {
+		write("last_success_timestamp_seconds", "gauge", "Unix time the last successful export finished.", float64(r.lastSuccess.Unix()))
+	}
+
+	if r.last != nil {
+		success := 1.0
+		if r.last.Err != nil {
+			success = 0
+		}
+		write("last_run_timestamp_seconds", "gauge", "Unix time the last export finished.", float64(r.last.End.Unix()))
+		write("last_run_success", "gauge", "Whether the last export succeeded.", success)
+		write("last_run_duration_seconds", "gauge", "Duration of the last export.", r.last.End.Sub(r.last.Start).Seconds())
+		write("last_run_items", "gauge", "Items processed by the last export.", float64(r.last.Stats.ItemsDone))
+		write("last_run_items_total", "gauge", "Items reported by the server during the last export.", float64(r.last.Stats.ItemsTotal))
+		write("last_run_attachments", "gauge", "Attachments downloaded by the last export.", float64(r.last.Stats.Attachments))
+		write("last_run_skipped", "gauge", "Unchanged attachments skipped by the last export.", float64(r.last.Stats.Skipped))
+		write("last_run_bytes", "gauge", "Bytes downloaded by the last export.", float64(r.last.Stats.Bytes))
+		write("last_run_errors", "gauge", "Errors encountered by the last export.", float64(r.last.Stats.Errors))
+	}
+
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+// ServeHTTP exposes the metrics for Prometheus to scrape.
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	r.WriteTo(w)
+}
+
+// WriteTextfile atomically writes the metrics to path for the node_exporter
+// textfile collector.
+func (r *Recorder) WriteTextfile(path string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create metrics file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := r.WriteTo(tmp); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write metrics file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write metrics file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return fmt.Errorf("failed to write metrics file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write metrics file: %w", err)
+	}
+	return nil
+}
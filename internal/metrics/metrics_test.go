@@ -0,0 +1,136 @@
+package metrics
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kusold/homebox-export/internal/progress"
+)
+
+func testRun(err error) Run {
+	start := time.Unix(1700000000, 0)
+	return Run{
+		Start: start,
+		End:   start.Add(90 * time.Second),
+		Stats: progress.Stats{
+			ItemsDone:   12,
+			ItemsTotal:  12,
+			Attachments: 30,
+			Bytes:       4096,
+		},
+		Err: err,
+	}
+}
+
+func TestRecorder_WriteTo(t *testing.T) {
+	r := NewRecorder()
+	r.Record(testRun(nil))
+
+	var buf bytes.Buffer
+	if _, err := r.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"# TYPE homebox_export_runs_total counter",
+		"homebox_export_runs_total 1\n",
+		"homebox_export_failures_total 0\n",
+		"homebox_export_last_success_timestamp_seconds 1700000090\n",
+		"homebox_export_last_run_success 1\n",
+		"homebox_export_last_run_duration_seconds 90\n",
+		"homebox_export_last_run_items 12\n",
+		"homebox_export_last_run_attachments 30\n",
+		"homebox_export_last_run_bytes 4096\n",
+		"homebox_export_last_run_errors 0\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output missing %q\nGot:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestRecorder_FailureKeepsLastSuccess(t *testing.T) {
+	r := NewRecorder()
+	r.Record(testRun(nil))
+	failed := testRun(errors.New("boom"))
+	failed.End = failed.End.Add(time.Hour)
+	r.Record(failed)
+
+	var buf bytes.Buffer
+	r.WriteTo(&buf)
+
+	for _, want := range []string{
+		"homebox_export_runs_total 2\n",
+		"homebox_export_failures_total 1\n",
+		"homebox_export_last_success_timestamp_seconds 1700000090\n",
+		"homebox_export_last_run_success 0\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output missing %q\nGot:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestRecorder_Textfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "homebox_export.prom")
+
+	first := NewRecorder()
+	if err := first.Load(path); err != nil {
+		t.Fatalf("Load() of missing file error = %v", err)
+	}
+	first.Record(testRun(nil))
+	if err := first.WriteTextfile(path); err != nil {
+		t.Fatalf("WriteTextfile() error = %v", err)
+	}
+
+	// A later, failed run must keep the previous success timestamp.
+	second := NewRecorder()
+	if err := second.Load(path); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	second.Record(testRun(errors.New("login failed")))
+	if err := second.WriteTextfile(path); err != nil {
+		t.Fatalf("WriteTextfile() error = %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read textfile: %v", err)
+	}
+	for _, want := range []string{
+		"homebox_export_runs_total 2\n",
+		"homebox_export_failures_total 1\n",
+		"homebox_export_last_success_timestamp_seconds 1700000090\n",
+		"homebox_export_last_run_success 0\n",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("textfile missing %q\nGot:\n%s", want, content)
+		}
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(filepath.Dir(path), ".*.tmp"))
+	if len(matches) != 0 {
+		t.Errorf("temporary files left behind: %v", matches)
+	}
+}
+
+func TestRecorder_ServeHTTP(t *testing.T) {
+	r := NewRecorder()
+	r.Record(testRun(nil))
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("Content-Type = %q, want text/plain", ct)
+	}
+	if !strings.Contains(rec.Body.String(), "homebox_export_last_run_success 1") {
+		t.Errorf("body missing last run metric:\n%s", rec.Body.String())
+	}
+}
@@ -0,0 +1,675 @@
+// Package migrate copies the inventory of one Homebox account to another,
+// possibly on another server or fork: labels, locations, items with their
+// custom fields, attachments and maintenance. IDs differ between servers, so
+// everything copied is remapped through a State, which also makes a sync
+// resumable.
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/csvexport"
+	"github.com/kusold/homebox-export/internal/downloader"
+)
+
+// What to do with an item when the target already has one like it.
+const (
+	ConflictFail      = "fail"      // stop before changing anything
+	ConflictSkip      = "skip"      // keep the target's item instead of copying
+	ConflictDuplicate = "duplicate" // copy the item anyway
+)
+
+// ErrConflicts is returned when the target has items like the ones to copy
+// and conflicts are to fail the sync.
+var ErrConflicts = errors.New("the target already has some of the items")
+
+type Options struct {
+	Conflicts string // optional, ConflictFail, ConflictSkip or ConflictDuplicate; defaults to ConflictFail
+	DryRun    bool   // optional, only plans the sync without changing the target or the state
+	Logger    *slog.Logger
+}
+
+// Counts sums up what a sync did with one kind of object.
+type Counts struct {
+	Created int // copied to the target
+	Matched int // already on the target under the same name, and used instead
+	Skipped int // left out because of a conflict
+	Resumed int // copied by an earlier run, according to the state
+}
+
+// Conflict is an item the target seems to have already.
+type Conflict struct {
+	Item   homeboxclient.Item // on the source
+	Target homeboxclient.Item // the one it is like, a summary
+	Reason string
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%q (%s) is like %q on the target: %s", c.Item.Name, c.Item.ID, c.Target.Name, c.Reason)
+}
+
+type Result struct {
+	Labels      Counts
+	Locations   Counts
+	Items       Counts
+	Attachments Counts
+	Maintenance Counts
+	Conflicts   []Conflict
+}
+
+// account is one end of a sync.
+type account struct {
+	items       *homeboxclient.ItemsService
+	labels      *homeboxclient.LabelsService
+	locations   *homeboxclient.LocationsService
+	maintenance *homeboxclient.MaintenanceService
+}
+
+func newAccount(c *homeboxclient.Client) account {
+	return account{
+		items:       homeboxclient.NewItemsService(c),
+		labels:      homeboxclient.NewLabelsService(c),
+		locations:   homeboxclient.NewLocationsService(c),
+		maintenance: homeboxclient.NewMaintenanceService(c),
+	}
+}
+
+type Migrator struct {
+	from   account
+	to     account
+	state  *State
+	opts   Options
+	log    *slog.Logger
+	kept   map[string]string // source item ID to the target's item it was skipped for
+	result Result
+}
+
+// New returns a Migrator copying from one logged in client to another.
+func New(from, to *homeboxclient.Client, state *State, opts Options) *Migrator {
+	if opts.Conflicts == "" {
+		opts.Conflicts = ConflictFail
+	}
+	log := opts.Logger
+	if log == nil {
+		log = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	return &Migrator{
+		from:  newAccount(from),
+		to:    newAccount(to),
+		state: state,
+		opts:  opts,
+		log:   log,
+		kept:  make(map[string]string),
+	}
+}
+
+// location is a location with the path of names leading to it.
+type location struct {
+	homeboxclient.Location
+	ParentID string
+	Path     string
+}
+
+// snapshot is what an account holds, as far as a sync is concerned.
+type snapshot struct {
+	labels    []homeboxclient.Label
+	locations []location // parents before their children
+	items     []homeboxclient.Item
+}
+
+// Run copies everything the state doesn't list as copied yet. It plans the
+// whole sync first, and with ConflictFail returns ErrConflicts before
+// changing anything if the target has items like the ones to copy. The
+// result counts what was done, or with DryRun what would be done.
+func (m *Migrator) Run() (*Result, error) {
+	src, err := m.load(m.from, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source: %w", err)
+	}
+	dst, err := m.load(m.to, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read target: %w", err)
+	}
+	if err := m.checkState(dst); err != nil {
+		return nil, err
+	}
+
+	conflicts := m.findConflicts(src, dst)
+	m.result.Conflicts = conflicts
+	if len(conflicts) > 0 && m.opts.Conflicts == ConflictFail {
+		if m.opts.DryRun {
+			m.opts.Conflicts = ConflictSkip
+		} else {
+			return &m.result, ErrConflicts
+		}
+	}
+
+	if err := m.syncLabels(src, dst); err != nil {
+		return &m.result, err
+	}
+	if err := m.syncLocations(src, dst); err != nil {
+		return &m.result, err
+	}
+	if err := m.syncItems(src, conflicts); err != nil {
+		return &m.result, err
+	}
+	return &m.result, nil
+}
+
+// load reads the labels, locations and items of an account, archived items
+// included. Only the items of the source are fetched in full.
+func (m *Migrator) load(a account, full bool) (*snapshot, error) {
+	var s snapshot
+	var err error
+	if s.labels, err = a.labels.List(); err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	tree, err := a.locations.GetTree(false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list locations: %w", err)
+	}
+	// The tree lacks the descriptions.
+	list, err := a.locations.List(false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list locations: %w", err)
+	}
+	descriptions := make(map[string]string)
+	for _, l := range list {
+		descriptions[l.ID] = l.Description
+	}
+	var walk func(nodes []homeboxclient.Location, parent location)
+	walk = func(nodes []homeboxclient.Location, parent location) {
+		for _, n := range nodes {
+			l := location{Location: n, ParentID: parent.ID, Path: n.Name}
+			if parent.ID != "" {
+				l.Path = parent.Path + " / " + n.Name
+			}
+			l.Description = descriptions[n.ID]
+			l.Children = nil
+			s.locations = append(s.locations, l)
+			walk(n.Children, l)
+		}
+	}
+	walk(tree, location{})
+
+	items := withArchived{a.items}
+	if full {
+		s.items, err = downloader.FetchItems(context.Background(), items, 100)
+	} else {
+		s.items, err = downloader.ListItems(items, 100)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// withArchived lists archived items along with the others, which Homebox
+// leaves out by default.
+type withArchived struct {
+	*homeboxclient.ItemsService
+}
+
+func (w withArchived) List(page, pageSize int) (*homeboxclient.PaginationResult[homeboxclient.Item], error) {
+	return w.ListWithArchived(page, pageSize)
+}
+
+// checkState fails if the state refers to something that was deleted on the
+// target since, as copying it again could go unnoticed.
+func (m *Migrator) checkState(dst *snapshot) error {
+	exists := make(map[string]bool)
+	for _, l := range dst.labels {
+		exists[l.ID] = true
+	}
+	for _, l := range dst.locations {
+		exists[l.ID] = true
+	}
+	for _, item := range dst.items {
+		exists[item.ID] = true
+	}
+	for kind, ids := range map[string]map[string]string{"label": m.state.Labels, "location": m.state.Locations, "item": m.state.Items} {
+		for _, id := range ids {
+			if !exists[id] {
+				return fmt.Errorf("the state refers to %s %s, which is no longer on the target; remove it from %s to copy it again", kind, id, m.state.path)
+			}
+		}
+	}
+	return nil
+}
+
+// findConflicts returns the items that are like an item on the target that
+// isn't a copy: with the same asset ID, or with the same name in the same
+// location. Items copied by an earlier run are checked as well, so that their
+// asset ID isn't set again.
+func (m *Migrator) findConflicts(src, dst *snapshot) []Conflict {
+	copies := make(map[string]bool)
+	for _, id := range m.state.Items {
+		copies[id] = true
+	}
+	srcPaths, dstPaths := locationPaths(src), locationPaths(dst)
+	byAssetID := make(map[string]homeboxclient.Item)
+	byName := make(map[string]homeboxclient.Item)
+	for _, item := range dst.items {
+		if copies[item.ID] {
+			continue
+		}
+		if id := csvexport.FormatAssetID(item.AssetID); id != "" {
+			byAssetID[id] = item
+		}
+		byName[nameKey(item, dstPaths)] = item
+	}
+
+	var conflicts []Conflict
+	for _, item := range src.items {
+		if target, ok := byAssetID[csvexport.FormatAssetID(item.AssetID)]; ok {
+			conflicts = append(conflicts, Conflict{Item: item, Target: target, Reason: "same asset ID " + item.AssetID})
+		} else if target, ok := byName[nameKey(item, srcPaths)]; ok {
+			conflicts = append(conflicts, Conflict{Item: item, Target: target, Reason: "same name and location"})
+		}
+	}
+	return conflicts
+}
+
+func locationPaths(s *snapshot) map[string]string {
+	paths := make(map[string]string)
+	for _, l := range s.locations {
+		paths[l.ID] = l.Path
+	}
+	return paths
+}
+
+func nameKey(item homeboxclient.Item, paths map[string]string) string {
+	var p string
+	if item.Location != nil {
+		p = paths[item.Location.ID]
+	}
+	return strings.ToLower(p + "\x00" + item.Name)
+}
+
+func (m *Migrator) syncLabels(src, dst *snapshot) error {
+	existing := make(map[string]string)
+	for _, l := range dst.labels {
+		existing[strings.ToLower(l.Name)] = l.ID
+	}
+
+	for _, l := range src.labels {
+		if _, ok := m.state.Labels[l.ID]; ok {
+			m.result.Labels.Resumed++
+			continue
+		}
+		if id, ok := existing[strings.ToLower(l.Name)]; ok {
+			m.log.Info("using existing label", "label", l.Name)
+			m.result.Labels.Matched++
+			if err := m.record(m.state.Labels, l.ID, id); err != nil {
+				return err
+			}
+			continue
+		}
+
+		m.log.Info("creating label", "label", l.Name)
+		m.result.Labels.Created++
+		if m.opts.DryRun {
+			continue
+		}
+		created, err := m.to.labels.Create(&homeboxclient.LabelCreate{Name: l.Name, Description: l.Description, Color: l.Color})
+		if err != nil {
+			return fmt.Errorf("failed to create label %q: %w", l.Name, err)
+		}
+		if err := m.record(m.state.Labels, l.ID, created.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) syncLocations(src, dst *snapshot) error {
+	existing := make(map[string]string)
+	for _, l := range dst.locations {
+		existing[strings.ToLower(l.Path)] = l.ID
+	}
+
+	for _, l := range src.locations {
+		if _, ok := m.state.Locations[l.ID]; ok {
+			m.result.Locations.Resumed++
+			continue
+		}
+		if id, ok := existing[strings.ToLower(l.Path)]; ok {
+			m.log.Info("using existing location", "location", l.Path)
+			m.result.Locations.Matched++
+			if err := m.record(m.state.Locations, l.ID, id); err != nil {
+				return err
+			}
+			continue
+		}
+
+		m.log.Info("creating location", "location", l.Path)
+		m.result.Locations.Created++
+		if m.opts.DryRun {
+			continue
+		}
+		created, err := m.to.locations.Create(&homeboxclient.LocationCreate{
+			Name:        l.Name,
+			Description: l.Description,
+			ParentID:    m.state.Locations[l.ParentID],
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create location %q: %w", l.Path, err)
+		}
+		if err := m.record(m.state.Locations, l.ID, created.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) syncItems(src *snapshot, conflicts []Conflict) error {
+	conflicting := make(map[string]Conflict)
+	for _, c := range conflicts {
+		conflicting[c.Item.ID] = c
+	}
+
+	for _, item := range parentsFirst(src.items) {
+		log := m.log.With("item", item.Name, "item_id", item.ID)
+		id, resumed := m.state.Items[item.ID]
+		c, conflict := conflicting[item.ID]
+		switch {
+		case resumed && m.state.Completed[item.ID]:
+			// Changes made on the target since are kept.
+			m.result.Items.Resumed++
+			continue
+		case resumed:
+			m.result.Items.Resumed++
+		case conflict && m.opts.Conflicts == ConflictSkip:
+			log.Info("skipping item like one on the target", "reason", c.Reason)
+			m.result.Items.Skipped++
+			// Children of the item go below the target's one. It isn't
+			// recorded in the state, as it mustn't be updated on resume.
+			m.kept[item.ID] = c.Target.ID
+			continue
+		case m.opts.DryRun:
+			log.Info("creating item")
+			m.result.Items.Created++
+			m.result.Attachments.Created += len(item.Attachments)
+			entries, err := m.from.maintenance.GetItemMaintenance(item.ID, homeboxclient.MaintenanceFilterStatusBoth)
+			if err != nil {
+				return fmt.Errorf("failed to list maintenance of %q: %w", item.Name, err)
+			}
+			m.result.Maintenance.Created += len(entries)
+			continue
+		default:
+			if conflict {
+				log.Info("copying item like one on the target", "reason", c.Reason)
+			} else {
+				log.Info("creating item")
+			}
+			created, err := m.to.items.Create(&homeboxclient.ItemCreate{
+				Name:        item.Name,
+				Description: item.Description,
+				LabelIDs:    m.labelIDs(item),
+				LocationID:  m.locationID(item),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create item %q: %w", item.Name, err)
+			}
+			m.result.Items.Created++
+			id = created.ID
+			if err := m.record(m.state.Items, item.ID, id); err != nil {
+				return err
+			}
+		}
+		if m.opts.DryRun {
+			continue
+		}
+
+		// An item created by an interrupted run is updated again, which
+		// completes it if the run stopped before the update.
+		update := m.itemUpdate(item, id)
+		if conflict && update.AssetID == csvexport.FormatAssetID(c.Target.AssetID) {
+			// Asset IDs have to stay unique to be looked up.
+			update.AssetID = ""
+		}
+		updated, err := m.to.items.Update(id, update)
+		if err != nil {
+			return fmt.Errorf("failed to update item %q: %w", item.Name, err)
+		}
+		if err := m.syncAttachments(log, item, id, updated.Attachments); err != nil {
+			return err
+		}
+		if err := m.syncMaintenance(log, item, id); err != nil {
+			return err
+		}
+		m.state.Completed[item.ID] = true
+		if err := m.state.Save(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parentsFirst orders items so that every item comes after its parent.
+func parentsFirst(items []homeboxclient.Item) []homeboxclient.Item {
+	byID := make(map[string]homeboxclient.Item)
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+	ordered := make([]homeboxclient.Item, 0, len(items))
+	added := make(map[string]bool)
+	var add func(item homeboxclient.Item)
+	add = func(item homeboxclient.Item) {
+		if added[item.ID] {
+			return
+		}
+		added[item.ID] = true
+		if item.Parent != nil {
+			if parent, ok := byID[item.Parent.ID]; ok {
+				add(parent)
+			}
+		}
+		ordered = append(ordered, item)
+	}
+	for _, item := range items {
+		add(item)
+	}
+	return ordered
+}
+
+func (m *Migrator) labelIDs(item homeboxclient.Item) []string {
+	ids := []string{}
+	for _, l := range item.Labels {
+		if id, ok := m.state.Labels[l.ID]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (m *Migrator) locationID(item homeboxclient.Item) string {
+	if item.Location == nil {
+		return ""
+	}
+	return m.state.Locations[item.Location.ID]
+}
+
+// itemUpdate returns the update setting every field of the copy with ID id
+// to that of item.
+func (m *Migrator) itemUpdate(item homeboxclient.Item, id string) *homeboxclient.ItemUpdate {
+	fields := make([]homeboxclient.ItemField, len(item.Fields))
+	for i, f := range item.Fields {
+		f.ID = ""
+		fields[i] = f
+	}
+	var parentID string
+	if item.Parent != nil {
+		parentID = m.state.Items[item.Parent.ID]
+		if parentID == "" {
+			parentID = m.kept[item.Parent.ID]
+		}
+	}
+
+	return &homeboxclient.ItemUpdate{
+		ID:               id,
+		Name:             item.Name,
+		Description:      item.Description,
+		LabelIDs:         m.labelIDs(item),
+		LocationID:       m.locationID(item),
+		ParentID:         parentID,
+		Fields:           fields,
+		Archived:         item.Archived,
+		AssetID:          csvexport.FormatAssetID(item.AssetID),
+		Insured:          item.Insured,
+		Manufacturer:     item.Manufacturer,
+		ModelNumber:      item.ModelNumber,
+		SerialNumber:     item.SerialNumber,
+		PurchaseFrom:     item.PurchaseFrom,
+		PurchasePrice:    item.PurchasePrice,
+		PurchaseTime:     parseDate(item.PurchaseTime),
+		Quantity:         item.Quantity,
+		Notes:            item.Notes,
+		LifetimeWarranty: item.LifetimeWarranty,
+		WarrantyDetails:  item.WarrantyDetails,
+		WarrantyExpires:  parseDate(item.WarrantyExpires),
+		SoldTo:           item.SoldTo,
+		SoldPrice:        item.SoldPrice,
+		SoldTime:         parseDate(item.SoldTime),
+		SoldNotes:        item.SoldNotes,
+	}
+}
+
+// parseDate parses a date from the API, returning the zero time, which
+// Homebox takes as unset, if there is none.
+func parseDate(s string) time.Time {
+	t, err := time.Parse(time.DateOnly, csvexport.FormatDate(s))
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func (m *Migrator) syncAttachments(log *slog.Logger, item homeboxclient.Item, id string, existing []homeboxclient.Attachment) error {
+	for _, a := range item.Attachments {
+		if _, ok := m.state.Attachments[a.ID]; ok {
+			m.result.Attachments.Resumed++
+			continue
+		}
+
+		name := a.Document.Title
+		if name == "" {
+			name = path.Base(a.Document.Path)
+		}
+		// A run interrupted between uploading and recording an attachment
+		// left it on the target already.
+		copied, ok := uncopiedAttachment(existing, m.state.Attachments, name, a.Type)
+		if ok {
+			log.Info("using attachment already on the target", "attachment", name, "type", a.Type)
+			m.result.Attachments.Matched++
+		} else {
+			log.Info("copying attachment", "attachment", name, "type", a.Type)
+			content, err := m.from.items.OpenAttachment(item.ID, a.ID)
+			if err != nil {
+				return fmt.Errorf("failed to download attachment %q of %q: %w", name, item.Name, err)
+			}
+			updated, err := m.to.items.UploadAttachment(id, name, a.Type, content)
+			content.Close()
+			if err != nil {
+				return fmt.Errorf("failed to upload attachment %q of %q: %w", name, item.Name, err)
+			}
+			if copied, ok = newAttachment(updated.Attachments, m.state.Attachments); !ok {
+				return fmt.Errorf("the target didn't return the attachment %q of %q", name, item.Name)
+			}
+			m.result.Attachments.Created++
+		}
+
+		if a.Primary && !copied.Primary {
+			update := &homeboxclient.AttachmentUpdate{Title: name, Type: a.Type, Primary: true}
+			if _, err := m.to.items.UpdateAttachment(id, copied.ID, update); err != nil {
+				return fmt.Errorf("failed to make %q the primary photo of %q: %w", name, item.Name, err)
+			}
+		}
+		if err := m.record(m.state.Attachments, a.ID, copied.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uncopiedAttachment returns the attachment with title and type that isn't a
+// copy of a source attachment yet.
+func uncopiedAttachment(attachments []homeboxclient.Attachment, copied map[string]string, title, attachmentType string) (homeboxclient.Attachment, bool) {
+	known := make(map[string]bool)
+	for _, id := range copied {
+		known[id] = true
+	}
+	for _, a := range attachments {
+		if !known[a.ID] && a.Document.Title == title && a.Type == attachmentType {
+			return a, true
+		}
+	}
+	return homeboxclient.Attachment{}, false
+}
+
+// newAttachment returns the attachment that isn't a copy of a source
+// attachment yet, which is the one just uploaded. Of several, such as ones
+// added on the target by hand, it returns the newest.
+func newAttachment(attachments []homeboxclient.Attachment, copied map[string]string) (homeboxclient.Attachment, bool) {
+	known := make(map[string]bool)
+	for _, id := range copied {
+		known[id] = true
+	}
+	var candidates []homeboxclient.Attachment
+	for _, a := range attachments {
+		if !known[a.ID] {
+			candidates = append(candidates, a)
+		}
+	}
+	if len(candidates) == 0 {
+		return homeboxclient.Attachment{}, false
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].CreatedAt.After(candidates[j].CreatedAt) })
+	return candidates[0], true
+}
+
+func (m *Migrator) syncMaintenance(log *slog.Logger, item homeboxclient.Item, id string) error {
+	entries, err := m.from.maintenance.GetItemMaintenance(item.ID, homeboxclient.MaintenanceFilterStatusBoth)
+	if err != nil {
+		return fmt.Errorf("failed to list maintenance of %q: %w", item.Name, err)
+	}
+	for _, e := range entries {
+		if _, ok := m.state.Maintenance[e.ID]; ok {
+			m.result.Maintenance.Resumed++
+			continue
+		}
+
+		log.Info("copying maintenance", "maintenance", e.Name)
+		entry := e.MaintenanceEntry
+		entry.ID = ""
+		created, err := m.to.maintenance.Create(id, &entry)
+		if err != nil {
+			return fmt.Errorf("failed to create maintenance %q of %q: %w", e.Name, item.Name, err)
+		}
+		m.result.Maintenance.Created++
+		if err := m.record(m.state.Maintenance, e.ID, created.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// record maps a source ID to a target ID and saves the state, unless this is
+// a dry run.
+func (m *Migrator) record(ids map[string]string, from, to string) error {
+	if m.opts.DryRun {
+		return nil
+	}
+	ids[from] = to
+	return m.state.Save()
+}
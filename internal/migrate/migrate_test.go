@@ -0,0 +1,325 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/fakehomebox"
+)
+
+func login(t *testing.T, s *fakehomebox.Server) *homeboxclient.Client {
+	t.Helper()
+	srv := s.Start()
+	t.Cleanup(srv.Close)
+	c, err := homeboxclient.NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Login("user", "pass"); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// newSource returns a source with a drill in the garage, with a manual, a
+// photo and maintenance, and a drill bit belonging to the drill.
+func newSource() *fakehomebox.Server {
+	return fakehomebox.New(fakehomebox.Fixtures{
+		Labels:    []homeboxclient.Label{{ID: "tools", Name: "Tools"}},
+		Locations: []fakehomebox.Location{{ID: "home", Name: "Home"}, {ID: "garage", Name: "Garage", Description: "Garage description", ParentID: "home"}},
+		Items: []fakehomebox.Item{
+			{
+				Item: homeboxclient.Item{
+					ID: "drill", Name: "Drill", AssetID: "000-001", Quantity: 1, PurchasePrice: 129.5, WarrantyExpires: "2027-05-01",
+					Fields: []homeboxclient.ItemField{{ID: "field-1", Name: "Voltage", Type: "text", TextValue: "18V"}},
+				},
+				LocationID: "garage",
+				LabelIDs:   []string{"tools"},
+				Attachments: []fakehomebox.Attachment{
+					{Title: "manual.pdf", Type: homeboxclient.AttachmentTypeManual, Content: "manual"},
+					{Title: "drill.jpg", Type: homeboxclient.AttachmentTypePhoto, Primary: true, Content: "photo"},
+				},
+				Maintenance: []homeboxclient.MaintenanceEntry{{Name: "Oil", Cost: "5", ScheduledDate: "2025-06-01"}},
+			},
+			{Item: homeboxclient.Item{ID: "bit", Name: "Bit"}, LocationID: "garage", ParentID: "drill"},
+		},
+	})
+}
+
+func run(t *testing.T, from, to *fakehomebox.Server, statePath string, opts Options) (*Result, error) {
+	t.Helper()
+	state, err := LoadState(statePath, "from", "to")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(login(t, from), login(t, to), state, opts).Run()
+}
+
+func TestRun(t *testing.T) {
+	src := newSource()
+	dst := fakehomebox.New(fakehomebox.Fixtures{
+		Labels:    []homeboxclient.Label{{Name: "tools"}},
+		Locations: []fakehomebox.Location{{Name: "Home"}},
+	})
+	statePath := filepath.Join(t.TempDir(), "state.json")
+
+	result, err := run(t, src, dst, statePath, Options{})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	want := Result{
+		Labels:      Counts{Matched: 1},
+		Locations:   Counts{Created: 1, Matched: 1},
+		Items:       Counts{Created: 2},
+		Attachments: Counts{Created: 2},
+		Maintenance: Counts{Created: 1},
+	}
+	if fmt.Sprint(*result) != fmt.Sprint(want) {
+		t.Errorf("Run() = %+v, want %+v", *result, want)
+	}
+
+	target := dst.Fixtures()
+	if len(target.Labels) != 1 || len(target.Locations) != 2 || len(target.Items) != 2 {
+		t.Fatalf("target has %d labels, %d locations, %d items, want 1, 2, 2", len(target.Labels), len(target.Locations), len(target.Items))
+	}
+	garage := target.Locations[1]
+	if garage.Name != "Garage" || garage.ParentID != target.Locations[0].ID || garage.Description != "Garage description" {
+		t.Errorf("garage = %+v, want it below Home", garage)
+	}
+
+	drill, bit := target.Items[0], target.Items[1]
+	if drill.Name != "Drill" || drill.AssetID != "000-001" || drill.PurchasePrice != 129.5 || drill.WarrantyExpires != "2027-05-01" {
+		t.Errorf("drill = %+v", drill.Item)
+	}
+	if drill.LocationID != garage.ID || len(drill.LabelIDs) != 1 || drill.LabelIDs[0] != target.Labels[0].ID {
+		t.Errorf("drill location = %q, labels = %q", drill.LocationID, drill.LabelIDs)
+	}
+	if len(drill.Fields) != 1 || drill.Fields[0].ID == "field-1" || drill.Fields[0].TextValue != "18V" {
+		t.Errorf("drill fields = %+v", drill.Fields)
+	}
+	if len(drill.Attachments) != 2 {
+		t.Fatalf("drill attachments = %+v", drill.Attachments)
+	}
+	for _, a := range drill.Attachments {
+		wantPrimary := a.Type == homeboxclient.AttachmentTypePhoto
+		if a.Primary != wantPrimary || a.Content != map[string]string{"manual.pdf": "manual", "drill.jpg": "photo"}[a.Title] {
+			t.Errorf("attachment = %+v", a)
+		}
+	}
+	if m := drill.Maintenance; len(m) != 1 || m[0].Name != "Oil" || m[0].Cost != "5" || m[0].ScheduledDate != "2025-06-01" {
+		t.Errorf("drill maintenance = %+v", m)
+	}
+	if bit.ParentID != drill.ID {
+		t.Errorf("bit parent = %q, want the drill", bit.ParentID)
+	}
+
+	// A second run finds everything copied, and keeps what was changed on
+	// the target since.
+	update := &homeboxclient.ItemUpdate{ID: bit.ID, Name: "Bit", Notes: "Worn", LocationID: garage.ID, ParentID: drill.ID}
+	if _, err := homeboxclient.NewItemsService(login(t, dst)).Update(bit.ID, update); err != nil {
+		t.Fatal(err)
+	}
+	result, err = run(t, src, dst, statePath, Options{})
+	if err != nil {
+		t.Fatalf("second Run() error = %v", err)
+	}
+	want = Result{
+		Labels:    Counts{Resumed: 1},
+		Locations: Counts{Resumed: 2},
+		Items:     Counts{Resumed: 2},
+	}
+	if fmt.Sprint(*result) != fmt.Sprint(want) {
+		t.Errorf("second Run() = %+v, want %+v", *result, want)
+	}
+	target = dst.Fixtures()
+	if len(target.Items) != 2 || len(target.Items[0].Attachments) != 2 || len(target.Items[0].Maintenance) != 1 {
+		t.Errorf("second run copied again: %d items, %d attachments", len(target.Items), len(target.Items[0].Attachments))
+	}
+	if notes := target.Items[1].Notes; notes != "Worn" {
+		t.Errorf("bit notes = %q, want the target's edit kept", notes)
+	}
+}
+
+func TestRun_Resume(t *testing.T) {
+	src := newSource()
+	dst := fakehomebox.New(fakehomebox.Fixtures{})
+	dst.AddFault(fakehomebox.Fault{Method: "POST", Path: "/api/v1/items/*/attachments", Status: 500, Times: 1})
+	statePath := filepath.Join(t.TempDir(), "state.json")
+
+	if _, err := run(t, src, dst, statePath, Options{}); err == nil || !strings.Contains(err.Error(), `failed to upload attachment "manual.pdf" of "Drill"`) {
+		t.Fatalf("Run() error = %v, want the upload to fail", err)
+	}
+
+	result, err := run(t, src, dst, statePath, Options{})
+	if err != nil {
+		t.Fatalf("resumed Run() error = %v", err)
+	}
+	if result.Items != (Counts{Created: 1, Resumed: 1}) || result.Attachments != (Counts{Created: 2}) {
+		t.Errorf("resumed Run() = %+v", *result)
+	}
+	target := dst.Fixtures()
+	if len(target.Items) != 2 || len(target.Items[0].Attachments) != 2 {
+		t.Errorf("target has %d items, the drill %d attachments, want 2 and 2", len(target.Items), len(target.Items[0].Attachments))
+	}
+}
+
+func TestRun_ResumeAfterUpload(t *testing.T) {
+	src := newSource()
+	dst := fakehomebox.New(fakehomebox.Fixtures{})
+	// The target saves the manual, but the response is lost, so the run
+	// stops before recording it.
+	dst.AddFault(fakehomebox.Fault{Method: "POST", Path: "/api/v1/items/*/attachments", Truncate: true, Times: 1})
+	statePath := filepath.Join(t.TempDir(), "state.json")
+
+	if _, err := run(t, src, dst, statePath, Options{}); err == nil || !strings.Contains(err.Error(), `failed to upload attachment "manual.pdf" of "Drill"`) {
+		t.Fatalf("Run() error = %v, want the upload to fail", err)
+	}
+
+	result, err := run(t, src, dst, statePath, Options{})
+	if err != nil {
+		t.Fatalf("resumed Run() error = %v", err)
+	}
+	if result.Attachments != (Counts{Created: 1, Matched: 1}) {
+		t.Errorf("resumed Run() attachments = %+v, want the manual matched", result.Attachments)
+	}
+	if attachments := dst.Fixtures().Items[0].Attachments; len(attachments) != 2 {
+		t.Errorf("drill attachments = %+v, want the manual once and the photo", attachments)
+	}
+}
+
+func TestRun_Archived(t *testing.T) {
+	src := fakehomebox.New(fakehomebox.Fixtures{
+		Items: []fakehomebox.Item{{Item: homeboxclient.Item{ID: "radio", Name: "Old radio", Archived: true}}},
+	})
+	dst := fakehomebox.New(fakehomebox.Fixtures{})
+	statePath := filepath.Join(t.TempDir(), "state.json")
+
+	result, err := run(t, src, dst, statePath, Options{})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if result.Items != (Counts{Created: 1}) {
+		t.Errorf("Run() items = %+v, want the archived item created", result.Items)
+	}
+	if items := dst.Fixtures().Items; len(items) != 1 || !items[0].Archived {
+		t.Fatalf("target items = %+v, want the radio archived", items)
+	}
+
+	// The archived copy is found on the target again.
+	result, err = run(t, src, dst, statePath, Options{})
+	if err != nil {
+		t.Fatalf("second Run() error = %v", err)
+	}
+	if result.Items != (Counts{Resumed: 1}) {
+		t.Errorf("second Run() items = %+v, want the radio resumed", result.Items)
+	}
+}
+
+func TestRun_Conflicts(t *testing.T) {
+	tests := []struct {
+		name      string
+		conflicts string
+		dryRun    bool
+		wantErr   error
+		wantItems Counts
+		// wantTarget is the names and asset IDs of the target's items.
+		wantTarget []string
+	}{
+		{
+			name:       "fail",
+			conflicts:  ConflictFail,
+			wantErr:    ErrConflicts,
+			wantTarget: []string{"Old drill 000-001"},
+		},
+		{
+			name:       "skip",
+			conflicts:  ConflictSkip,
+			wantItems:  Counts{Created: 1, Skipped: 1},
+			wantTarget: []string{"Old drill 000-001", "Bit "},
+		},
+		{
+			name:       "duplicate",
+			conflicts:  ConflictDuplicate,
+			wantItems:  Counts{Created: 2},
+			wantTarget: []string{"Old drill 000-001", "Drill ", "Bit "},
+		},
+		{
+			name:       "dry run",
+			conflicts:  ConflictFail,
+			dryRun:     true,
+			wantItems:  Counts{Created: 1, Skipped: 1},
+			wantTarget: []string{"Old drill 000-001"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := newSource()
+			dst := fakehomebox.New(fakehomebox.Fixtures{
+				Items: []fakehomebox.Item{{Item: homeboxclient.Item{ID: "old", Name: "Old drill", AssetID: "000-001"}}},
+			})
+			statePath := filepath.Join(t.TempDir(), "state.json")
+
+			result, err := run(t, src, dst, statePath, Options{Conflicts: tt.conflicts, DryRun: tt.dryRun})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Run() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(result.Conflicts) != 1 || result.Conflicts[0].Target.ID != "old" {
+				t.Errorf("conflicts = %+v, want the drill", result.Conflicts)
+			}
+			if result.Items != tt.wantItems {
+				t.Errorf("items = %+v, want %+v", result.Items, tt.wantItems)
+			}
+
+			target := dst.Fixtures()
+			var items []string
+			for _, item := range target.Items {
+				items = append(items, item.Name+" "+item.AssetID)
+			}
+			if strings.Join(items, ", ") != strings.Join(tt.wantTarget, ", ") {
+				t.Errorf("target items = %q, want %q", items, tt.wantTarget)
+			}
+			if tt.conflicts == ConflictSkip && target.Items[1].ParentID != "old" {
+				t.Errorf("bit parent = %q, want the target's drill", target.Items[1].ParentID)
+			}
+			if tt.wantErr != nil || tt.dryRun {
+				if len(target.Labels) != 0 || len(target.Locations) != 0 {
+					t.Errorf("target changed: %d labels, %d locations", len(target.Labels), len(target.Locations))
+				}
+				if _, err := os.Stat(statePath); err == nil {
+					t.Error("state was saved")
+				}
+			}
+		})
+	}
+}
+
+func TestLoadState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	s, err := LoadState(path, "http://old", "http://new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Items["a"] = "b"
+	if err := s.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = LoadState(path, "http://old", "http://new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Items["a"] != "b" || s.Labels == nil {
+		t.Errorf("LoadState() = %+v", s)
+	}
+
+	_, err = LoadState(path, "http://old", "http://other")
+	if err == nil || err.Error() != "state "+path+" is of a sync from http://old to http://new, not from http://old to http://other" {
+		t.Errorf("LoadState() error = %v", err)
+	}
+}
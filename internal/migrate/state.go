@@ -0,0 +1,85 @@
+package migrate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// State maps what was copied from the source to its copy on the target, by
+// ID. It is saved after every change, so that an interrupted sync picks up
+// where it stopped instead of creating everything twice. Items are listed in
+// Completed once their fields, attachments and maintenance are copied as
+// well, and are left alone from then on.
+type State struct {
+	path string
+
+	From        string            `json:"from"`
+	To          string            `json:"to"`
+	Labels      map[string]string `json:"labels"`
+	Locations   map[string]string `json:"locations"`
+	Items       map[string]string `json:"items"`
+	Attachments map[string]string `json:"attachments"`
+	Maintenance map[string]string `json:"maintenance"`
+	Completed   map[string]bool   `json:"completed"` // source item IDs
+}
+
+// LoadState reads the state of a sync from one account to another from path,
+// or starts a new one if path doesn't exist yet. A state of a sync between
+// other accounts is an error, as its IDs would be meaningless.
+func LoadState(path, from, to string) (*State, error) {
+	s := &State{path: path, From: from, To: to}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return nil, fmt.Errorf("failed to read state: %w", err)
+	default:
+		if err := json.Unmarshal(data, s); err != nil {
+			return nil, fmt.Errorf("failed to parse state %s: %w", path, err)
+		}
+		if s.From != from || s.To != to {
+			return nil, fmt.Errorf("state %s is of a sync from %s to %s, not from %s to %s", path, s.From, s.To, from, to)
+		}
+	}
+
+	for _, m := range []*map[string]string{&s.Labels, &s.Locations, &s.Items, &s.Attachments, &s.Maintenance} {
+		if *m == nil {
+			*m = make(map[string]string)
+		}
+	}
+	if s.Completed == nil {
+		s.Completed = make(map[string]bool)
+	}
+	return s, nil
+}
+
+// Save writes the state to its file, replacing it only once the new state is
+// complete.
+func (s *State) Save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode state: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,345 @@
+package progress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	ModeAuto = "auto"
+	ModeTTY  = "tty"
+	ModeJSON = "json"
+	ModeNone = "none"
+)
+
+// Tracker counts the work done by an export. It is safe for concurrent use.
+type Tracker struct {
+	mu          sync.Mutex
+	start       time.Time
+	itemsTotal  int
+	itemsDone   int
+	attachments int
+	skipped     int
+	bytes       int64
+	errors      int
+	now         func() time.Time
+}
+
+// Stats is a point in time view of a Tracker.
+type Stats struct {
+	ItemsDone      int
+	ItemsTotal     int
+	Attachments    int
+	Skipped        int
+	Bytes          int64
+	Errors         int
+	Elapsed        time.Duration
+	BytesPerSecond float64
+	ETA            time.Duration
+}
+
+func NewTracker() *Tracker {
+	t := &Tracker{now: time.Now}
+	t.start = t.now()
+	return t
+}
+
+// Reset clears all counters and restarts the clock.
+func (t *Tracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.start = t.now()
+	t.itemsTotal = 0
+	t.itemsDone = 0
+	t.attachments = 0
+	t.skipped = 0
+	t.bytes = 0
+	t.errors = 0
+}
+
+// SetTotal sets the number of items the export expects to process.
+func (t *Tracker) SetTotal(total int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.itemsTotal = total
+}
+
+func (t *Tracker) ItemDone() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.itemsDone++
+}
+
+func (t *Tracker) AttachmentDone(bytes int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.attachments++
+	t.bytes += bytes
+}
+
+// AttachmentSkipped counts an attachment that was already up to date.
+func (t *Tracker) AttachmentSkipped() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.skipped++
+}
+
+func (t *Tracker) Error() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.errors++
+}
+
+func (t *Tracker) Stats() Stats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := Stats{
+		ItemsDone:   t.itemsDone,
+		ItemsTotal:  t.itemsTotal,
+		Attachments: t.attachments,
+		Skipped:     t.skipped,
+		Bytes:       t.bytes,
+		Errors:      t.errors,
+		Elapsed:     t.now().Sub(t.start),
+	}
+	if secs := s.Elapsed.Seconds(); secs > 0 {
+		s.BytesPerSecond = float64(s.Bytes) / secs
+	}
+	if s.ItemsDone > 0 && s.ItemsTotal > s.ItemsDone {
+		perItem := s.Elapsed / time.Duration(s.ItemsDone)
+		s.ETA = perItem * time.Duration(s.ItemsTotal-s.ItemsDone)
+	}
+	return s
+}
+
+// Add returns the combined counters of two exports that ran one after the
+// other.
+func (s Stats) Add(o Stats) Stats {
+	sum := Stats{
+		ItemsDone:   s.ItemsDone + o.ItemsDone,
+		ItemsTotal:  s.ItemsTotal + o.ItemsTotal,
+		Attachments: s.Attachments + o.Attachments,
+		Skipped:     s.Skipped + o.Skipped,
+		Bytes:       s.Bytes + o.Bytes,
+		Errors:      s.Errors + o.Errors,
+		Elapsed:     s.Elapsed + o.Elapsed,
+	}
+	if secs := sum.Elapsed.Seconds(); secs > 0 {
+		sum.BytesPerSecond = float64(sum.Bytes) / secs
+	}
+	return sum
+}
+
+// Event is the JSON representation of Stats written by ModeJSON reporters.
+// Durations are in seconds so wrapper scripts don't need to parse Go duration
+// strings.
+type Event struct {
+	Event          string  `json:"event"`
+	ItemsDone      int     `json:"items_done"`
+	ItemsTotal     int     `json:"items_total"`
+	Attachments    int     `json:"attachments"`
+	Skipped        int     `json:"skipped"`
+	Bytes          int64   `json:"bytes"`
+	Errors         int     `json:"errors"`
+	BytesPerSecond float64 `json:"bytes_per_second"`
+	ElapsedSeconds float64 `json:"elapsed_seconds"`
+	ETASeconds     float64 `json:"eta_seconds"`
+}
+
+func NewEvent(event string, s Stats) Event {
+	return Event{
+		Event:          event,
+		ItemsDone:      s.ItemsDone,
+		ItemsTotal:     s.ItemsTotal,
+		Attachments:    s.Attachments,
+		Skipped:        s.Skipped,
+		Bytes:          s.Bytes,
+		Errors:         s.Errors,
+		BytesPerSecond: s.BytesPerSecond,
+		ElapsedSeconds: s.Elapsed.Seconds(),
+		ETASeconds:     s.ETA.Seconds(),
+	}
+}
+
+// Reporter periodically writes a Tracker's stats to w, either as a single
+// redrawn line for terminals or as JSON events.
+type Reporter struct {
+	w        io.Writer
+	mode     string
+	interval time.Duration
+	tracker  *Tracker
+
+	mu     sync.Mutex // serializes writes to w
+	active bool       // whether the TTY line is on screen
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// NewReporter returns a reporter for the given mode. ModeAuto resolves to
+// ModeTTY when w is a terminal and ModeNone otherwise. A nil Reporter is
+// returned for ModeNone; its methods are no-ops.
+func NewReporter(w io.Writer, mode string, interval time.Duration, tracker *Tracker) (*Reporter, error) {
+	switch mode {
+	case "", ModeAuto:
+		if !IsTerminal(w) {
+			return nil, nil
+		}
+		mode = ModeTTY
+	case ModeTTY, ModeJSON:
+	case ModeNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("invalid progress mode %q (valid modes: auto, tty, json, none)", mode)
+	}
+
+	return &Reporter{
+		w:        w,
+		mode:     mode,
+		interval: interval,
+		tracker:  tracker,
+	}, nil
+}
+
+// Start begins reporting in the background until Stop is called.
+func (r *Reporter) Start() {
+	if r == nil {
+		return
+	}
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	r.mu.Lock()
+	r.active = true
+	r.mu.Unlock()
+
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.report("progress")
+			case <-r.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops reporting and writes a final report: a "complete" event, or a
+// "failed" one when err, the error the export ended with, is not nil.
+func (r *Reporter) Stop(err error) {
+	if r == nil || r.stop == nil {
+		return
+	}
+	close(r.stop)
+	<-r.done
+	event := "complete"
+	if err != nil {
+		event = "failed"
+	}
+	r.report(event)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.mode == ModeTTY {
+		fmt.Fprintln(r.w)
+	}
+	r.active = false
+}
+
+func (r *Reporter) report(event string) {
+	stats := r.tracker.Stats()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch r.mode {
+	case ModeJSON:
+		b, err := json.Marshal(NewEvent(event, stats))
+		if err != nil {
+			return
+		}
+		fmt.Fprintf(r.w, "%s\n", b)
+	case ModeTTY:
+		fmt.Fprintf(r.w, "\r\033[K%s", FormatLine(stats))
+	}
+}
+
+// Writer returns a writer to w, the terminal the reporter draws on, for other
+// output such as logs. In ModeTTY, it clears the progress line before each
+// write and redraws it after, so that the two don't garble each other.
+func (r *Reporter) Writer(w io.Writer) io.Writer {
+	if r == nil || r.mode != ModeTTY {
+		return w
+	}
+	return &ttyWriter{r: r, w: w}
+}
+
+type ttyWriter struct {
+	r *Reporter
+	w io.Writer
+}
+
+func (t *ttyWriter) Write(p []byte) (int, error) {
+	t.r.mu.Lock()
+	defer t.r.mu.Unlock()
+	if !t.r.active {
+		return t.w.Write(p)
+	}
+	fmt.Fprint(t.r.w, "\r\033[K")
+	n, err := t.w.Write(p)
+	fmt.Fprint(t.r.w, FormatLine(t.r.tracker.Stats()))
+	return n, err
+}
+
+// FormatLine renders stats as a single human readable line.
+func FormatLine(s Stats) string {
+	items := fmt.Sprintf("%d", s.ItemsDone)
+	if s.ItemsTotal > 0 {
+		items = fmt.Sprintf("%d/%d (%.0f%%)", s.ItemsDone, s.ItemsTotal, 100*float64(s.ItemsDone)/float64(s.ItemsTotal))
+	}
+
+	eta := "--"
+	if s.ETA > 0 {
+		eta = s.ETA.Round(time.Second).String()
+	}
+
+	return fmt.Sprintf("items %s | attachments %d | %s | %s/s | ETA %s",
+		items,
+		s.Attachments,
+		FormatBytes(s.Bytes),
+		FormatBytes(int64(s.BytesPerSecond)),
+		eta,
+	)
+}
+
+// FormatBytes renders a byte count using binary units.
+func FormatBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
+
+// IsTerminal reports whether w is a character device such as a terminal.
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
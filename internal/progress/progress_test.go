@@ -0,0 +1,221 @@
+package progress
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestTracker(start time.Time, now *time.Time) *Tracker {
+	t := &Tracker{now: func() time.Time { return *now }}
+	t.start = start
+	return t
+}
+
+func TestTracker_Stats(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	tracker := newTestTracker(start, &now)
+
+	tracker.SetTotal(10)
+	for i := 0; i < 4; i++ {
+		tracker.ItemDone()
+	}
+	tracker.AttachmentDone(1000)
+	tracker.AttachmentDone(3000)
+	tracker.Error()
+	now = start.Add(8 * time.Second)
+
+	got := tracker.Stats()
+	want := Stats{
+		ItemsDone:      4,
+		ItemsTotal:     10,
+		Attachments:    2,
+		Bytes:          4000,
+		Errors:         1,
+		Elapsed:        8 * time.Second,
+		BytesPerSecond: 500,
+		ETA:            12 * time.Second,
+	}
+	if got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+
+	tracker.Reset()
+	if got := tracker.Stats(); got.ItemsDone != 0 || got.Bytes != 0 || got.Elapsed != 0 {
+		t.Errorf("Stats() after Reset() = %+v, want zero counters", got)
+	}
+}
+
+func TestTracker_NoETAWithoutProgress(t *testing.T) {
+	start := time.Now()
+	now := start.Add(time.Second)
+	tracker := newTestTracker(start, &now)
+	tracker.SetTotal(5)
+
+	if eta := tracker.Stats().ETA; eta != 0 {
+		t.Errorf("ETA = %v, want 0", eta)
+	}
+}
+
+func TestStats_Add(t *testing.T) {
+	a := Stats{ItemsDone: 2, ItemsTotal: 3, Attachments: 4, Bytes: 1000, Elapsed: time.Second, ETA: time.Second}
+	b := Stats{ItemsDone: 5, ItemsTotal: 5, Attachments: 1, Skipped: 2, Bytes: 3000, Errors: 1, Elapsed: 3 * time.Second}
+
+	want := Stats{ItemsDone: 7, ItemsTotal: 8, Attachments: 5, Skipped: 2, Bytes: 4000, Errors: 1, Elapsed: 4 * time.Second, BytesPerSecond: 1000}
+	if got := a.Add(b); got != want {
+		t.Errorf("Add() = %+v, want %+v", got, want)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		bytes int64
+		want  string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KiB"},
+		{1536, "1.5 KiB"},
+		{5 * 1024 * 1024, "5.0 MiB"},
+		{3 * 1024 * 1024 * 1024, "3.0 GiB"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatBytes(tt.bytes); got != tt.want {
+			t.Errorf("FormatBytes(%d) = %q, want %q", tt.bytes, got, tt.want)
+		}
+	}
+}
+
+func TestFormatLine(t *testing.T) {
+	line := FormatLine(Stats{
+		ItemsDone:      5,
+		ItemsTotal:     20,
+		Attachments:    7,
+		Bytes:          2048,
+		BytesPerSecond: 1024,
+		ETA:            90 * time.Second,
+	})
+
+	for _, want := range []string{"items 5/20 (25%)", "attachments 7", "2.0 KiB", "1.0 KiB/s", "ETA 1m30s"} {
+		if !strings.Contains(line, want) {
+			t.Errorf("FormatLine() = %q, want it to contain %q", line, want)
+		}
+	}
+}
+
+func TestNewReporter(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    string
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "auto without terminal", mode: ModeAuto, wantNil: true},
+		{name: "empty is auto", mode: "", wantNil: true},
+		{name: "none", mode: ModeNone, wantNil: true},
+		{name: "tty", mode: ModeTTY},
+		{name: "json", mode: ModeJSON},
+		{name: "invalid", mode: "xml", wantNil: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewReporter(&bytes.Buffer{}, tt.mode, time.Second, NewTracker())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewReporter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (r == nil) != tt.wantNil {
+				t.Errorf("NewReporter() = %v, wantNil %v", r, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestReporter_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	tracker := NewTracker()
+	r, err := NewReporter(&buf, ModeJSON, 10*time.Millisecond, tracker)
+	if err != nil {
+		t.Fatalf("NewReporter() error = %v", err)
+	}
+
+	r.Start()
+	tracker.SetTotal(2)
+	tracker.ItemDone()
+	tracker.AttachmentDone(42)
+	time.Sleep(30 * time.Millisecond)
+	r.Stop(nil)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) < 2 {
+		t.Fatalf("expected periodic and final events, got %q", buf.String())
+	}
+
+	var last Event
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &last); err != nil {
+		t.Fatalf("invalid JSON event %q: %v", lines[len(lines)-1], err)
+	}
+	if last.Event != "complete" {
+		t.Errorf("last event = %q, want complete", last.Event)
+	}
+	if last.ItemsDone != 1 || last.ItemsTotal != 2 || last.Attachments != 1 || last.Bytes != 42 {
+		t.Errorf("last event = %+v", last)
+	}
+}
+
+func TestReporter_Failed(t *testing.T) {
+	var buf bytes.Buffer
+	r, err := NewReporter(&buf, ModeJSON, time.Hour, NewTracker())
+	if err != nil {
+		t.Fatalf("NewReporter() error = %v", err)
+	}
+
+	r.Start()
+	r.Stop(errors.New("failed to list items"))
+
+	var last Event
+	if err := json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &last); err != nil {
+		t.Fatalf("invalid JSON event %q: %v", buf.String(), err)
+	}
+	if last.Event != "failed" {
+		t.Errorf("last event = %q, want failed", last.Event)
+	}
+}
+
+func TestReporter_Writer(t *testing.T) {
+	var buf bytes.Buffer
+	tracker := NewTracker()
+	r, err := NewReporter(&buf, ModeTTY, time.Hour, tracker)
+	if err != nil {
+		t.Fatalf("NewReporter() error = %v", err)
+	}
+	w := r.Writer(&buf)
+
+	fmt.Fprintln(w, "before start")
+	r.Start()
+	tracker.ItemDone()
+	fmt.Fprintln(w, "log line")
+	r.Stop(nil)
+
+	got := buf.String()
+	want := "before start\n\r\033[Klog line\n" + FormatLine(tracker.Stats())
+	if !strings.HasPrefix(got, want) {
+		t.Errorf("output = %q, want it to start with %q", got, want)
+	}
+
+	if w := (*Reporter)(nil).Writer(&buf); w != &buf {
+		t.Error("Writer() of a nil reporter wraps the writer")
+	}
+}
+
+func TestReporter_NilIsNoop(t *testing.T) {
+	var r *Reporter
+	r.Start()
+	r.Stop(nil)
+}
@@ -0,0 +1,249 @@
+// Package qrlabels renders printable sheets of labels with a QR code that
+// links to an item in Homebox, for sticking onto the item or its box.
+package qrlabels
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif" // the server may render QR codes as GIFs
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Label is a single label of a sheet.
+type Label struct {
+	Title    string // the item's name
+	AssetID  string
+	Location string
+	QR       []byte // image of the QR code in PNG, JPEG or GIF
+}
+
+// Layout of a sheet in millimetres on A4 paper: 3 columns of 8 labels.
+const (
+	pageWidth  = 210.0
+	pageHeight = 297.0
+	margin     = 10.0
+	columns    = 3
+	rows       = 8
+	labelW     = (pageWidth - 2*margin) / columns
+	labelH     = (pageHeight - 2*margin) / rows
+	padding    = 3.0
+	qrSize     = labelH - 2*padding
+	textX      = padding + qrSize + 2
+	textW      = labelW - textX - padding
+	// pngDPI is the resolution of PNG sheets.
+	pngDPI = 150
+)
+
+// PerPage is how many labels fit on a sheet.
+const PerPage = columns * rows
+
+// position returns the top left corner of the i-th label on its page.
+func position(i int) (x, y float64) {
+	i %= PerPage
+	return margin + float64(i%columns)*labelW, margin + float64(i/columns)*labelH
+}
+
+// WritePDF writes the labels as a PDF with a page per sheet. Labels are
+// outlined as a guide for cutting them out.
+func WritePDF(w io.Writer, labels []Label) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(margin, margin, margin)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetTitle("QR Labels", true)
+	pdf.SetDrawColor(200, 200, 200)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for i, l := range labels {
+		if i%PerPage == 0 {
+			pdf.AddPage()
+		}
+		x, y := position(i)
+		pdf.Rect(x, y, labelW, labelH, "D")
+
+		qr, err := decode(l.QR)
+		if err != nil {
+			return fmt.Errorf("failed to read the QR code of %s: %w", l.Title, err)
+		}
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, qr); err != nil {
+			return err
+		}
+		name := fmt.Sprintf("qr%d", i)
+		opts := fpdf.ImageOptions{ImageType: "PNG"}
+		pdf.RegisterImageOptionsReader(name, opts, &buf)
+		pdf.ImageOptions(name, x+padding, y+padding, qrSize, qrSize, false, opts, 0, "")
+
+		pdf.SetXY(x+textX, y+padding)
+		pdf.SetFont("Helvetica", "B", 9)
+		for _, line := range firstLines(pdf.SplitText(tr(l.Title), textW), 3) {
+			pdf.SetX(x + textX)
+			pdf.CellFormat(textW, 4, line, "", 1, "", false, 0, "")
+		}
+		pdf.SetFont("Helvetica", "", 8)
+		for _, line := range []string{l.AssetID, l.Location} {
+			if line == "" {
+				continue
+			}
+			pdf.SetX(x + textX)
+			pdf.CellFormat(textW, 4, firstLines(pdf.SplitText(tr(line), textW), 1)[0], "", 1, "", false, 0, "")
+		}
+
+		if err := pdf.Error(); err != nil {
+			return fmt.Errorf("failed to render the label of %s: %w", l.Title, err)
+		}
+	}
+	return pdf.Output(w)
+}
+
+// RenderPNG renders the labels as a PNG image per sheet.
+func RenderPNG(labels []Label) ([][]byte, error) {
+	var pages [][]byte
+	var page *image.RGBA
+	flush := func() error {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, page); err != nil {
+			return err
+		}
+		pages = append(pages, buf.Bytes())
+		return nil
+	}
+
+	for i, l := range labels {
+		if i%PerPage == 0 {
+			if page != nil {
+				if err := flush(); err != nil {
+					return nil, err
+				}
+			}
+			page = image.NewRGBA(image.Rect(0, 0, px(pageWidth), px(pageHeight)))
+			draw.Draw(page, page.Bounds(), image.White, image.Point{}, draw.Src)
+		}
+		x, y := position(i)
+		outline(page, image.Rect(px(x), px(y), px(x+labelW), px(y+labelH)))
+
+		qr, err := decode(l.QR)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the QR code of %s: %w", l.Title, err)
+		}
+		// Nearest neighbour keeps the modules of the code sharp.
+		box := image.Rect(px(x+padding), px(y+padding), px(x+padding+qrSize), px(y+padding+qrSize))
+		xdraw.NearestNeighbor.Scale(page, box, qr, qr.Bounds(), draw.Over, nil)
+
+		lines := wrap(l.Title, textChars(), 3)
+		for _, s := range []string{l.AssetID, l.Location} {
+			if s != "" {
+				lines = append(lines, truncate(s, textChars()))
+			}
+		}
+		for n, line := range lines {
+			drawText(page, line, px(x+textX), px(y+padding)+n*textLineHeight)
+		}
+	}
+	if page != nil {
+		if err := flush(); err != nil {
+			return nil, err
+		}
+	}
+	return pages, nil
+}
+
+// px converts millimetres to pixels of a PNG sheet.
+func px(mm float64) int {
+	return int(mm / 25.4 * pngDPI)
+}
+
+func decode(data []byte) (image.Image, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+func outline(img *image.RGBA, r image.Rectangle) {
+	grey := color.RGBA{200, 200, 200, 255}
+	for x := r.Min.X; x < r.Max.X; x++ {
+		img.Set(x, r.Min.Y, grey)
+		img.Set(x, r.Max.Y-1, grey)
+	}
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		img.Set(r.Min.X, y, grey)
+		img.Set(r.Max.X-1, y, grey)
+	}
+}
+
+// PNG text is drawn in basicfont's 7x13 pixel font at twice its size.
+const (
+	textScale      = 2
+	textLineHeight = 15 * textScale
+)
+
+// textChars is how many characters fit on a line of a label.
+func textChars() int {
+	return px(textW) / (basicfont.Face7x13.Advance * textScale)
+}
+
+func drawText(dst *image.RGBA, s string, x, y int) {
+	face := basicfont.Face7x13
+	small := image.NewRGBA(image.Rect(0, 0, len([]rune(s))*face.Advance, face.Height))
+	d := font.Drawer{
+		Dst:  small,
+		Src:  image.Black,
+		Face: face,
+		Dot:  fixed.P(0, face.Ascent),
+	}
+	d.DrawString(s)
+	b := small.Bounds()
+	xdraw.NearestNeighbor.Scale(dst, image.Rect(x, y, x+b.Dx()*textScale, y+b.Dy()*textScale), small, b, draw.Over, nil)
+}
+
+// wrap breaks s into at most max lines of width characters, breaking at
+// spaces where possible.
+func wrap(s string, width, max int) []string {
+	var lines []string
+	for _, word := range strings.Fields(s) {
+		n := len(lines)
+		if n > 0 && len([]rune(lines[n-1]))+1+len([]rune(word)) <= width {
+			lines[n-1] += " " + word
+			continue
+		}
+		for len([]rune(word)) > width {
+			lines = append(lines, string([]rune(word)[:width]))
+			word = string([]rune(word)[width:])
+		}
+		lines = append(lines, word)
+	}
+	if len(lines) > max {
+		lines = lines[:max]
+		lines[max-1] = truncate(lines[max-1]+"...", width)
+	}
+	return lines
+}
+
+func truncate(s string, width int) string {
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+	return string(r[:width-3]) + "..."
+}
+
+// firstLines returns the first n lines, marking them as cut when there were
+// more.
+func firstLines(lines []string, n int) []string {
+	if len(lines) <= n {
+		return lines
+	}
+	lines = lines[:n]
+	lines[n-1] = strings.TrimRight(lines[n-1], " ") + "..."
+	return lines
+}
@@ -0,0 +1,124 @@
+package qrlabels
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testQR is a checkerboard standing in for a QR code.
+func testQR(t *testing.T, size int) []byte {
+	t.Helper()
+	img := image.NewGray(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if (x+y)%2 == 0 {
+				img.SetGray(x, y, color.Gray{0})
+			} else {
+				img.SetGray(x, y, color.Gray{255})
+			}
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func testLabels(t *testing.T, n int) []Label {
+	var labels []Label
+	for i := 0; i < n; i++ {
+		labels = append(labels, Label{
+			Title:    fmt.Sprintf("Cordless drill with a rather long name %d", i),
+			AssetID:  fmt.Sprintf("000-%03d", i+1),
+			Location: "Home / Garage / Shelf",
+			QR:       testQR(t, 21+i),
+		})
+	}
+	return labels
+}
+
+func TestWritePDF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WritePDF(&buf, testLabels(t, PerPage+1)); err != nil {
+		t.Fatalf("WritePDF() error = %v", err)
+	}
+	out := buf.String()
+	if !strings.HasPrefix(out, "%PDF-") {
+		t.Fatal("output is not a PDF document")
+	}
+	if n := strings.Count(out, "/Type /Page\n"); n != 2 {
+		t.Errorf("PDF has %d pages, want 2", n)
+	}
+	if n := strings.Count(out, "/Subtype /Image"); n != PerPage+1 {
+		t.Errorf("PDF has %d images, want one per label", n)
+	}
+}
+
+func TestWritePDF_InvalidQR(t *testing.T) {
+	labels := testLabels(t, 1)
+	labels[0].QR = []byte("not an image")
+	if err := WritePDF(&bytes.Buffer{}, labels); err == nil {
+		t.Error("WritePDF() error = nil, want an error for an unreadable QR code")
+	}
+}
+
+func TestRenderPNG(t *testing.T) {
+	pages, err := RenderPNG(testLabels(t, PerPage+1))
+	if err != nil {
+		t.Fatalf("RenderPNG() error = %v", err)
+	}
+	if len(pages) != 2 {
+		t.Fatalf("RenderPNG() returned %d pages, want 2", len(pages))
+	}
+
+	img, err := png.Decode(bytes.NewReader(pages[0]))
+	if err != nil {
+		t.Fatalf("page is not a PNG: %v", err)
+	}
+	if got, want := img.Bounds().Size(), image.Pt(1240, 1753); got != want {
+		t.Errorf("page size = %v, want A4 at 150 dpi %v", got, want)
+	}
+
+	// The QR code of the first label starts at the top left of the label.
+	x, y := px(margin+padding), px(margin+padding)
+	if r, _, _, _ := img.At(x+1, y+1).RGBA(); r != 0 {
+		t.Errorf("pixel at %d,%d is not black, the QR code is missing", x+1, y+1)
+	}
+	// And some text is drawn right of it.
+	dark := false
+	for yy := y; yy < y+textLineHeight; yy++ {
+		for xx := px(margin + textX); xx < px(margin+labelW); xx++ {
+			if r, _, _, _ := img.At(xx, yy).RGBA(); r < 0x8000 {
+				dark = true
+			}
+		}
+	}
+	if !dark {
+		t.Error("no title drawn next to the QR code")
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		s     string
+		width int
+		want  []string
+	}{
+		{"Drill", 10, []string{"Drill"}},
+		{"Cordless drill set", 10, []string{"Cordless", "drill set"}},
+		{"Verylongwordwithoutspaces", 10, []string{"Verylongwo", "rdwithouts", "paces"}},
+		{"one two three four five six seven", 9, []string{"one two", "three", "four f..."}},
+	}
+	for _, tt := range tests {
+		if got := wrap(tt.s, tt.width, 3); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("wrap(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // photos may be GIFs
+	"image/jpeg"
+	_ "image/png" // photos may be PNGs
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/csvexport"
+)
+
+// PhotoFunc returns the content of an item's primary photo, or nil if the
+// item has none.
+type PhotoFunc func(item homeboxclient.Item) ([]byte, error)
+
+// Layout of the PDF in millimetres on A4 paper.
+const (
+	pdfMargin     = 15.0
+	pdfPhotoSize  = 40.0
+	pdfTextX      = pdfMargin + pdfPhotoSize + 5
+	pdfLabelWidth = 32.0
+	pdfLineHeight = 5.0
+	// pdfPhotoPixels is the size photos are scaled down to, enough for print
+	// at the size they are shown while keeping the document small.
+	pdfPhotoPixels = 600
+)
+
+// WritePDF writes the report as a printable document with every item's
+// primary photo, purchase details, serial number and receipts, followed by
+// the total value by location. photo may be nil to leave out photos; photos
+// in formats other than JPEG, PNG and GIF are left out as well. An item whose
+// photo fails to download is logged to log and rendered without it.
+func (r *Report) WritePDF(w io.Writer, photo PhotoFunc, log *slog.Logger) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+	pdf.SetCreationDate(r.Generated)
+	pdf.SetTitle("Inventory Report", true)
+	pdf.AliasNbPages("")
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-10)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetTextColor(120, 120, 120)
+		pdf.CellFormat(0, 4, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+		pdf.SetTextColor(0, 0, 0)
+	})
+
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(0, 10, "Inventory Report", "", 1, "", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, "Generated "+r.Generated.Format("2006-01-02 15:04"), "", 1, "", false, 0, "")
+	pdf.CellFormat(0, 6, fmt.Sprintf("%d items, total value %s", len(r.Rows), money(r.Total())), "", 1, "", false, 0, "")
+	pdf.Ln(4)
+
+	for _, row := range r.Rows {
+		var img []byte
+		if photo != nil {
+			data, err := photo(row.Item)
+			if err != nil {
+				log.Warn("failed to get photo, leaving it out of the report", "item_id", row.Item.ID, "item", row.Item.Name, "error", err)
+			}
+			img = data
+		}
+		r.pdfItem(pdf, tr, row, img)
+		if err := pdf.Error(); err != nil {
+			return fmt.Errorf("failed to render %s: %w", row.Item.Name, err)
+		}
+	}
+
+	r.pdfSummary(pdf, tr)
+	return pdf.Output(w)
+}
+
+// pdfItem renders an item as a photo with its details beside it.
+func (r *Report) pdfItem(pdf *fpdf.Fpdf, tr func(string) string, row Row, img []byte) {
+	item := row.Item
+	details := [][2]string{
+		{"Asset ID", csvexport.FormatAssetID(item.AssetID)},
+		{"Location", row.Location},
+		{"Labels", strings.Join(row.Labels, ", ")},
+		{"Value", fmt.Sprintf("%d x %s = %s", item.Quantity, money(item.PurchasePrice), money(row.Total()))},
+		{"Purchased", strings.TrimSpace(csvexport.FormatDate(item.PurchaseTime) + " " + from(item.PurchaseFrom))},
+		{"Manufacturer", strings.TrimSpace(item.Manufacturer + " " + item.ModelNumber)},
+		{"Serial Number", item.SerialNumber},
+		{"Warranty", warranty(item)},
+	}
+	var receipts []string
+	for _, a := range row.Receipts() {
+		if a.Path != "" {
+			receipts = append(receipts, a.Path)
+		} else {
+			receipts = append(receipts, a.Title)
+		}
+	}
+	details = append(details, [2]string{"Receipts", strings.Join(receipts, "\n")})
+
+	// Start a new page rather than splitting an item across pages.
+	valueWidth := 210 - pdfMargin - pdfTextX - pdfLabelWidth
+	height := 8.0
+	pdf.SetFont("Helvetica", "", 9)
+	for _, d := range details {
+		if d[1] != "" {
+			height += float64(len(pdf.SplitText(tr(d[1]), valueWidth))) * pdfLineHeight
+		}
+	}
+	height = max(height, pdfPhotoSize) + 6
+	_, pageHeight := pdf.GetPageSize()
+	if pdf.GetY()+height > pageHeight-pdfMargin {
+		pdf.AddPage()
+	}
+
+	top := pdf.GetY()
+	if img != nil {
+		registerPhoto(pdf, item.ID, img, top)
+	}
+
+	pdf.SetXY(pdfTextX, top)
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.MultiCell(0, 7, tr(item.Name), "", "", false)
+	pdf.Ln(1)
+	for _, d := range details {
+		if d[1] == "" {
+			continue
+		}
+		pdf.SetX(pdfTextX)
+		pdf.SetFont("Helvetica", "B", 9)
+		pdf.CellFormat(pdfLabelWidth, pdfLineHeight, d[0], "", 0, "", false, 0, "")
+		pdf.SetFont("Helvetica", "", 9)
+		pdf.MultiCell(valueWidth, pdfLineHeight, tr(d[1]), "", "", false)
+	}
+
+	bottom := max(pdf.GetY(), top+pdfPhotoSize) + 3
+	pdf.SetDrawColor(200, 200, 200)
+	pdf.Line(pdfMargin, bottom, 210-pdfMargin, bottom)
+	pdf.SetY(bottom + 3)
+}
+
+// pdfSummary renders the value of the items per location and in total.
+func (r *Report) pdfSummary(pdf *fpdf.Fpdf, tr func(string) string) {
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "B", 14)
+	pdf.CellFormat(0, 10, "Total Value by Location", "", 1, "", false, 0, "")
+
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.SetFillColor(235, 235, 235)
+	pdf.CellFormat(110, 6, "Location", "B", 0, "", true, 0, "")
+	pdf.CellFormat(20, 6, "Items", "B", 0, "R", true, 0, "")
+	pdf.CellFormat(50, 6, "Value", "B", 1, "R", true, 0, "")
+
+	pdf.SetFont("Helvetica", "", 9)
+	for _, g := range r.ByLocation() {
+		name := g.Name
+		if name == "" {
+			name = "(no location)"
+		}
+		pdf.CellFormat(110, 6, tr(name), "", 0, "", false, 0, "")
+		pdf.CellFormat(20, 6, strconv.Itoa(g.Items), "", 0, "R", false, 0, "")
+		pdf.CellFormat(50, 6, money(g.Total), "", 1, "R", false, 0, "")
+	}
+
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(110, 7, "Total", "T", 0, "", false, 0, "")
+	pdf.CellFormat(20, 7, strconv.Itoa(len(r.Rows)), "T", 0, "R", false, 0, "")
+	pdf.CellFormat(50, 7, money(r.Total()), "T", 1, "R", false, 0, "")
+}
+
+// registerPhoto draws a photo into the square photo box at the left margin,
+// keeping its aspect ratio. Photos that can't be decoded are left out.
+func registerPhoto(pdf *fpdf.Fpdf, name string, data []byte, top float64) {
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+	img := thumbnail(src, pdfPhotoPixels)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 80}); err != nil {
+		return
+	}
+	opts := fpdf.ImageOptions{ImageType: "JPG"}
+	pdf.RegisterImageOptionsReader(name, opts, &buf)
+
+	b := img.Bounds()
+	w, h := pdfPhotoSize, pdfPhotoSize
+	if b.Dx() > b.Dy() {
+		h = pdfPhotoSize * float64(b.Dy()) / float64(b.Dx())
+	} else {
+		w = pdfPhotoSize * float64(b.Dx()) / float64(b.Dy())
+	}
+	pdf.ImageOptions(name, pdfMargin+(pdfPhotoSize-w)/2, top+(pdfPhotoSize-h)/2, w, h, false, opts, 0, "")
+}
+
+// thumbnail scales img down so neither side is longer than size, averaging
+// a few samples per pixel. Transparent areas become white.
+func thumbnail(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	scale := max(float64(b.Dx()), float64(b.Dy())) / float64(size)
+	if scale < 1 {
+		scale = 1
+	}
+	w, h := max(1, int(float64(b.Dx())/scale)), max(1, int(float64(b.Dy())/scale))
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	offsets := []float64{0.25, 0.75}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var r, g, bl uint32
+			for _, oy := range offsets {
+				for _, ox := range offsets {
+					sx := b.Min.X + int((float64(x)+ox)*scale)
+					sy := b.Min.Y + int((float64(y)+oy)*scale)
+					cr, cg, cb, ca := img.At(sx, sy).RGBA()
+					// Blend onto white.
+					r += cr + 0xffff - ca
+					g += cg + 0xffff - ca
+					bl += cb + 0xffff - ca
+				}
+			}
+			n := uint32(len(offsets) * len(offsets) * 0x101)
+			dst.Set(x, y, color.RGBA{uint8(r / n), uint8(g / n), uint8(bl / n), 0xff})
+		}
+	}
+	return dst
+}
+
+func money(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
+
+func from(vendor string) string {
+	if vendor == "" {
+		return ""
+	}
+	return "from " + vendor
+}
@@ -0,0 +1,109 @@
+package report
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"log/slog"
+	"strings"
+	"testing"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+)
+
+func testPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.NRGBA{R: 200, A: 128})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReport_WritePDF(t *testing.T) {
+	items := testItems()
+	items[0].Name = "Bohrmaschine Größe L"
+	items[0].SerialNumber = "SN-1"
+	r := New(items, testLocations(), nil)
+
+	photo := testPNG(t, 800, 400)
+	photos := 0
+	photoFunc := func(item homeboxclient.Item) ([]byte, error) {
+		photos++
+		switch item.ID {
+		case "drill-1":
+			return photo, nil
+		case "tv-1":
+			return []byte("not an image"), nil
+		case "saw-1":
+			return nil, errors.New("connection reset")
+		}
+		return nil, nil
+	}
+
+	var buf, logs bytes.Buffer
+	if err := r.WritePDF(&buf, photoFunc, slog.New(slog.NewTextHandler(&logs, nil))); err != nil {
+		t.Fatalf("WritePDF() error = %v", err)
+	}
+	out := buf.String()
+	if !strings.HasPrefix(out, "%PDF-") || !strings.HasSuffix(strings.TrimSpace(out), "%%EOF") {
+		t.Fatal("output is not a PDF document")
+	}
+	if photos != len(items) {
+		t.Errorf("photo function called %d times, want once per item", photos)
+	}
+	// Only the drill has a photo that can be decoded.
+	if n := strings.Count(out, "/Subtype /Image"); n != 1 {
+		t.Errorf("PDF has %d images, want 1", n)
+	}
+	if !strings.Contains(logs.String(), "item_id=saw-1") || !strings.Contains(logs.String(), "connection reset") {
+		t.Errorf("failed photo not logged: %s", logs.String())
+	}
+}
+
+func TestReport_WritePDFManyItems(t *testing.T) {
+	var items []homeboxclient.Item
+	for i := 0; i < 50; i++ {
+		items = append(items, homeboxclient.Item{ID: string(rune('a' + i%26)), Name: "Item", Quantity: 1, Notes: "x"})
+	}
+	var buf bytes.Buffer
+	if err := New(items, nil, nil).WritePDF(&buf, nil, slog.Default()); err != nil {
+		t.Fatalf("WritePDF() error = %v", err)
+	}
+	if pages := strings.Count(buf.String(), "/Type /Page\n"); pages < 3 {
+		t.Errorf("PDF has %d pages, want items spread over several pages", pages)
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	tests := []struct {
+		w, h         int
+		wantW, wantH int
+	}{
+		{1200, 600, 600, 300},
+		{300, 900, 200, 600},
+		{100, 50, 100, 50},
+	}
+	for _, tt := range tests {
+		img := image.NewRGBA(image.Rect(0, 0, tt.w, tt.h))
+		b := thumbnail(img, 600).Bounds()
+		if b.Dx() != tt.wantW || b.Dy() != tt.wantH {
+			t.Errorf("thumbnail(%dx%d) = %dx%d, want %dx%d", tt.w, tt.h, b.Dx(), b.Dy(), tt.wantW, tt.wantH)
+		}
+	}
+
+	// Transparent pixels are blended onto white.
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	c := thumbnail(img, 600).At(0, 0).(color.RGBA)
+	if c.R != 255 || c.G != 255 || c.B != 255 {
+		t.Errorf("transparent pixel = %v, want white", c)
+	}
+}
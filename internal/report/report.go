@@ -0,0 +1,243 @@
+// Package report builds inventory reports, such as the spreadsheet an
+// insurer asks for, from the items of a Homebox instance.
+package report
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/csvexport"
+)
+
+// NoLabel groups items without labels in the totals by label.
+const NoLabel = "(no label)"
+
+// AttachmentPathFunc returns the path, relative to the report, of the file an
+// attachment was exported to, and false if it was not exported.
+type AttachmentPathFunc func(item homeboxclient.Item, attachment homeboxclient.Attachment) (string, bool)
+
+// Attachment is an exported attachment of an item.
+type Attachment struct {
+	ID      string
+	Title   string
+	Type    string
+	Path    string // slash separated, relative to the report
+	Primary bool   // the item's primary photo
+}
+
+// File is a file rendered from a report, such as a page of the HTML catalog.
+type File struct {
+	Name string // slash separated, relative to the export
+	Data []byte
+}
+
+// Row is a single item of the report.
+type Row struct {
+	Item        homeboxclient.Item
+	Location    string // full path, such as "Home / Garage"
+	Labels      []string
+	Attachments []Attachment
+}
+
+// Total is the value of all units of the item.
+func (r Row) Total() float64 {
+	return float64(r.Item.Quantity) * r.Item.PurchasePrice
+}
+
+// Photos returns the item's exported photos, the primary photo first.
+func (r Row) Photos() []Attachment {
+	var photos []Attachment
+	for _, a := range r.Attachments {
+		if a.Type != homeboxclient.AttachmentTypePhoto {
+			continue
+		}
+		if a.Primary {
+			photos = append([]Attachment{a}, photos...)
+		} else {
+			photos = append(photos, a)
+		}
+	}
+	return photos
+}
+
+// Receipts returns all receipts of the item. Receipts that were not exported
+// have an empty Path.
+func (r Row) Receipts() []Attachment {
+	var receipts []Attachment
+	for _, a := range r.Item.Attachments {
+		if a.Type != homeboxclient.AttachmentTypeReceipt {
+			continue
+		}
+		receipt := Attachment{ID: a.ID, Title: title(a), Type: a.Type}
+		for _, exported := range r.Attachments {
+			if exported.ID == a.ID {
+				receipt.Path = exported.Path
+			}
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts
+}
+
+// Group sums up the items sharing a location or label.
+type Group struct {
+	Name     string
+	Items    int
+	Quantity int
+	Total    float64
+}
+
+// Report is an inventory report.
+type Report struct {
+	Generated time.Time
+	Rows      []Row
+}
+
+// New builds a report of items, sorted by location and name. attachmentPath
+// may be nil to leave out attachments.
+func New(items []homeboxclient.Item, locations csvexport.LocationPaths, attachmentPath AttachmentPathFunc) *Report {
+	r := &Report{Generated: time.Now()}
+	for _, item := range items {
+		row := Row{Item: item, Location: locations.Path(item.Location)}
+		for _, l := range item.Labels {
+			row.Labels = append(row.Labels, l.Name)
+		}
+		if attachmentPath != nil {
+			for _, a := range item.Attachments {
+				p, ok := attachmentPath(item, a)
+				if !ok {
+					continue
+				}
+				row.Attachments = append(row.Attachments, Attachment{
+					ID:      a.ID,
+					Title:   title(a),
+					Type:    a.Type,
+					Path:    p,
+					Primary: a.ID == item.ImageID || (item.ImageID == "" && a.Primary),
+				})
+			}
+		}
+		r.Rows = append(r.Rows, row)
+	}
+
+	sort.SliceStable(r.Rows, func(i, j int) bool {
+		if r.Rows[i].Location != r.Rows[j].Location {
+			return r.Rows[i].Location < r.Rows[j].Location
+		}
+		return r.Rows[i].Item.Name < r.Rows[j].Item.Name
+	})
+	return r
+}
+
+// Filter selects the items a report includes. The zero Filter includes all
+// items.
+type Filter struct {
+	Labels   []string // items with any of these labels
+	Location string   // items in this location or below it
+}
+
+// Filter removes the rows that don't match f.
+func (r *Report) Filter(f Filter) {
+	rows := r.Rows[:0]
+	for _, row := range r.Rows {
+		if f.matches(row) {
+			rows = append(rows, row)
+		}
+	}
+	r.Rows = rows
+}
+
+func (f Filter) matches(row Row) bool {
+	if f.Location != "" {
+		location := strings.ToLower(row.Location)
+		want := strings.ToLower(f.Location)
+		if location != want && !strings.HasPrefix(location, want+" / ") {
+			return false
+		}
+	}
+	if len(f.Labels) == 0 {
+		return true
+	}
+	for _, want := range f.Labels {
+		for _, l := range row.Labels {
+			if strings.EqualFold(l, want) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Total is the value of all items in the report.
+func (r *Report) Total() float64 {
+	var total float64
+	for _, row := range r.Rows {
+		total += row.Total()
+	}
+	return total
+}
+
+// ByLocation sums up the items per location, sorted by location.
+func (r *Report) ByLocation() []Group {
+	return r.groups(func(row Row) []string {
+		return []string{row.Location}
+	})
+}
+
+// ByLabel sums up the items per label, sorted by label. Items with several
+// labels count towards each of them, so the groups can add up to more than
+// the total.
+func (r *Report) ByLabel() []Group {
+	return r.groups(func(row Row) []string {
+		if len(row.Labels) == 0 {
+			return []string{NoLabel}
+		}
+		return row.Labels
+	})
+}
+
+func (r *Report) groups(keys func(Row) []string) []Group {
+	byName := make(map[string]*Group)
+	var groups []*Group
+	for _, row := range r.Rows {
+		for _, key := range keys(row) {
+			g, ok := byName[key]
+			if !ok {
+				g = &Group{Name: key}
+				byName[key] = g
+				groups = append(groups, g)
+			}
+			g.Items++
+			g.Quantity += row.Item.Quantity
+			g.Total += row.Total()
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	result := make([]Group, len(groups))
+	for i, g := range groups {
+		result[i] = *g
+	}
+	return result
+}
+
+// URLPath escapes each segment of a slash separated relative path for use as
+// a link.
+func URLPath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/")
+}
+
+// title returns the file name of an attachment, or its ID if it has none.
+func title(a homeboxclient.Attachment) string {
+	if a.Document.Title == "" {
+		return a.ID
+	}
+	return a.Document.Title
+}
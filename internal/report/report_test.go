@@ -0,0 +1,189 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/csvexport"
+)
+
+func testItems() []homeboxclient.Item {
+	return []homeboxclient.Item{
+		{
+			ID:            "drill-1",
+			Name:          "Drill",
+			Quantity:      2,
+			PurchasePrice: 100,
+			Location:      &homeboxclient.Location{ID: "garage"},
+			Labels:        []homeboxclient.Label{{Name: "Tools"}, {Name: "Power"}},
+			Attachments: []homeboxclient.Attachment{
+				{ID: "a1", Type: "receipt", Document: homeboxclient.DocumentOut{Title: "receipt.pdf"}},
+				{ID: "a2", Type: "photo", Document: homeboxclient.DocumentOut{Title: "drill.jpg"}},
+			},
+		},
+		{
+			ID:            "tv-1",
+			Name:          "TV",
+			Quantity:      1,
+			PurchasePrice: 500,
+			Location:      &homeboxclient.Location{ID: "living"},
+		},
+		{
+			ID:            "saw-1",
+			Name:          "Saw",
+			Quantity:      1,
+			PurchasePrice: 50.5,
+			Location:      &homeboxclient.Location{ID: "garage"},
+			Labels:        []homeboxclient.Label{{Name: "Tools"}},
+		},
+	}
+}
+
+func testLocations() csvexport.LocationPaths {
+	return csvexport.LocationPaths{"garage": "Home / Garage", "living": "Home / Living Room"}
+}
+
+func TestNew(t *testing.T) {
+	onlyReceipts := func(item homeboxclient.Item, a homeboxclient.Attachment) (string, bool) {
+		return item.ID + "/" + a.Document.Title, a.Type == "receipt"
+	}
+	r := New(testItems(), testLocations(), onlyReceipts)
+
+	var names []string
+	for _, row := range r.Rows {
+		names = append(names, row.Item.Name)
+	}
+	want := []string{"Drill", "Saw", "TV"}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("rows = %v, want %v sorted by location and name", names, want)
+		}
+	}
+
+	drill := r.Rows[0]
+	if drill.Location != "Home / Garage" {
+		t.Errorf("Location = %q, want Home / Garage", drill.Location)
+	}
+	if len(drill.Labels) != 2 {
+		t.Errorf("Labels = %v, want 2 labels", drill.Labels)
+	}
+	if len(drill.Attachments) != 1 || drill.Attachments[0].Path != "drill-1/receipt.pdf" {
+		t.Errorf("Attachments = %+v, want only the receipt", drill.Attachments)
+	}
+	if drill.Total() != 200 {
+		t.Errorf("Total() = %v, want 200", drill.Total())
+	}
+	if r.Total() != 750.5 {
+		t.Errorf("Report.Total() = %v, want 750.5", r.Total())
+	}
+}
+
+func TestReport_Groups(t *testing.T) {
+	r := New(testItems(), testLocations(), nil)
+
+	tests := []struct {
+		name   string
+		groups []Group
+		want   []Group
+	}{
+		{
+			name:   "by location",
+			groups: r.ByLocation(),
+			want: []Group{
+				{Name: "Home / Garage", Items: 2, Quantity: 3, Total: 250.5},
+				{Name: "Home / Living Room", Items: 1, Quantity: 1, Total: 500},
+			},
+		},
+		{
+			name:   "by label",
+			groups: r.ByLabel(),
+			want: []Group{
+				{Name: NoLabel, Items: 1, Quantity: 1, Total: 500},
+				{Name: "Power", Items: 1, Quantity: 2, Total: 200},
+				{Name: "Tools", Items: 2, Quantity: 3, Total: 250.5},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.groups) != len(tt.want) {
+				t.Fatalf("groups = %+v, want %+v", tt.groups, tt.want)
+			}
+			for i := range tt.want {
+				if tt.groups[i] != tt.want[i] {
+					t.Errorf("group %d = %+v, want %+v", i, tt.groups[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRow_Photos(t *testing.T) {
+	item := homeboxclient.Item{
+		ID:      "item",
+		ImageID: "b",
+		Attachments: []homeboxclient.Attachment{
+			{ID: "a", Type: "photo"},
+			{ID: "receipt", Type: "receipt"},
+			{ID: "b", Type: "photo"},
+		},
+	}
+	all := func(item homeboxclient.Item, a homeboxclient.Attachment) (string, bool) { return a.ID, true }
+	photos := New([]homeboxclient.Item{item}, nil, all).Rows[0].Photos()
+
+	if len(photos) != 2 || photos[0].Path != "b" || !photos[0].Primary || photos[1].Path != "a" {
+		t.Errorf("Photos() = %+v, want the primary photo b first, then a", photos)
+	}
+}
+
+func TestRow_Receipts(t *testing.T) {
+	item := homeboxclient.Item{
+		ID: "item",
+		Attachments: []homeboxclient.Attachment{
+			{ID: "r1", Type: "receipt", Document: homeboxclient.DocumentOut{Title: "invoice.pdf"}},
+			{ID: "r2", Type: "receipt"},
+			{ID: "p1", Type: "photo"},
+		},
+	}
+	onlyFirst := func(item homeboxclient.Item, a homeboxclient.Attachment) (string, bool) {
+		return "item/" + a.Document.Title, a.ID == "r1"
+	}
+	receipts := New([]homeboxclient.Item{item}, nil, onlyFirst).Rows[0].Receipts()
+
+	if len(receipts) != 2 {
+		t.Fatalf("Receipts() = %+v, want both receipts", receipts)
+	}
+	if receipts[0].Path != "item/invoice.pdf" || receipts[1].Path != "" || receipts[1].Title != "r2" {
+		t.Errorf("Receipts() = %+v, want the exported path of r1 and the ID of untitled r2", receipts)
+	}
+}
+
+func TestReport_Filter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter Filter
+		want   []string
+	}{
+		{name: "everything", filter: Filter{}, want: []string{"Drill", "Saw", "TV"}},
+		{name: "location", filter: Filter{Location: "home / garage"}, want: []string{"Drill", "Saw"}},
+		{name: "parent location", filter: Filter{Location: "Home"}, want: []string{"Drill", "Saw", "TV"}},
+		{name: "location prefix is not a parent", filter: Filter{Location: "Home / Gar"}, want: nil},
+		{name: "labels", filter: Filter{Labels: []string{"power", "Kitchen"}}, want: []string{"Drill"}},
+		{name: "location and label", filter: Filter{Location: "Home / Garage", Labels: []string{"Tools"}}, want: []string{"Drill", "Saw"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(testItems(), testLocations(), nil)
+			r.Filter(tt.filter)
+
+			var got []string
+			for _, row := range r.Rows {
+				got = append(got, row.Item.Name)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("rows = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
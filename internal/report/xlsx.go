@@ -0,0 +1,132 @@
+package report
+
+import (
+	"io"
+	"strings"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+	"github.com/kusold/homebox-export/internal/csvexport"
+	"github.com/kusold/homebox-export/internal/xlsx"
+)
+
+// itemColumns are the columns of the items sheet before the attachments.
+var itemColumns = []struct {
+	name  string
+	width float64
+}{
+	{"Name", 30},
+	{"Asset ID", 10},
+	{"Location", 30},
+	{"Labels", 20},
+	{"Quantity", 9},
+	{"Purchase Price", 14},
+	{"Total", 14},
+	{"Purchase Date", 13},
+	{"Vendor", 20},
+	{"Manufacturer", 18},
+	{"Model Number", 16},
+	{"Serial Number", 18},
+	{"Warranty Expires", 16},
+	{"Warranty Details", 24},
+}
+
+// WriteXLSX writes the report as a spreadsheet with a sheet listing every
+// item, linked to its exported attachments, and a summary sheet with totals
+// by location and label.
+func (r *Report) WriteXLSX(w io.Writer) error {
+	var wb xlsx.Workbook
+	r.itemsSheet(wb.AddSheet("Items"))
+	r.summarySheet(wb.AddSheet("Summary"))
+	return wb.Write(w)
+}
+
+func (r *Report) itemsSheet(s *xlsx.Sheet) {
+	attachments := 0
+	for _, row := range r.Rows {
+		attachments = max(attachments, len(row.Attachments))
+	}
+
+	var header []xlsx.Cell
+	for _, c := range itemColumns {
+		header = append(header, xlsx.Cell{Text: c.name, Bold: true})
+		s.Widths = append(s.Widths, c.width)
+	}
+	for i := 0; i < attachments; i++ {
+		header = append(header, xlsx.Cell{Text: "Attachment", Bold: true})
+		s.Widths = append(s.Widths, 24)
+	}
+	s.AddRow(header...)
+
+	for _, row := range r.Rows {
+		item := row.Item
+		cells := []xlsx.Cell{
+			xlsx.Text(item.Name),
+			xlsx.Text(csvexport.FormatAssetID(item.AssetID)),
+			xlsx.Text(row.Location),
+			xlsx.Text(strings.Join(row.Labels, ", ")),
+			xlsx.Number(float64(item.Quantity)),
+			xlsx.Money(item.PurchasePrice),
+			xlsx.Money(row.Total()),
+			xlsx.Text(csvexport.FormatDate(item.PurchaseTime)),
+			xlsx.Text(item.PurchaseFrom),
+			xlsx.Text(item.Manufacturer),
+			xlsx.Text(item.ModelNumber),
+			xlsx.Text(item.SerialNumber),
+			xlsx.Text(warranty(item)),
+			xlsx.Text(item.WarrantyDetails),
+		}
+		for _, a := range row.Attachments {
+			cells = append(cells, xlsx.Link(a.Title, URLPath(a.Path)))
+		}
+		s.AddRow(cells...)
+	}
+
+	total := make([]xlsx.Cell, 7)
+	total[0] = xlsx.Cell{Text: "Total", Bold: true}
+	total[6] = xlsx.Cell{Number: r.Total(), IsNumber: true, Money: true, Bold: true}
+	s.AddRow(total...)
+}
+
+func (r *Report) summarySheet(s *xlsx.Sheet) {
+	s.Widths = []float64{30, 9, 9, 14}
+	s.AddRow(xlsx.Cell{Text: "Generated", Bold: true}, xlsx.Text(r.Generated.Format("2006-01-02 15:04")))
+	s.AddRow()
+
+	table := func(title string, groups []Group) {
+		s.AddRow(
+			xlsx.Cell{Text: title, Bold: true},
+			xlsx.Cell{Text: "Items", Bold: true},
+			xlsx.Cell{Text: "Quantity", Bold: true},
+			xlsx.Cell{Text: "Total", Bold: true},
+		)
+		for _, g := range groups {
+			name := g.Name
+			if name == "" {
+				name = "(no location)"
+			}
+			s.AddRow(xlsx.Text(name), xlsx.Number(float64(g.Items)), xlsx.Number(float64(g.Quantity)), xlsx.Money(g.Total))
+		}
+		s.AddRow()
+	}
+	table("Location", r.ByLocation())
+	table("Label", r.ByLabel())
+
+	quantity := 0
+	for _, row := range r.Rows {
+		quantity += row.Item.Quantity
+	}
+	s.AddRow(
+		xlsx.Cell{Text: "Total", Bold: true},
+		xlsx.Cell{Number: float64(len(r.Rows)), IsNumber: true, Bold: true},
+		xlsx.Cell{Number: float64(quantity), IsNumber: true, Bold: true},
+		xlsx.Cell{Number: r.Total(), IsNumber: true, Money: true, Bold: true},
+	)
+}
+
+// warranty describes when the item's warranty expires.
+func warranty(item homeboxclient.Item) string {
+	if item.LifetimeWarranty {
+		return "Lifetime"
+	}
+	return csvexport.FormatDate(item.WarrantyExpires)
+}
@@ -0,0 +1,56 @@
+package report
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	homeboxclient "github.com/kusold/homebox-export/homebox_client"
+)
+
+func TestReport_WriteXLSX(t *testing.T) {
+	paths := func(item homeboxclient.Item, a homeboxclient.Attachment) (string, bool) {
+		return "Drill_drill/" + a.Document.Title, true
+	}
+	items := testItems()
+	items[0].Attachments[0].Document.Title = "receipt 2023.pdf"
+	r := New(items, testLocations(), paths)
+
+	var buf bytes.Buffer
+	if err := r.WriteXLSX(&buf); err != nil {
+		t.Fatalf("WriteXLSX() error = %v", err)
+	}
+
+	z, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("not a zip file: %v", err)
+	}
+	read := func(name string) string {
+		f, err := z.Open(name)
+		if err != nil {
+			t.Fatalf("missing %s: %v", name, err)
+		}
+		defer f.Close()
+		data, _ := io.ReadAll(f)
+		return string(data)
+	}
+
+	sheet := read("xl/worksheets/sheet1.xml")
+	for _, want := range []string{">Drill<", ">Home / Garage<", ">Tools, Power<", "<v>200</v>", "<v>750.5</v>", ">receipt 2023.pdf<"} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("items sheet missing %s", want)
+		}
+	}
+	if rels := read("xl/worksheets/_rels/sheet1.xml.rels"); !strings.Contains(rels, `Target="Drill_drill/receipt%202023.pdf"`) {
+		t.Errorf("items sheet does not link the receipt: %s", rels)
+	}
+
+	summary := read("xl/worksheets/sheet2.xml")
+	for _, want := range []string{">Location<", ">Label<", ">Home / Living Room<", ">" + NoLabel + "<", "<v>250.5</v>"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("summary sheet missing %s", want)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Job is the work run on every tick of the schedule.
+type Job func(ctx context.Context) error
+
+// RunStatus describes a single run of the job.
+type RunStatus struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	Error string    `json:"error,omitempty"`
+}
+
+func (r RunStatus) Success() bool {
+	return r.Error == ""
+}
+
+// Status is a point in time view of the scheduler, served by the health
+// endpoint.
+type Status struct {
+	Status      string     `json:"status"`
+	Running     bool       `json:"running"`
+	NextRun     time.Time  `json:"nextRun,omitzero"`
+	LastRun     *RunStatus `json:"lastRun,omitempty"`
+	LastSuccess time.Time  `json:"lastSuccess,omitzero"`
+}
+
+const (
+	StatusStarting = "starting"
+	StatusOK       = "ok"
+	StatusFailing  = "failing"
+)
+
+// Scheduler runs a job on a cron schedule. A tick that fires while the
+// previous run is still in progress is skipped rather than overlapping it.
+type Scheduler struct {
+	schedule cron.Schedule
+	jitter   time.Duration
+	job      Job
+	logger   *slog.Logger
+
+	mu          sync.Mutex
+	running     bool
+	nextRun     time.Time
+	lastRun     *RunStatus
+	lastSuccess time.Time
+	wg          sync.WaitGroup
+
+	now   func() time.Time
+	after func(time.Duration) <-chan time.Time
+}
+
+type Option func(*Scheduler)
+
+// WithJitter delays every run by a random duration up to jitter, so that many
+// instances sharing a schedule don't hit the server at the same time.
+func WithJitter(jitter time.Duration) Option {
+	return func(s *Scheduler) {
+		s.jitter = jitter
+	}
+}
+
+func WithLogger(l *slog.Logger) Option {
+	return func(s *Scheduler) {
+		s.logger = l
+	}
+}
+
+// New parses a standard five field cron expression (or a descriptor such as
+// @daily) and returns a scheduler that runs job on it.
+func New(spec string, job Job, options ...Option) (*Scheduler, error) {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", spec, err)
+	}
+	if schedule.Next(time.Now()).IsZero() {
+		return nil, fmt.Errorf("invalid schedule %q: it never runs", spec)
+	}
+
+	s := &Scheduler{
+		schedule: schedule,
+		job:      job,
+		logger:   slog.Default(),
+		now:      time.Now,
+		after:    time.After,
+	}
+	for _, opt := range options {
+		opt(s)
+	}
+	if s.jitter < 0 {
+		return nil, fmt.Errorf("jitter must not be negative")
+	}
+	return s, nil
+}
+
+// Run blocks, running the job on schedule until ctx is cancelled. It then
+// waits for an in-progress run to finish before returning. It fails if the
+// schedule has no run left.
+func (s *Scheduler) Run(ctx context.Context) error {
+	defer s.wg.Wait()
+
+	for {
+		next, err := s.next()
+		if err != nil {
+			return err
+		}
+		s.logger.Info("next export scheduled", "at", next)
+
+		select {
+		case <-ctx.Done():
+			s.logger.Info("scheduler stopping, waiting for running export to finish")
+			return nil
+		case <-s.after(next.Sub(s.now())):
+			s.Trigger(ctx)
+		}
+	}
+}
+
+// Trigger starts a run in the background unless one is already in progress.
+// It reports whether a run was started.
+func (s *Scheduler) Trigger(ctx context.Context) bool {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		s.logger.Warn("previous export still running, skipping this run")
+		return false
+	}
+	s.running = true
+	s.wg.Add(1)
+	s.mu.Unlock()
+
+	go func() {
+		defer s.wg.Done()
+		s.run(ctx)
+	}()
+	return true
+}
+
+// Wait blocks until an in-progress run has finished.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) run(ctx context.Context) {
+	status := RunStatus{Start: s.now()}
+	s.logger.Info("scheduled export starting")
+
+	err := s.job(ctx)
+
+	status.End = s.now()
+	if err != nil {
+		status.Error = err.Error()
+		s.logger.Error("scheduled export failed", "error", err, "duration", status.End.Sub(status.Start))
+	} else {
+		s.logger.Info("scheduled export finished", "duration", status.End.Sub(status.Start))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.running = false
+	s.lastRun = &status
+	if err == nil {
+		s.lastSuccess = status.End
+	}
+}
+
+func (s *Scheduler) next() (time.Time, error) {
+	next := s.schedule.Next(s.now())
+	if next.IsZero() {
+		return next, fmt.Errorf("schedule has no next run after %s", s.now().Format(time.RFC3339))
+	}
+	if s.jitter > 0 {
+		next = next.Add(rand.N(s.jitter))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextRun = next
+	return next, nil
+}
+
+func (s *Scheduler) Status() Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := Status{
+		Status:      StatusStarting,
+		Running:     s.running,
+		NextRun:     s.nextRun,
+		LastSuccess: s.lastSuccess,
+	}
+	if s.lastRun != nil {
+		run := *s.lastRun
+		status.LastRun = &run
+		status.Status = StatusOK
+		if !run.Success() {
+			status.Status = StatusFailing
+		}
+	}
+	return status
+}
+
+// HealthHandler serves the scheduler status as JSON. It responds with 503
+// Service Unavailable when the last run failed.
+func (s *Scheduler) HealthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := s.Status()
+
+		w.Header().Set("Content-Type", "application/json")
+		if status.Status == StatusFailing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(status)
+	})
+}
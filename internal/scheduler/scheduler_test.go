@@ -0,0 +1,219 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		jitter  time.Duration
+		wantErr bool
+	}{
+		{name: "standard cron", spec: "0 3 * * *"},
+		{name: "descriptor", spec: "@daily"},
+		{name: "invalid", spec: "every day", wantErr: true},
+		{name: "never runs", spec: "0 0 30 2 *", wantErr: true},
+		{name: "negative jitter", spec: "@daily", jitter: -time.Minute, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.spec, func(context.Context) error { return nil }, WithJitter(tt.jitter))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScheduler_Next(t *testing.T) {
+	s, err := New("0 3 * * *", nil, WithJitter(10*time.Minute), WithLogger(discardLogger()))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	base := time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC)
+	for i := 0; i < 20; i++ {
+		next, err := s.next()
+		if err != nil {
+			t.Fatalf("next() error = %v", err)
+		}
+		if next.Before(base) || !next.Before(base.Add(10*time.Minute)) {
+			t.Fatalf("next() = %v, want within 10m after %v", next, base)
+		}
+	}
+	if got := s.Status().NextRun; got.IsZero() {
+		t.Error("Status().NextRun not set")
+	}
+}
+
+func TestScheduler_TriggerPreventsOverlap(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	s, err := New("@daily", func(context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}, WithLogger(discardLogger()))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if !s.Trigger(context.Background()) {
+		t.Fatal("first Trigger() = false, want true")
+	}
+	<-started
+	if !s.Status().Running {
+		t.Error("Status().Running = false while job is running")
+	}
+	if s.Trigger(context.Background()) {
+		t.Error("second Trigger() = true while previous run is in progress")
+	}
+
+	close(release)
+	s.Wait()
+	if s.Status().Running {
+		t.Error("Status().Running = true after job finished")
+	}
+}
+
+func TestScheduler_Status(t *testing.T) {
+	var jobErr error
+	s, err := New("@daily", func(context.Context) error { return jobErr }, WithLogger(discardLogger()))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if got := s.Status().Status; got != StatusStarting {
+		t.Errorf("initial status = %q, want %q", got, StatusStarting)
+	}
+
+	s.Trigger(context.Background())
+	s.Wait()
+	status := s.Status()
+	if status.Status != StatusOK || status.LastRun == nil || status.LastSuccess.IsZero() {
+		t.Errorf("status after success = %+v", status)
+	}
+
+	jobErr = errors.New("login failed")
+	s.Trigger(context.Background())
+	s.Wait()
+	status = s.Status()
+	if status.Status != StatusFailing || status.LastRun.Error != "login failed" {
+		t.Errorf("status after failure = %+v", status)
+	}
+	if status.LastSuccess.IsZero() {
+		t.Error("LastSuccess cleared by failed run")
+	}
+}
+
+func TestScheduler_HealthHandler(t *testing.T) {
+	jobErr := errors.New("boom")
+	s, err := New("@daily", func(context.Context) error { return jobErr }, WithLogger(discardLogger()))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	check := func(wantCode int, wantStatus string) {
+		t.Helper()
+		rec := httptest.NewRecorder()
+		s.HealthHandler().ServeHTTP(rec, httptest.NewRequest("GET", "/healthz", nil))
+		if rec.Code != wantCode {
+			t.Errorf("code = %d, want %d", rec.Code, wantCode)
+		}
+		var status Status
+		if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil {
+			t.Fatalf("invalid JSON %q: %v", rec.Body.String(), err)
+		}
+		if status.Status != wantStatus {
+			t.Errorf("status = %q, want %q", status.Status, wantStatus)
+		}
+	}
+
+	check(http.StatusOK, StatusStarting)
+
+	s.Trigger(context.Background())
+	s.Wait()
+	check(http.StatusServiceUnavailable, StatusFailing)
+
+	jobErr = nil
+	s.Trigger(context.Background())
+	s.Wait()
+	check(http.StatusOK, StatusOK)
+}
+
+func TestScheduler_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runs := 0
+	s, err := New("* * * * *", func(context.Context) error {
+		runs++
+		cancel()
+		return nil
+	}, WithLogger(discardLogger()))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	s.after = func(time.Duration) <-chan time.Time {
+		ch := make(chan time.Time, 1)
+		ch <- time.Now()
+		return ch
+	}
+
+	done := make(chan error)
+	go func() { done <- s.Run(ctx) }()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Run() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return after context was cancelled")
+	}
+	if runs < 1 {
+		t.Errorf("job ran %d times, want at least 1", runs)
+	}
+}
+
+// noRuns is a schedule that has run for the last time.
+type noRuns struct{}
+
+func (noRuns) Next(time.Time) time.Time { return time.Time{} }
+
+func TestScheduler_RunWithoutNextRun(t *testing.T) {
+	s, err := New("@daily", func(context.Context) error { return nil }, WithLogger(discardLogger()))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	s.schedule = noRuns{}
+
+	done := make(chan error)
+	go func() { done <- s.Run(context.Background()) }()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("Run() error = nil, want the schedule to have no next run")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return for a schedule without a next run")
+	}
+}
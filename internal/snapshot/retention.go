@@ -0,0 +1,94 @@
+package snapshot
+
+import (
+	"fmt"
+	"os"
+)
+
+// Policy is a grandfather-father-son retention policy. Each count keeps the
+// newest snapshot of that many distinct periods; a snapshot is kept when any
+// rule selects it. The zero Policy keeps everything.
+type Policy struct {
+	Last    int
+	Daily   int
+	Weekly  int
+	Monthly int
+	Yearly  int
+}
+
+func (p Policy) IsZero() bool {
+	return p == Policy{}
+}
+
+func (p Policy) Validate() error {
+	if p.Last < 0 || p.Daily < 0 || p.Weekly < 0 || p.Monthly < 0 || p.Yearly < 0 {
+		return fmt.Errorf("retention counts must not be negative")
+	}
+	return nil
+}
+
+// Apply splits snapshots, which must be sorted newest first, into those the
+// policy keeps and those it removes.
+func (p Policy) Apply(snapshots []Snapshot) (keep, remove []Snapshot) {
+	if p.IsZero() {
+		return snapshots, nil
+	}
+
+	rules := []struct {
+		count  int
+		period func(Snapshot) string
+	}{
+		{p.Last, func(s Snapshot) string { return s.Name }},
+		{p.Daily, func(s Snapshot) string { return s.Time.Format("2006-01-02") }},
+		{p.Weekly, func(s Snapshot) string {
+			year, week := s.Time.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+		{p.Monthly, func(s Snapshot) string { return s.Time.Format("2006-01") }},
+		{p.Yearly, func(s Snapshot) string { return s.Time.Format("2006") }},
+	}
+
+	kept := make(map[string]bool)
+	for _, rule := range rules {
+		seen := make(map[string]bool)
+		for _, s := range snapshots {
+			if len(seen) >= rule.count {
+				break
+			}
+			period := rule.period(s)
+			if seen[period] {
+				continue
+			}
+			// Snapshots are newest first, so the first one seen in a period
+			// is the one kept for it.
+			seen[period] = true
+			kept[s.Name] = true
+		}
+	}
+
+	for _, s := range snapshots {
+		if kept[s.Name] {
+			keep = append(keep, s)
+		} else {
+			remove = append(remove, s)
+		}
+	}
+	return keep, remove
+}
+
+// Prune deletes the snapshots in root that the policy doesn't keep and
+// returns them.
+func Prune(root string, p Policy) ([]Snapshot, error) {
+	snapshots, err := List(root)
+	if err != nil {
+		return nil, err
+	}
+
+	_, remove := p.Apply(snapshots)
+	for i, s := range remove {
+		if err := os.RemoveAll(s.Path); err != nil {
+			return remove[:i], fmt.Errorf("failed to remove snapshot %s: %w", s.Name, err)
+		}
+	}
+	return remove, nil
+}
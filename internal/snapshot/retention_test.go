@@ -0,0 +1,121 @@
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// dailySnapshots returns one snapshot per day for n days ending at end,
+// newest first.
+func dailySnapshots(end time.Time, n int) []Snapshot {
+	var snapshots []Snapshot
+	for i := 0; i < n; i++ {
+		t := end.AddDate(0, 0, -i)
+		snapshots = append(snapshots, Snapshot{Name: t.Format(TimeFormat), Time: t})
+	}
+	return snapshots
+}
+
+func names(snapshots []Snapshot) []string {
+	var n []string
+	for _, s := range snapshots {
+		n = append(n, s.Name)
+	}
+	return n
+}
+
+func TestPolicy_Apply(t *testing.T) {
+	end := time.Date(2024, 3, 31, 3, 0, 0, 0, time.UTC) // a Sunday
+	snapshots := dailySnapshots(end, 100)
+
+	tests := []struct {
+		name     string
+		policy   Policy
+		wantKeep int
+		mustKeep []string
+	}{
+		{
+			name:     "zero policy keeps everything",
+			policy:   Policy{},
+			wantKeep: 100,
+		},
+		{
+			name:     "keep last",
+			policy:   Policy{Last: 3},
+			wantKeep: 3,
+			mustKeep: []string{"20240331T030000Z", "20240330T030000Z", "20240329T030000Z"},
+		},
+		{
+			name:     "daily and weekly overlap",
+			policy:   Policy{Daily: 7, Weekly: 4},
+			wantKeep: 10, // 7 days plus the Sundays of the 3 weeks before
+			mustKeep: []string{"20240325T030000Z", "20240324T030000Z", "20240317T030000Z", "20240310T030000Z"},
+		},
+		{
+			name:     "monthly keeps newest of each month",
+			policy:   Policy{Monthly: 3},
+			wantKeep: 3,
+			mustKeep: []string{"20240331T030000Z", "20240229T030000Z", "20240131T030000Z"},
+		},
+		{
+			name:     "yearly",
+			policy:   Policy{Yearly: 5},
+			wantKeep: 2,
+			mustKeep: []string{"20240331T030000Z", "20231231T030000Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keep, remove := tt.policy.Apply(snapshots)
+			if len(keep) != tt.wantKeep {
+				t.Errorf("kept %d snapshots %v, want %d", len(keep), names(keep), tt.wantKeep)
+			}
+			if len(keep)+len(remove) != len(snapshots) {
+				t.Errorf("kept %d + removed %d != %d", len(keep), len(remove), len(snapshots))
+			}
+			kept := make(map[string]bool)
+			for _, s := range keep {
+				kept[s.Name] = true
+			}
+			for _, name := range tt.mustKeep {
+				if !kept[name] {
+					t.Errorf("snapshot %s not kept; kept %v", name, names(keep))
+				}
+			}
+		})
+	}
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	if err := (Policy{Daily: 7}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	if err := (Policy{Weekly: -1}).Validate(); err == nil {
+		t.Error("Validate() expected error for negative count")
+	}
+}
+
+func TestPrune(t *testing.T) {
+	root := t.TempDir()
+	for _, s := range dailySnapshots(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), 5) {
+		if err := os.MkdirAll(filepath.Join(root, s.Name), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	removed, err := Prune(root, Policy{Last: 2})
+	if err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+	if len(removed) != 3 {
+		t.Errorf("Prune() removed %v, want 3 snapshots", names(removed))
+	}
+
+	remaining, _ := List(root)
+	if got := names(remaining); len(got) != 2 || got[0] != "20240110T000000Z" || got[1] != "20240109T000000Z" {
+		t.Errorf("remaining snapshots = %v", got)
+	}
+}
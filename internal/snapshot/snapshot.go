@@ -0,0 +1,149 @@
+package snapshot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TimeFormat is the layout of snapshot directory names. It sorts
+// lexicographically and contains no characters that are invalid on Windows.
+const TimeFormat = "20060102T150405Z"
+
+const (
+	partialSuffix = ".partial"
+	latestLink    = "latest"
+)
+
+// Snapshot is a timestamped export directory below the output root.
+type Snapshot struct {
+	Name string
+	Path string
+	Time time.Time
+}
+
+// List returns the completed snapshots in root, newest first.
+func List(root string) ([]Snapshot, error) {
+	entries, err := os.ReadDir(root)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	var snapshots []Snapshot
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		t, err := time.Parse(TimeFormat, e.Name())
+		if err != nil {
+			continue
+		}
+		snapshots = append(snapshots, Snapshot{
+			Name: e.Name(),
+			Path: filepath.Join(root, e.Name()),
+			Time: t,
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Time.After(snapshots[j].Time)
+	})
+	return snapshots, nil
+}
+
+// Latest returns the newest completed snapshot in root.
+func Latest(root string) (Snapshot, bool, error) {
+	snapshots, err := List(root)
+	if err != nil || len(snapshots) == 0 {
+		return Snapshot{}, false, err
+	}
+	return snapshots[0], true, nil
+}
+
+// Pending is a snapshot that is still being written. It only becomes visible
+// to List once Commit is called, so a failed export never replaces the latest
+// good snapshot.
+type Pending struct {
+	Snapshot
+	root string
+}
+
+// Begin creates the directory for a new snapshot taken at now. Leftovers of
+// interrupted snapshots are removed first.
+func Begin(root string, now time.Time) (*Pending, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+	if err := removePartials(root); err != nil {
+		return nil, err
+	}
+
+	now = now.UTC().Truncate(time.Second)
+	name := now.Format(TimeFormat)
+	if _, err := os.Stat(filepath.Join(root, name)); err == nil {
+		return nil, fmt.Errorf("snapshot %s already exists", name)
+	}
+
+	p := &Pending{
+		Snapshot: Snapshot{
+			Name: name,
+			Path: filepath.Join(root, name+partialSuffix),
+			Time: now,
+		},
+		root: root,
+	}
+	if err := os.MkdirAll(p.Path, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	return p, nil
+}
+
+// Commit makes the snapshot visible and points the "latest" link at it.
+func (p *Pending) Commit() (Snapshot, error) {
+	final := filepath.Join(p.root, p.Name)
+	if err := os.Rename(p.Path, final); err != nil {
+		return Snapshot{}, fmt.Errorf("failed to finalize snapshot: %w", err)
+	}
+	p.Path = final
+
+	// The link is a convenience; filesystems without symlink support still
+	// get a complete snapshot.
+	link := filepath.Join(p.root, latestLink)
+	if info, err := os.Lstat(link); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		os.Remove(link)
+	}
+	os.Symlink(p.Name, link)
+
+	return p.Snapshot, nil
+}
+
+// Abort removes a snapshot that could not be completed.
+func (p *Pending) Abort() error {
+	return os.RemoveAll(p.Path)
+}
+
+func removePartials(root string) error {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), partialSuffix)
+		if !ok || !e.IsDir() {
+			continue
+		}
+		if _, err := time.Parse(TimeFormat, name); err != nil {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(root, e.Name())); err != nil {
+			return fmt.Errorf("failed to remove interrupted snapshot: %w", err)
+		}
+	}
+	return nil
+}
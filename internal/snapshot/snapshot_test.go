@@ -0,0 +1,106 @@
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBeginCommit(t *testing.T) {
+	root := t.TempDir()
+	now := time.Date(2024, 3, 5, 3, 0, 0, 0, time.UTC)
+
+	p, err := Begin(root, now)
+	if err != nil {
+		t.Fatalf("Begin() error = %v", err)
+	}
+	if p.Name != "20240305T030000Z" {
+		t.Errorf("Name = %q, want 20240305T030000Z", p.Name)
+	}
+
+	// A pending snapshot is not listed.
+	if snapshots, _ := List(root); len(snapshots) != 0 {
+		t.Errorf("List() before Commit() = %v, want none", snapshots)
+	}
+
+	if err := os.WriteFile(filepath.Join(p.Path, "file.txt"), []byte("data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := p.Commit()
+	if err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "20240305T030000Z", "file.txt")); err != nil {
+		t.Errorf("committed snapshot missing file: %v", err)
+	}
+
+	latest, ok, err := Latest(root)
+	if err != nil || !ok || latest.Name != s.Name {
+		t.Errorf("Latest() = %v, %v, %v; want %v", latest, ok, err, s.Name)
+	}
+
+	if target, err := os.Readlink(filepath.Join(root, "latest")); err == nil && target != s.Name {
+		t.Errorf("latest link = %q, want %q", target, s.Name)
+	}
+
+	if _, err := Begin(root, now); err == nil {
+		t.Error("Begin() expected error for existing snapshot")
+	}
+}
+
+func TestBeginRemovesInterruptedSnapshots(t *testing.T) {
+	root := t.TempDir()
+	stale := filepath.Join(root, "20240101T000000Z"+partialSuffix)
+	unrelated := filepath.Join(root, "photos.partial")
+	for _, dir := range []string{stale, unrelated} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	p, err := Begin(root, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Begin() error = %v", err)
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("interrupted snapshot not removed")
+	}
+	if _, err := os.Stat(unrelated); err != nil {
+		t.Errorf("unrelated directory removed: %v", err)
+	}
+
+	if err := p.Abort(); err != nil {
+		t.Fatalf("Abort() error = %v", err)
+	}
+	if _, err := os.Stat(p.Path); !os.IsNotExist(err) {
+		t.Errorf("aborted snapshot not removed")
+	}
+}
+
+func TestList(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"20240102T000000Z", "20240301T000000Z", "20231231T000000Z", "not-a-snapshot"} {
+		if err := os.MkdirAll(filepath.Join(root, name), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	snapshots, err := List(root)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	want := []string{"20240301T000000Z", "20240102T000000Z", "20231231T000000Z"}
+	if len(snapshots) != len(want) {
+		t.Fatalf("List() = %v, want %v", snapshots, want)
+	}
+	for i := range want {
+		if snapshots[i].Name != want[i] {
+			t.Errorf("List()[%d] = %s, want %s", i, snapshots[i].Name, want[i])
+		}
+	}
+
+	if snapshots, err := List(filepath.Join(root, "missing")); err != nil || snapshots != nil {
+		t.Errorf("List() of missing root = %v, %v", snapshots, err)
+	}
+}
@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Local writes to a directory on the local filesystem.
+type Local struct {
+	root string
+}
+
+// NewLocal creates root if needed and returns a sink writing below it.
+func NewLocal(root string) (*Local, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create download directory: %w", err)
+	}
+	return &Local{root: root}, nil
+}
+
+// Path returns the filesystem path of name.
+func (l *Local) Path(name string) string {
+	return l.PathIn(l.root, name)
+}
+
+func (l *Local) PathIn(root, name string) string {
+	return filepath.Join(root, filepath.FromSlash(name))
+}
+
+func (l *Local) Put(ctx context.Context, name string, r io.Reader, size int64, modTime time.Time) (int64, error) {
+	dest := l.Path(name)
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return 0, fmt.Errorf("failed to create subdirectory: %w", err)
+	}
+
+	tmp, err := os.OpenFile(l.Path(tempName(name)), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return n, fmt.Errorf("failed to save file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return n, fmt.Errorf("failed to save file: %w", err)
+	}
+	if !modTime.IsZero() {
+		if err := os.Chtimes(tmp.Name(), modTime, modTime); err != nil {
+			return n, fmt.Errorf("failed to set modification time: %w", err)
+		}
+	}
+	if err := os.Rename(tmp.Name(), dest); err != nil {
+		return n, fmt.Errorf("failed to save file: %w", err)
+	}
+	return n, nil
+}
+
+func (l *Local) Stat(ctx context.Context, name string) (Object, error) {
+	info, err := os.Stat(l.Path(name))
+	if err != nil {
+		return Object{}, err
+	}
+	return Object{Name: name, Size: info.Size(), ModTime: info.ModTime()}, nil
+}
+
+// Link hardlinks the existing file src to name, replacing name if it exists.
+func (l *Local) Link(src, name string) error {
+	dest := l.Path(name)
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return fmt.Errorf("failed to create subdirectory: %w", err)
+	}
+	os.Remove(dest)
+	return os.Link(src, dest)
+}
+
+func (l *Local) String() string {
+	return l.root
+}
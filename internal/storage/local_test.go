@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocal_PutStat(t *testing.T) {
+	ctx := context.Background()
+	root := filepath.Join(t.TempDir(), "export")
+	l, err := NewLocal(root)
+	if err != nil {
+		t.Fatalf("NewLocal() error = %v", err)
+	}
+
+	if _, err := l.Stat(ctx, "item/file.txt"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat() of missing file error = %v, want fs.ErrNotExist", err)
+	}
+
+	modTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	n, err := l.Put(ctx, "item/file.txt", strings.NewReader("test content"), -1, modTime)
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if n != 12 {
+		t.Errorf("Put() = %d bytes, want 12", n)
+	}
+
+	content, err := os.ReadFile(filepath.Join(root, "item", "file.txt"))
+	if err != nil || string(content) != "test content" {
+		t.Errorf("file content = %q, %v", content, err)
+	}
+
+	obj, err := l.Stat(ctx, "item/file.txt")
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if obj.Size != 12 || !obj.ModTime.Equal(modTime) {
+		t.Errorf("Stat() = %+v, want size 12 and mod time %v", obj, modTime)
+	}
+
+	entries, _ := os.ReadDir(filepath.Join(root, "item"))
+	if len(entries) != 1 {
+		t.Errorf("temporary files left behind: %v", entries)
+	}
+}
+
+func TestLocal_PutFailureKeepsExistingFile(t *testing.T) {
+	ctx := context.Background()
+	l, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocal() error = %v", err)
+	}
+	if _, err := l.Put(ctx, "file.txt", strings.NewReader("old"), -1, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := l.Put(ctx, "file.txt", &failingReader{}, -1, time.Time{}); err == nil {
+		t.Fatal("Put() expected error")
+	}
+	content, _ := os.ReadFile(l.Path("file.txt"))
+	if string(content) != "old" {
+		t.Errorf("file content = %q, want the previous content", content)
+	}
+}
+
+func TestLocal_Link(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "file.txt")
+	if err := os.WriteFile(src, []byte("data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	l, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocal() error = %v", err)
+	}
+
+	if err := l.Link(src, "item/file.txt"); err != nil {
+		t.Fatalf("Link() error = %v", err)
+	}
+	srcInfo, _ := os.Stat(src)
+	info, err := os.Stat(l.Path("item/file.txt"))
+	if err != nil || !os.SameFile(srcInfo, info) {
+		t.Errorf("Link() did not hardlink the file: %v", err)
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
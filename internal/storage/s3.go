@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// mtimeKey is the object metadata key holding the modification time passed to
+// Put, since S3 sets Last-Modified to the upload time.
+const mtimeKey = "mtime"
+
+// S3 writes to a bucket of Amazon S3 or an S3-compatible service.
+type S3 struct {
+	client   *s3.Client
+	uploader *manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+// NewS3 returns a sink writing below prefix in bucket. Credentials and, unless
+// set in opts, the region are read from the standard AWS environment variables
+// and shared configuration files.
+func NewS3(ctx context.Context, bucket, prefix string, opts Options) (*S3, error) {
+	if bucket == "" {
+		return nil, errors.New("S3 bucket is required")
+	}
+
+	var loadOpts []func(*awsconfig.LoadOptions) error
+	if opts.S3Region != "" {
+		loadOpts = append(loadOpts, awsconfig.WithRegion(opts.S3Region))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, loadOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+	if cfg.Region == "" {
+		// S3-compatible services usually ignore the region, but requests
+		// can't be signed without one.
+		cfg.Region = "us-east-1"
+	}
+
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if opts.S3Endpoint != "" {
+			o.BaseEndpoint = aws.String(opts.S3Endpoint)
+		}
+		o.UsePathStyle = opts.S3PathStyle
+		// Only send checksums when the API requires them; many S3-compatible
+		// services reject the newer trailing checksums.
+		o.RequestChecksumCalculation = aws.RequestChecksumCalculationWhenRequired
+	})
+	return newS3(client, bucket, prefix), nil
+}
+
+func newS3(client *s3.Client, bucket, prefix string) *S3 {
+	return &S3{
+		client:   client,
+		uploader: manager.NewUploader(client),
+		bucket:   bucket,
+		prefix:   strings.Trim(prefix, "/"),
+	}
+}
+
+func (s *S3) key(name string) string {
+	if s.prefix == "" {
+		return name
+	}
+	return s.prefix + "/" + name
+}
+
+// Put uploads r, in several parts when it is large, without staging it on
+// disk.
+func (s *S3) Put(ctx context.Context, name string, r io.Reader, size int64, modTime time.Time) (int64, error) {
+	counter := &CountingReader{R: r}
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+		Body:   counter,
+	}
+	if contentType := mime.TypeByExtension(path.Ext(name)); contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+	if !modTime.IsZero() {
+		input.Metadata = map[string]string{mtimeKey: modTime.UTC().Format(time.RFC3339Nano)}
+	}
+
+	if _, err := s.uploader.Upload(ctx, input); err != nil {
+		return counter.N, fmt.Errorf("failed to upload %s: %w", name, err)
+	}
+	return counter.N, nil
+}
+
+func (s *S3) Stat(ctx context.Context, name string) (Object, error) {
+	out, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return Object{}, fmt.Errorf("%s: %w", name, fs.ErrNotExist)
+		}
+		return Object{}, fmt.Errorf("failed to stat %s: %w", name, err)
+	}
+
+	obj := Object{Name: name, Size: aws.ToInt64(out.ContentLength), ModTime: aws.ToTime(out.LastModified)}
+	if v, ok := out.Metadata[mtimeKey]; ok {
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			obj.ModTime = t
+		}
+	}
+	return obj, nil
+}
+
+func (s *S3) String() string {
+	return "s3://" + path.Join(s.bucket, s.prefix)
+}
+
+func isNotFound(err error) bool {
+	var notFound *types.NotFound
+	if errors.As(err, &notFound) {
+		return true
+	}
+	var respErr *awshttp.ResponseError
+	return errors.As(err, &respErr) && respErr.HTTPStatusCode() == 404
+}
@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// fakeS3 is an in-process stand-in for an S3-compatible service, supporting
+// the single part uploads and HEAD requests the sink makes for small files.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string]fakeObject
+}
+
+type fakeObject struct {
+	data   []byte
+	header http.Header
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		header := http.Header{}
+		for k, v := range r.Header {
+			if strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") || k == "Content-Type" {
+				header[k] = v
+			}
+		}
+		f.objects[r.URL.Path] = fakeObject{data: data, header: header}
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodHead:
+		obj, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for k, v := range obj.header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(obj.data)))
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestS3(t *testing.T) (*S3, *fakeS3) {
+	fake := &fakeS3{objects: make(map[string]fakeObject)}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	client := s3.New(s3.Options{
+		Region:                     "us-east-1",
+		BaseEndpoint:               aws.String(srv.URL),
+		UsePathStyle:               true,
+		Credentials:                credentials.NewStaticCredentialsProvider("key", "secret", ""),
+		RequestChecksumCalculation: aws.RequestChecksumCalculationWhenRequired,
+	})
+	return newS3(client, "bucket", "/backups/"), fake
+}
+
+func TestS3_PutStat(t *testing.T) {
+	ctx := context.Background()
+	sink, fake := newTestS3(t)
+
+	if _, err := sink.Stat(ctx, "item/front.jpg"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat() of missing object error = %v, want fs.ErrNotExist", err)
+	}
+
+	modTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	n, err := sink.Put(ctx, "item/front.jpg", strings.NewReader("test content"), -1, modTime)
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if n != 12 {
+		t.Errorf("Put() = %d bytes, want 12", n)
+	}
+
+	obj, ok := fake.objects["/bucket/backups/item/front.jpg"]
+	if !ok {
+		t.Fatalf("object not uploaded, have %v", fake.objects)
+	}
+	if string(obj.data) != "test content" {
+		t.Errorf("uploaded content = %q", obj.data)
+	}
+	if ct := obj.header.Get("Content-Type"); ct != "image/jpeg" {
+		t.Errorf("Content-Type = %q, want image/jpeg", ct)
+	}
+
+	stat, err := sink.Stat(ctx, "item/front.jpg")
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if stat.Size != 12 || !stat.ModTime.Equal(modTime) {
+		t.Errorf("Stat() = %+v, want size 12 and mod time %v", stat, modTime)
+	}
+}
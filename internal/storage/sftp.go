@@ -0,0 +1,187 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// SFTP writes to a directory on an SSH server.
+type SFTP struct {
+	conn   *ssh.Client
+	client *sftp.Client
+	host   string
+	root   string
+}
+
+// NewSFTP connects to the server in u, an sftp://[user[:password]@]host[:port]/path
+// URL, and returns a sink writing below its path. The host key is checked
+// against opts.SFTPKnownHosts, ~/.ssh/known_hosts by default.
+func NewSFTP(u *url.URL, opts Options) (*SFTP, error) {
+	user := u.User.Username()
+	if user == "" {
+		user = os.Getenv("USER")
+	}
+	if user == "" {
+		return nil, errors.New("SFTP user is required")
+	}
+
+	var auth []ssh.AuthMethod
+	if password, ok := u.User.Password(); ok {
+		auth = append(auth, ssh.Password(password))
+	} else if opts.SFTPPassword != "" {
+		auth = append(auth, ssh.Password(opts.SFTPPassword))
+	}
+	if opts.SFTPKeyFile != "" {
+		key, err := os.ReadFile(opts.SFTPKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SFTP key: %w", err)
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse SFTP key: %w", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if len(auth) == 0 {
+		return nil, errors.New("SFTP password or key is required")
+	}
+
+	hostKeyCallback, err := hostKeyCallback(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "22")
+	}
+	conn, err := ssh.Dial("tcp", host, &ssh.ClientConfig{
+		User:            user,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         30 * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", host, err)
+	}
+	client, err := sftp.NewClient(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to start SFTP session: %w", err)
+	}
+
+	root := u.Path
+	if root == "" {
+		root = "."
+	}
+	if err := client.MkdirAll(root); err != nil {
+		client.Close()
+		conn.Close()
+		return nil, fmt.Errorf("failed to create download directory: %w", err)
+	}
+
+	return &SFTP{conn: conn, client: client, host: u.Host, root: root}, nil
+}
+
+func hostKeyCallback(opts Options) (ssh.HostKeyCallback, error) {
+	if opts.SFTPInsecureIgnoreHostKey {
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+	file := opts.SFTPKnownHosts
+	if file == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to find known_hosts: %w", err)
+		}
+		file = filepath.Join(home, ".ssh", "known_hosts")
+	}
+	callback, err := knownhosts.New(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load known hosts: %w", err)
+	}
+	return callback, nil
+}
+
+func (s *SFTP) path(name string) string {
+	return path.Join(s.root, name)
+}
+
+func (s *SFTP) Put(ctx context.Context, name string, r io.Reader, size int64, modTime time.Time) (int64, error) {
+	dest := s.path(name)
+	if err := s.client.MkdirAll(path.Dir(dest)); err != nil {
+		return 0, fmt.Errorf("failed to create subdirectory: %w", err)
+	}
+
+	tmp := s.path(tempName(name))
+	f, err := s.client.Create(tmp)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create file: %w", err)
+	}
+	defer s.client.Remove(tmp)
+
+	// A transfer in progress holds the file, so only closing the connection
+	// aborts it. That leaves the temporary file behind, and the sink unusable,
+	// but cancelling ends the export anyway.
+	stop := context.AfterFunc(ctx, func() { s.conn.Close() })
+	defer stop()
+
+	n, err := f.ReadFrom(r)
+	if err != nil {
+		f.Close()
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		return n, fmt.Errorf("failed to save file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return n, fmt.Errorf("failed to save file: %w", err)
+	}
+	if !modTime.IsZero() {
+		if err := s.client.Chtimes(tmp, modTime, modTime); err != nil {
+			return n, fmt.Errorf("failed to set modification time: %w", err)
+		}
+	}
+
+	// Plain SFTP renames fail when the target exists; the OpenSSH extension
+	// replaces it atomically.
+	if err := s.client.PosixRename(tmp, dest); err != nil {
+		s.client.Remove(dest)
+		if err := s.client.Rename(tmp, dest); err != nil {
+			return n, fmt.Errorf("failed to save file: %w", err)
+		}
+	}
+	return n, nil
+}
+
+func (s *SFTP) Stat(ctx context.Context, name string) (Object, error) {
+	info, err := s.client.Stat(s.path(name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return Object{}, fmt.Errorf("%s: %w", name, fs.ErrNotExist)
+		}
+		return Object{}, fmt.Errorf("failed to stat %s: %w", name, err)
+	}
+	return Object{Name: name, Size: info.Size(), ModTime: info.ModTime()}, nil
+}
+
+// Close ends the SFTP session and the SSH connection.
+func (s *SFTP) Close() error {
+	return errors.Join(s.client.Close(), s.conn.Close())
+}
+
+func (s *SFTP) String() string {
+	return (&url.URL{Scheme: "sftp", Host: s.host, Path: s.root}).String()
+}
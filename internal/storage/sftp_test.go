@@ -0,0 +1,202 @@
+package storage
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"io/fs"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// startSFTPServer runs an in-process SSH server with the SFTP subsystem,
+// serving the local filesystem to the user "alice" with password "secret".
+// It returns the server address and its host key.
+func startSFTPServer(t *testing.T) (string, ssh.PublicKey) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if c.User() == "alice" && string(pass) == "secret" {
+				return nil, nil
+			}
+			return nil, errors.New("access denied")
+		},
+	}
+	config.AddHostKey(signer)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go serveSFTP(conn, config)
+		}
+	}()
+	return ln.Addr().String(), signer.PublicKey()
+}
+
+func serveSFTP(conn net.Conn, config *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range requests {
+				ok := req.Type == "subsystem" && string(req.Payload[4:]) == "sftp"
+				req.Reply(ok, nil)
+				if ok {
+					go func() {
+						server, err := sftp.NewServer(channel)
+						if err == nil {
+							server.Serve()
+						}
+						channel.Close()
+					}()
+				}
+			}
+		}()
+	}
+}
+
+func TestSFTP_PutStat(t *testing.T) {
+	ctx := context.Background()
+	addr, _ := startSFTPServer(t)
+	root := filepath.Join(t.TempDir(), "backups")
+
+	u := &url.URL{Scheme: "sftp", User: url.UserPassword("alice", "secret"), Host: addr, Path: filepath.ToSlash(root)}
+	sink, err := NewSFTP(u, Options{SFTPInsecureIgnoreHostKey: true})
+	if err != nil {
+		t.Fatalf("NewSFTP() error = %v", err)
+	}
+	defer sink.Close()
+
+	if _, err := sink.Stat(ctx, "item/front.jpg"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat() of missing file error = %v, want fs.ErrNotExist", err)
+	}
+
+	modTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	for _, content := range []string{"old content", "test content"} {
+		if _, err := sink.Put(ctx, "item/front.jpg", strings.NewReader(content), -1, modTime); err != nil {
+			t.Fatalf("Put() error = %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, "item", "front.jpg"))
+	if err != nil || string(data) != "test content" {
+		t.Errorf("uploaded content = %q, %v", data, err)
+	}
+	if entries, _ := os.ReadDir(filepath.Join(root, "item")); len(entries) != 1 {
+		t.Errorf("temporary files left behind: %v", entries)
+	}
+
+	obj, err := sink.Stat(ctx, "item/front.jpg")
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if obj.Size != 12 || !obj.ModTime.Equal(modTime) {
+		t.Errorf("Stat() = %+v, want size 12 and mod time %v", obj, modTime)
+	}
+}
+
+// slowReader fills a buffer every few milliseconds, forever.
+type slowReader struct{}
+
+func (slowReader) Read(p []byte) (int, error) {
+	time.Sleep(5 * time.Millisecond)
+	return len(p), nil
+}
+
+func TestSFTP_PutCancel(t *testing.T) {
+	addr, _ := startSFTPServer(t)
+	root := t.TempDir()
+	u := &url.URL{Scheme: "sftp", User: url.UserPassword("alice", "secret"), Host: addr, Path: filepath.ToSlash(root)}
+	sink, err := NewSFTP(u, Options{SFTPInsecureIgnoreHostKey: true})
+	if err != nil {
+		t.Fatalf("NewSFTP() error = %v", err)
+	}
+	defer sink.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		_, err := sink.Put(ctx, "endless.bin", slowReader{}, -1, time.Time{})
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Put() error = %v, want the deadline", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Put() was not interrupted by the context")
+	}
+	if _, err := os.Stat(filepath.Join(root, "endless.bin")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("interrupted upload looks complete: %v", err)
+	}
+}
+
+func TestSFTP_HostKey(t *testing.T) {
+	addr, hostKey := startSFTPServer(t)
+	dir := t.TempDir()
+	u := &url.URL{Scheme: "sftp", User: url.UserPassword("alice", "secret"), Host: addr, Path: filepath.ToSlash(dir)}
+
+	known := filepath.Join(dir, "known_hosts")
+	if err := os.WriteFile(known, []byte(knownhosts.Line([]string{knownhosts.Normalize(addr)}, hostKey)+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	sink, err := NewSFTP(u, Options{SFTPKnownHosts: known})
+	if err != nil {
+		t.Fatalf("NewSFTP() with known host error = %v", err)
+	}
+	sink.Close()
+
+	unknown := filepath.Join(dir, "empty_known_hosts")
+	if err := os.WriteFile(unknown, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewSFTP(u, Options{SFTPKnownHosts: unknown}); err == nil {
+		t.Error("NewSFTP() expected error for unknown host key")
+	}
+
+	u.User = url.UserPassword("alice", "wrong")
+	if _, err := NewSFTP(u, Options{SFTPInsecureIgnoreHostKey: true}); err == nil {
+		t.Error("NewSFTP() expected error for wrong password")
+	}
+}
@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// Object describes a file stored in a Sink.
+type Object struct {
+	Name    string
+	Size    int64
+	ModTime time.Time
+}
+
+// Sink is the destination an export is written to. Names are slash separated
+// and relative to the root of the sink.
+type Sink interface {
+	// Put writes r to name, replacing an existing object. size is the length
+	// of r, or -1 when it isn't known. modTime is recorded so that Stat can
+	// report it; the zero time records the time of writing. An interrupted
+	// Put never leaves a partial object behind.
+	Put(ctx context.Context, name string, r io.Reader, size int64, modTime time.Time) (int64, error)
+	// Stat returns an error satisfying errors.Is(err, fs.ErrNotExist) when
+	// name doesn't exist.
+	Stat(ctx context.Context, name string) (Object, error)
+	// String describes the location of the sink for logs.
+	String() string
+}
+
+// Linker is implemented by sinks on the local filesystem, which can hardlink
+// unchanged files from an earlier export instead of writing them again.
+type Linker interface {
+	// PathIn returns the path name is stored at in the export below root.
+	PathIn(root, name string) string
+	// Link hardlinks the existing file src to name, replacing name if it
+	// exists.
+	Link(src, name string) error
+}
+
+// Options configures sinks that write to remote storage.
+type Options struct {
+	S3Endpoint  string // optional, custom endpoint for S3-compatible services such as MinIO
+	S3Region    string // optional, defaults to the AWS SDK configuration
+	S3PathStyle bool   // optional, addresses buckets by path instead of by subdomain
+
+	WebDAVUser     string // optional, unless the URL contains credentials
+	WebDAVPassword string // optional, unless the URL contains credentials
+
+	SFTPPassword              string // optional, unless the URL contains it or a key is used
+	SFTPKeyFile               string // optional, private key to authenticate with
+	SFTPKnownHosts            string // optional, defaults to ~/.ssh/known_hosts
+	SFTPInsecureIgnoreHostKey bool   // optional, skips host key verification
+}
+
+// tempName returns a hidden name next to name, unique to one Put. Sinks write
+// to it first and rename it to name once complete, so that an interrupted
+// upload never looks like a complete one.
+func tempName(name string) string {
+	suffix := make([]byte, 4)
+	rand.Read(suffix)
+	return path.Join(path.Dir(name), "."+path.Base(name)+"."+hex.EncodeToString(suffix)+".tmp")
+}
+
+// CountingReader counts the bytes read through it, for sinks to report how
+// much of r they wrote.
+type CountingReader struct {
+	R io.Reader
+	N int64
+}
+
+func (c *CountingReader) Read(p []byte) (int, error) {
+	n, err := c.R.Read(p)
+	c.N += int64(n)
+	return n, err
+}
+
+// LocalPath returns the filesystem path location refers to, and whether it
+// refers to the local filesystem at all: a plain path is returned as is, a
+// file:// URL as its path.
+func LocalPath(location string) (string, bool) {
+	scheme, _, ok := strings.Cut(location, "://")
+	if !ok {
+		return location, true
+	}
+	if !strings.EqualFold(scheme, "file") {
+		return "", false
+	}
+	u, err := url.Parse(location)
+	if err != nil {
+		return "", false
+	}
+	return u.Path, true
+}
+
+// Open returns the sink for location. A plain path or file:// URL writes to
+// the local filesystem, s3://bucket/prefix writes to an S3 bucket,
+// webdav:// and webdavs:// write to a WebDAV server over http and https, and
+// sftp://host/path writes over SFTP. Sinks that hold a connection implement
+// io.Closer.
+func Open(ctx context.Context, location string, opts Options) (Sink, error) {
+	if path, ok := LocalPath(location); ok {
+		return NewLocal(path)
+	}
+
+	scheme, _, _ := strings.Cut(location, "://")
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output location %q: %w", location, err)
+	}
+	switch strings.ToLower(scheme) {
+	case "s3":
+		return NewS3(ctx, u.Host, u.Path, opts)
+	case "webdav", "webdavs":
+		user, password := opts.WebDAVUser, opts.WebDAVPassword
+		if u.User != nil {
+			user = u.User.Username()
+			password, _ = u.User.Password()
+		}
+		base := *u
+		base.Scheme = "http"
+		if strings.EqualFold(scheme, "webdavs") {
+			base.Scheme = "https"
+		}
+		return NewWebDAV(&base, user, password), nil
+	case "sftp":
+		return NewSFTP(u, opts)
+	default:
+		return nil, fmt.Errorf("unsupported output location %q", u.Redacted())
+	}
+}
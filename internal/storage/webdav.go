@@ -0,0 +1,215 @@
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WebDAV writes to a collection on a WebDAV server such as Nextcloud.
+type WebDAV struct {
+	client   *http.Client
+	base     *url.URL
+	user     string
+	password string
+
+	dirs sync.Map // collections known to exist
+}
+
+// NewWebDAV returns a sink writing below the collection at base, an http or
+// https URL. Missing collections are created as needed.
+func NewWebDAV(base *url.URL, user, password string) *WebDAV {
+	u := *base
+	u.User = nil
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return &WebDAV{
+		client:   &http.Client{},
+		base:     &u,
+		user:     user,
+		password: password,
+	}
+}
+
+func (w *WebDAV) url(name string, collection bool) string {
+	u := w.base.JoinPath(name)
+	if collection {
+		u.Path += "/"
+	}
+	return u.String()
+}
+
+func (w *WebDAV) do(ctx context.Context, method, url string, body io.Reader, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if w.user != "" || w.password != "" {
+		req.SetBasicAuth(w.user, w.password)
+	}
+	return w.client.Do(req)
+}
+
+// mkdirAll creates the collection dir and its parents below the base. It is
+// safe to call concurrently; creating a collection twice is harmless.
+func (w *WebDAV) mkdirAll(ctx context.Context, dir string) error {
+	parts := []string{""}
+	if dir != "." && dir != "" {
+		segments := strings.Split(dir, "/")
+		for i := range segments {
+			parts = append(parts, path.Join(segments[:i+1]...))
+		}
+	}
+
+	for _, p := range parts {
+		if _, ok := w.dirs.Load(p); ok {
+			continue
+		}
+		resp, err := w.do(ctx, "MKCOL", w.url(p, true), nil, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create collection %s: %w", p, err)
+		}
+		resp.Body.Close()
+		// 405 Method Not Allowed means the collection already exists.
+		if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusMethodNotAllowed {
+			return fmt.Errorf("failed to create collection %s: %s", p, resp.Status)
+		}
+		w.dirs.Store(p, true)
+	}
+	return nil
+}
+
+func (w *WebDAV) Put(ctx context.Context, name string, r io.Reader, size int64, modTime time.Time) (int64, error) {
+	if err := w.mkdirAll(ctx, path.Dir(name)); err != nil {
+		return 0, err
+	}
+
+	tmp := tempName(name)
+	n, err := w.upload(ctx, tmp, r, size, modTime)
+	if err == nil {
+		err = w.move(ctx, tmp, name)
+	}
+	if err != nil {
+		// Clean up even when ctx was cancelled, which is what interrupted
+		// the upload.
+		if resp, derr := w.do(context.WithoutCancel(ctx), http.MethodDelete, w.url(tmp, false), nil, nil); derr == nil {
+			resp.Body.Close()
+		}
+		return n, fmt.Errorf("failed to upload %s: %w", name, err)
+	}
+	return n, nil
+}
+
+func (w *WebDAV) upload(ctx context.Context, name string, r io.Reader, size int64, modTime time.Time) (int64, error) {
+	counter := &CountingReader{R: r}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, w.url(name, false), counter)
+	if err != nil {
+		return 0, err
+	}
+	if size >= 0 {
+		req.ContentLength = size
+	}
+	if !modTime.IsZero() {
+		// Nextcloud and ownCloud keep this as the file's modification time;
+		// other servers ignore it and record the upload time.
+		req.Header.Set("X-OC-Mtime", strconv.FormatInt(modTime.Unix(), 10))
+	}
+	if w.user != "" || w.password != "" {
+		req.SetBasicAuth(w.user, w.password)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return counter.N, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return counter.N, fmt.Errorf("%s", resp.Status)
+	}
+	return counter.N, nil
+}
+
+// move renames src to dest, replacing dest if it exists.
+func (w *WebDAV) move(ctx context.Context, src, dest string) error {
+	header := http.Header{}
+	header.Set("Destination", w.url(dest, false))
+	header.Set("Overwrite", "T")
+	resp, err := w.do(ctx, "MOVE", w.url(src, false), nil, header)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("failed to move into place: %s", resp.Status)
+	}
+	return nil
+}
+
+const propfindBody = `<?xml version="1.0" encoding="utf-8"?>
+<d:propfind xmlns:d="DAV:"><d:prop><d:getcontentlength/><d:getlastmodified/></d:prop></d:propfind>`
+
+type multistatus struct {
+	Responses []struct {
+		Propstat []struct {
+			Status string `xml:"DAV: status"`
+			Prop   struct {
+				ContentLength string `xml:"DAV: getcontentlength"`
+				LastModified  string `xml:"DAV: getlastmodified"`
+			} `xml:"DAV: prop"`
+		} `xml:"DAV: propstat"`
+	} `xml:"DAV: response"`
+}
+
+func (w *WebDAV) Stat(ctx context.Context, name string) (Object, error) {
+	header := http.Header{}
+	header.Set("Depth", "0")
+	header.Set("Content-Type", "application/xml; charset=utf-8")
+	resp, err := w.do(ctx, "PROPFIND", w.url(name, false), strings.NewReader(propfindBody), header)
+	if err != nil {
+		return Object{}, fmt.Errorf("failed to stat %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return Object{}, fmt.Errorf("%s: %w", name, fs.ErrNotExist)
+	}
+	if resp.StatusCode != http.StatusMultiStatus {
+		return Object{}, fmt.Errorf("failed to stat %s: %s", name, resp.Status)
+	}
+
+	var ms multistatus
+	if err := xml.NewDecoder(resp.Body).Decode(&ms); err != nil {
+		return Object{}, fmt.Errorf("failed to stat %s: %w", name, err)
+	}
+
+	obj := Object{Name: name}
+	for _, r := range ms.Responses {
+		for _, ps := range r.Propstat {
+			if !strings.Contains(ps.Status, " 200 ") {
+				continue
+			}
+			if v, err := strconv.ParseInt(ps.Prop.ContentLength, 10, 64); err == nil {
+				obj.Size = v
+			}
+			if t, err := http.ParseTime(ps.Prop.LastModified); err == nil {
+				obj.ModTime = t
+			}
+		}
+	}
+	return obj, nil
+}
+
+func (w *WebDAV) String() string {
+	return w.base.String()
+}
@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/webdav"
+)
+
+func newTestWebDAV(t *testing.T) (*WebDAV, webdav.FileSystem) {
+	fsys := webdav.NewMemFS()
+	if err := fsys.Mkdir(context.Background(), "/remote", 0755); err != nil {
+		t.Fatal(err)
+	}
+	handler := &webdav.Handler{FileSystem: fsys, LockSystem: webdav.NewMemLS()}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "alice" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	base, err := url.Parse(srv.URL + "/remote/homebox backups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewWebDAV(base, "alice", "secret"), fsys
+}
+
+func TestWebDAV_PutStat(t *testing.T) {
+	ctx := context.Background()
+	sink, fsys := newTestWebDAV(t)
+
+	if _, err := sink.Stat(ctx, "item/front.jpg"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat() of missing file error = %v, want fs.ErrNotExist", err)
+	}
+
+	modTime := time.Now().Add(-time.Hour)
+	n, err := sink.Put(ctx, "Item_abc/photo/front.jpg", strings.NewReader("test content"), 12, modTime)
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if n != 12 {
+		t.Errorf("Put() = %d bytes, want 12", n)
+	}
+
+	f, err := fsys.OpenFile(ctx, "/remote/homebox backups/Item_abc/photo/front.jpg", os.O_RDONLY, 0)
+	if err != nil {
+		t.Fatalf("uploaded file missing: %v", err)
+	}
+	f.Close()
+
+	// Replacing an existing file works and an unknown size is streamed.
+	if _, err := sink.Put(ctx, "Item_abc/photo/front.jpg", strings.NewReader("new content!"), -1, modTime); err != nil {
+		t.Fatalf("Put() replacing file error = %v", err)
+	}
+
+	obj, err := sink.Stat(ctx, "Item_abc/photo/front.jpg")
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	// The server records the upload time, which is never before the
+	// attachment's modification time, so incremental runs still skip it.
+	if obj.Size != 12 || obj.ModTime.Before(modTime) {
+		t.Errorf("Stat() = %+v, want size 12 and mod time after %v", obj, modTime)
+	}
+}
+
+func TestWebDAV_PutFailureLeavesNoPartialFile(t *testing.T) {
+	ctx := context.Background()
+	sink, fsys := newTestWebDAV(t)
+	if _, err := sink.Put(ctx, "dir/old.txt", strings.NewReader("old"), -1, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"dir/old.txt", "dir/new.txt"} {
+		if _, err := sink.Put(ctx, name, io.MultiReader(strings.NewReader("partial"), &failingReader{}), -1, time.Time{}); err == nil {
+			t.Fatalf("Put() of %s expected error", name)
+		}
+	}
+
+	if _, err := sink.Stat(ctx, "dir/new.txt"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat() of the failed upload error = %v, want fs.ErrNotExist", err)
+	}
+	if obj, err := sink.Stat(ctx, "dir/old.txt"); err != nil || obj.Size != 3 {
+		t.Errorf("Stat() of the replaced file = %+v, %v, want the previous content", obj, err)
+	}
+	dir, err := fsys.OpenFile(ctx, "/remote/homebox backups/dir", os.O_RDONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dir.Close()
+	if entries, _ := dir.Readdir(-1); len(entries) != 1 {
+		t.Errorf("collection has %d entries, want only old.txt", len(entries))
+	}
+}
+
+func TestWebDAV_Unauthorized(t *testing.T) {
+	sink, _ := newTestWebDAV(t)
+	sink.password = "wrong"
+
+	if _, err := sink.Put(context.Background(), "file.txt", strings.NewReader("data"), 4, time.Time{}); err == nil {
+		t.Error("Put() expected error with wrong credentials")
+	}
+}
@@ -0,0 +1,278 @@
+// Package xlsx writes simple Office Open XML spreadsheets: text and number
+// cells, bold headers, money formats and hyperlinks. It covers what the
+// inventory report needs without pulling in a full spreadsheet library.
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Cell is a single spreadsheet cell. Cells holding a number are written as
+// numbers so spreadsheets can sum and sort them; all other cells are text.
+type Cell struct {
+	Text     string
+	Number   float64
+	IsNumber bool
+	Bold     bool
+	Money    bool   // formats the number with two decimals and thousands separators
+	Link     string // relative path or URL the cell links to
+}
+
+// Text returns a text cell.
+func Text(s string) Cell {
+	return Cell{Text: s}
+}
+
+// Number returns a number cell.
+func Number(n float64) Cell {
+	return Cell{Number: n, IsNumber: true}
+}
+
+// Money returns a number cell formatted as an amount of money.
+func Money(n float64) Cell {
+	return Cell{Number: n, IsNumber: true, Money: true}
+}
+
+// Link returns a text cell linking to target.
+func Link(text, target string) Cell {
+	return Cell{Text: text, Link: target}
+}
+
+// Sheet is a worksheet. Rows may have different lengths.
+type Sheet struct {
+	Name   string
+	Widths []float64 // optional column widths in characters
+	Rows   [][]Cell
+}
+
+// AddRow appends a row of cells.
+func (s *Sheet) AddRow(cells ...Cell) {
+	s.Rows = append(s.Rows, cells)
+}
+
+// Workbook is a spreadsheet made of one or more sheets.
+type Workbook struct {
+	Sheets []*Sheet
+}
+
+// AddSheet appends a new, empty sheet.
+func (w *Workbook) AddSheet(name string) *Sheet {
+	s := &Sheet{Name: name}
+	w.Sheets = append(w.Sheets, s)
+	return s
+}
+
+// Write writes the workbook as an .xlsx file.
+func (w *Workbook) Write(out io.Writer) error {
+	if len(w.Sheets) == 0 {
+		return fmt.Errorf("workbook has no sheets")
+	}
+
+	z := zip.NewWriter(out)
+	parts := []part{
+		{"[Content_Types].xml", w.contentTypes()},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", w.workbook()},
+		{"xl/_rels/workbook.xml.rels", w.workbookRels()},
+		{"xl/styles.xml", styles},
+	}
+	for i, s := range w.Sheets {
+		sheet, rels := s.xml()
+		parts = append(parts, part{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), sheet})
+		if rels != "" {
+			parts = append(parts, part{fmt.Sprintf("xl/worksheets/_rels/sheet%d.xml.rels", i+1), rels})
+		}
+	}
+
+	for _, p := range parts {
+		f, err := z.Create(p.name)
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %w", p.name, err)
+		}
+		if _, err := io.WriteString(f, p.content); err != nil {
+			return fmt.Errorf("failed to write %s: %w", p.name, err)
+		}
+	}
+	return z.Close()
+}
+
+// part is a file inside the .xlsx zip archive.
+type part struct {
+	name    string
+	content string
+}
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+const rootRels = xmlHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+// Cell styles, indexes into cellXfs below.
+const (
+	styleDefault = iota
+	styleBold
+	styleLink
+	styleMoney
+	styleBoldMoney
+)
+
+// styles defines a regular, a bold and a hyperlink font, and the cell formats
+// combining them with the built-in "#,##0.00" number format.
+const styles = xmlHeader + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="3">` +
+	`<font><sz val="11"/><name val="Calibri"/></font>` +
+	`<font><b/><sz val="11"/><name val="Calibri"/></font>` +
+	`<font><u/><sz val="11"/><color rgb="FF0563C1"/><name val="Calibri"/></font>` +
+	`</fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="5">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="0" fontId="2" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="4" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1" applyNumberFormat="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+func (w *Workbook) contentTypes() string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	b.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := range w.Sheets {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i+1)
+	}
+	b.WriteString(`</Types>`)
+	return b.String()
+}
+
+func (w *Workbook) workbook() string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, s := range w.Sheets {
+		fmt.Fprintf(&b, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escape(sheetName(s.Name, i)), i+1, i+1)
+	}
+	b.WriteString(`</sheets></workbook>`)
+	return b.String()
+}
+
+func (w *Workbook) workbookRels() string {
+	var b strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := range w.Sheets {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, i+1)
+	}
+	fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, len(w.Sheets)+1)
+	b.WriteString(`</Relationships>`)
+	return b.String()
+}
+
+// xml returns the worksheet and, if any cell links somewhere, the
+// relationships holding the link targets.
+func (s *Sheet) xml() (sheet, rels string) {
+	var b, r, links strings.Builder
+	b.WriteString(xmlHeader)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">`)
+
+	if len(s.Widths) > 0 {
+		b.WriteString(`<cols>`)
+		for i, w := range s.Widths {
+			fmt.Fprintf(&b, `<col min="%d" max="%d" width="%s" customWidth="1"/>`, i+1, i+1, strconv.FormatFloat(w, 'f', -1, 64))
+		}
+		b.WriteString(`</cols>`)
+	}
+
+	b.WriteString(`<sheetData>`)
+	nLinks := 0
+	for i, row := range s.Rows {
+		fmt.Fprintf(&b, `<row r="%d">`, i+1)
+		for j, c := range row {
+			ref := CellRef(j, i)
+			switch {
+			case c.IsNumber:
+				fmt.Fprintf(&b, `<c r="%s" s="%d"><v>%s</v></c>`, ref, c.style(), strconv.FormatFloat(c.Number, 'f', -1, 64))
+			case c.Text != "":
+				fmt.Fprintf(&b, `<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, c.style(), escape(c.Text))
+			}
+			if c.Link != "" {
+				nLinks++
+				fmt.Fprintf(&links, `<hyperlink ref="%s" r:id="rId%d"/>`, ref, nLinks)
+				fmt.Fprintf(&r, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink" Target="%s" TargetMode="External"/>`, nLinks, escape(c.Link))
+			}
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData>`)
+
+	if nLinks > 0 {
+		b.WriteString(`<hyperlinks>`)
+		b.WriteString(links.String())
+		b.WriteString(`</hyperlinks>`)
+		rels = xmlHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` + r.String() + `</Relationships>`
+	}
+	b.WriteString(`</worksheet>`)
+	return b.String(), rels
+}
+
+func (c Cell) style() int {
+	switch {
+	case c.Link != "":
+		return styleLink
+	case c.Money && c.Bold:
+		return styleBoldMoney
+	case c.Money:
+		return styleMoney
+	case c.Bold:
+		return styleBold
+	default:
+		return styleDefault
+	}
+}
+
+// CellRef returns the A1 style reference of the cell in column col and row
+// row, both counted from zero.
+func CellRef(col, row int) string {
+	name := ""
+	for col++; col > 0; col = (col - 1) / 26 {
+		name = string(rune('A'+(col-1)%26)) + name
+	}
+	return name + strconv.Itoa(row+1)
+}
+
+// sheetName returns a name spreadsheets accept: at most 31 characters and
+// none of : \ / ? * [ ].
+func sheetName(name string, i int) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`:\/?*[]`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	if len([]rune(name)) > 31 {
+		name = string([]rune(name)[:31])
+	}
+	if name == "" {
+		name = "Sheet" + strconv.Itoa(i+1)
+	}
+	return name
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
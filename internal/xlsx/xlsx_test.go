@@ -0,0 +1,114 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCellRef(t *testing.T) {
+	tests := []struct {
+		col, row int
+		want     string
+	}{
+		{0, 0, "A1"},
+		{25, 1, "Z2"},
+		{26, 9, "AA10"},
+		{51, 0, "AZ1"},
+		{52, 0, "BA1"},
+		{701, 0, "ZZ1"},
+		{702, 0, "AAA1"},
+	}
+	for _, tt := range tests {
+		if got := CellRef(tt.col, tt.row); got != tt.want {
+			t.Errorf("CellRef(%d, %d) = %s, want %s", tt.col, tt.row, got, tt.want)
+		}
+	}
+}
+
+func TestWorkbook_Write(t *testing.T) {
+	var w Workbook
+	items := w.AddSheet("Items")
+	items.Widths = []float64{30, 12}
+	items.AddRow(Cell{Text: "Name", Bold: true}, Cell{Text: "Price", Bold: true})
+	items.AddRow(Link("Drill & <bits>", "Drill_abc/receipt%20scan.pdf"), Money(129.5))
+	items.AddRow(Text("Ladder"), Number(2))
+	w.AddSheet("Summary: [by location]").AddRow(Text("Total"), Money(131.5))
+
+	var buf bytes.Buffer
+	if err := w.Write(&buf); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	z, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("not a zip file: %v", err)
+	}
+	parts := make(map[string]string)
+	for _, f := range z.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(r)
+		r.Close()
+		parts[f.Name] = string(data)
+
+		// Every part must be well-formed XML.
+		d := xml.NewDecoder(bytes.NewReader(data))
+		for {
+			if _, err := d.Token(); err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%s is not well-formed: %v", f.Name, err)
+			}
+		}
+	}
+
+	for _, name := range []string{
+		"[Content_Types].xml",
+		"_rels/.rels",
+		"xl/workbook.xml",
+		"xl/_rels/workbook.xml.rels",
+		"xl/styles.xml",
+		"xl/worksheets/sheet1.xml",
+		"xl/worksheets/_rels/sheet1.xml.rels",
+		"xl/worksheets/sheet2.xml",
+	} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("missing part %s", name)
+		}
+	}
+	if _, ok := parts["xl/worksheets/_rels/sheet2.xml.rels"]; ok {
+		t.Error("sheet without links has relationships")
+	}
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	for _, want := range []string{
+		`<c r="A2" s="2" t="inlineStr"><is><t xml:space="preserve">Drill &amp; &lt;bits&gt;</t></is></c>`,
+		`<c r="B2" s="3"><v>129.5</v></c>`,
+		`<c r="B3" s="0"><v>2</v></c>`,
+		`<hyperlink ref="A2" r:id="rId1"/>`,
+		`<col min="1" max="1" width="30" customWidth="1"/>`,
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("sheet1.xml missing %s", want)
+		}
+	}
+	if !strings.Contains(parts["xl/worksheets/_rels/sheet1.xml.rels"], `Target="Drill_abc/receipt%20scan.pdf" TargetMode="External"`) {
+		t.Error("link target missing from sheet relationships")
+	}
+	if !strings.Contains(parts["xl/workbook.xml"], `name="Summary_ _by location_"`) {
+		t.Errorf("sheet name not sanitized: %s", parts["xl/workbook.xml"])
+	}
+}
+
+func TestWorkbook_WriteEmpty(t *testing.T) {
+	var w Workbook
+	if err := w.Write(io.Discard); err == nil {
+		t.Error("Write() of an empty workbook should fail")
+	}
+}
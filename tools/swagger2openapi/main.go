@@ -0,0 +1,136 @@
+// Command swagger2openapi converts the Swagger 2.0 spec Homebox ships to
+// OpenAPI 3, which oapi-codegen needs to generate the client.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	"sigs.k8s.io/yaml"
+)
+
+func main() {
+	in := flag.String("in", "swagger.yaml", "Swagger 2.0 spec to read")
+	out := flag.String("out", "openapi.yaml", "OpenAPI 3 spec to write")
+	flag.Parse()
+
+	if err := convert(*in, *out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func convert(in, out string) error {
+	data, err := os.ReadFile(in)
+	if err != nil {
+		return err
+	}
+
+	// openapi2.T only unmarshals from JSON.
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", in, err)
+	}
+	var v2 openapi2.T
+	if err := json.Unmarshal(data, &v2); err != nil {
+		return fmt.Errorf("failed to read %s: %w", in, err)
+	}
+
+	dropFormParams(&v2)
+	fixPathParams(&v2)
+
+	v3, err := openapi2conv.ToV3(&v2)
+	if err != nil {
+		return fmt.Errorf("failed to convert %s: %w", in, err)
+	}
+
+	data, err = json.Marshal(v3)
+	if err != nil {
+		return err
+	}
+	data, err = yaml.JSONToYAML(data)
+	if err != nil {
+		return err
+	}
+	header := []byte("# Code generated by swagger2openapi from " + in + ". DO NOT EDIT.\n")
+	return os.WriteFile(out, append(header, data...), 0o644)
+}
+
+// dropFormParams removes the form parameters of operations that also take a
+// JSON body, such as the login, which OpenAPI 3 can't describe. The client
+// always sends the JSON body.
+func dropFormParams(spec *openapi2.T) {
+	for _, item := range spec.Paths {
+		for _, op := range item.Operations() {
+			hasBody := false
+			for _, p := range op.Parameters {
+				if p.In == "body" {
+					hasBody = true
+				}
+			}
+			if !hasBody {
+				continue
+			}
+			params := op.Parameters[:0]
+			for _, p := range op.Parameters {
+				if p.In != "formData" {
+					params = append(params, p)
+				}
+			}
+			op.Parameters = params
+		}
+	}
+}
+
+var pathParam = regexp.MustCompile(`{([^}]+)}`)
+
+// fixPathParams makes the declared path parameters match the path: some
+// operations use parameters they don't declare, such as the id of
+// /v1/items/{id}/maintenance, and /v1/notifiers/test declares an id its path
+// doesn't have.
+func fixPathParams(spec *openapi2.T) {
+	for path, item := range spec.Paths {
+		inPath := map[string]bool{}
+		for _, m := range pathParam.FindAllStringSubmatch(path, -1) {
+			inPath[m[1]] = true
+		}
+
+		for _, op := range item.Operations() {
+			declared := map[string]bool{}
+			for _, p := range item.Parameters {
+				if p.In == "path" {
+					declared[p.Name] = true
+				}
+			}
+			params := op.Parameters[:0]
+			for _, p := range op.Parameters {
+				if p.In == "path" {
+					if !inPath[p.Name] {
+						continue
+					}
+					declared[p.Name] = true
+				}
+				params = append(params, p)
+			}
+			op.Parameters = params
+
+			for _, m := range pathParam.FindAllStringSubmatch(path, -1) {
+				if declared[m[1]] {
+					continue
+				}
+				op.Parameters = append(op.Parameters, &openapi2.Parameter{
+					In:       "path",
+					Name:     m[1],
+					Required: true,
+					Type:     &openapi3.Types{openapi3.TypeString},
+				})
+			}
+		}
+	}
+}